@@ -0,0 +1,328 @@
+package analytics
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go-core/entityexport"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// Type Analyzer struct accumulates frequency tables one entity at a time.
+type Analyzer struct {
+	erruleCodes map[bucketKey]*Bucket
+	matchKeys   map[bucketKey]*Bucket
+	sampleSize  int
+}
+
+type bucketKey struct {
+	dataSource1 string
+	dataSource2 string
+	matchLevel  int64
+	value       string
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Compute function computes frequency tables from a JSON-lines entity export.
+
+Input
+  - reader: The output of ExportJSONEntityReport(), e.g. an [entityexport.Reader] or an exported file.
+  - sampleSize: The maximum number of samples kept per bucket.
+
+Output
+  - The analytics report.
+*/
+func Compute(reader io.Reader, sampleSize int) (*Report, error) {
+	analyzer := New(sampleSize)
+	_, err := entityexport.ForEach(reader, func(entity *entityexport.Entity) error {
+		analyzer.Add(entity)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return analyzer.Report(), nil
+}
+
+/*
+The ComputeFromEngine function exports all entities using [ExportFlags] and computes frequency tables.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine used to export entities.
+  - sampleSize: The maximum number of samples kept per bucket.
+
+Output
+  - The analytics report.
+*/
+func ComputeFromEngine(ctx context.Context, szEngine senzing.SzEngine, sampleSize int) (*Report, error) {
+	reader, err := entityexport.NewJSONReader(ctx, szEngine, ExportFlags)
+	if err != nil {
+		return nil, err
+	}
+	result, err := Compute(reader, sampleSize)
+	closeErr := reader.Close()
+	if err != nil {
+		return nil, err
+	}
+	if closeErr != nil {
+		return nil, closeErr
+	}
+	return result, nil
+}
+
+/*
+The DrillDown function explains a sample.
+Samples of resolved records are explained with WhyRecordInEntity().
+Samples of relationships are explained with WhyEntities().
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine used to explain the sample.
+  - sample: A sample from a [Bucket].
+  - flags: Flags used to control information returned.
+    Use senzing.SzWhyEntitiesDefaultFlags for relationships or senzing.SzWhyRecordInEntityIDefaultFlags for resolved records.
+
+Output
+  - A JSON document from WhyRecordInEntity() or WhyEntities().
+*/
+func DrillDown(ctx context.Context, szEngine senzing.SzEngine, sample Sample, flags int64) (string, error) {
+	if sample.RelatedEntityID != 0 {
+		return szEngine.WhyEntities(ctx, sample.EntityID, sample.RelatedEntityID, flags)
+	}
+	return szEngine.WhyRecordInEntity(ctx, sample.DataSource, sample.RecordID, flags)
+}
+
+/*
+The New function returns an empty Analyzer.
+
+Input
+  - sampleSize: The maximum number of samples kept per bucket. If not positive, [DefaultSampleSize] is used.
+*/
+func New(sampleSize int) *Analyzer {
+	if sampleSize <= 0 {
+		sampleSize = DefaultSampleSize
+	}
+	return &Analyzer{
+		erruleCodes: map[bucketKey]*Bucket{},
+		matchKeys:   map[bucketKey]*Bucket{},
+		sampleSize:  sampleSize,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Analyzer methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Add adds an entity to the frequency tables.
+
+A relationship is reported by both of the related entities,
+so it is only counted from the entity having the lower entity ID.
+
+Input
+  - entity: An entity from the JSON entity export.
+*/
+func (analyzer *Analyzer) Add(entity *entityexport.Entity) {
+	entityID := entity.ResolvedEntity.EntityID
+	records := entity.ResolvedEntity.Records
+
+	seedDataSource := ""
+	for _, record := range records {
+		if len(record.MatchKey) == 0 {
+			seedDataSource = record.DataSource
+			break
+		}
+	}
+
+	for _, record := range records {
+		if len(record.MatchKey) == 0 || record.MatchLevel != entityexport.MatchLevelResolved {
+			continue
+		}
+		dataSource2 := seedDataSource
+		if len(dataSource2) == 0 {
+			dataSource2 = record.DataSource
+		}
+		sample := Sample{
+			EntityID:   entityID,
+			DataSource: record.DataSource,
+			RecordID:   record.RecordID,
+		}
+		analyzer.count(record.MatchLevel, MatchLevelCodeResolved, record.DataSource, dataSource2, record.MatchKey, record.ErruleCode, sample)
+	}
+
+	dataSources := entity.DataSources()
+	for _, related := range entity.RelatedEntities {
+		if related.EntityID <= entityID {
+			continue
+		}
+		sample := Sample{
+			EntityID:        entityID,
+			RelatedEntityID: related.EntityID,
+		}
+		relatedDataSources := map[string]bool{}
+		for _, summary := range related.RecordSummary {
+			relatedDataSources[summary.DataSource] = true
+		}
+		pairs := map[[2]string]bool{}
+		for _, dataSource1 := range dataSources {
+			for dataSource2 := range relatedDataSources {
+				pairs[orderedPair(dataSource1, dataSource2)] = true
+			}
+		}
+		for pair := range pairs {
+			analyzer.count(related.MatchLevel, related.MatchLevelCode, pair[0], pair[1], related.MatchKey, related.ErruleCode, sample)
+		}
+	}
+}
+
+/*
+Method Report returns the frequency tables accumulated so far.
+Buckets are sorted by match level, data source pair, descending count and value.
+
+Output
+  - The analytics report.
+*/
+func (analyzer *Analyzer) Report() *Report {
+	return &Report{
+		ErruleCodes: sortedBuckets(analyzer.erruleCodes),
+		MatchKeys:   sortedBuckets(analyzer.matchKeys),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Report methods
+// ----------------------------------------------------------------------------
+
+/*
+Method WriteCSV writes both tables as CSV.
+The TABLE column is either MATCH_KEY or ERRULE_CODE.
+Sample entity IDs are separated by spaces; a relationship sample is written as "entityID-relatedEntityID".
+
+Input
+  - writer: Where the CSV is written.
+*/
+func (report *Report) WriteCSV(writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	rows := [][]string{csvHeader}
+	rows = appendRows(rows, "MATCH_KEY", report.MatchKeys)
+	rows = appendRows(rows, "ERRULE_CODE", report.ErruleCodes)
+	if err := csvWriter.WriteAll(rows); err != nil {
+		return err
+	}
+	return csvWriter.Error()
+}
+
+/*
+Method WriteJSON writes the report as indented JSON.
+
+Input
+  - writer: Where the JSON is written.
+*/
+func (report *Report) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (analyzer *Analyzer) count(matchLevel int64, matchLevelCode string, dataSource1 string, dataSource2 string, matchKey string, erruleCode string, sample Sample) {
+	pair := orderedPair(dataSource1, dataSource2)
+	dataSource1, dataSource2 = pair[0], pair[1]
+	analyzer.countIn(analyzer.matchKeys, matchLevel, matchLevelCode, dataSource1, dataSource2, matchKey, sample)
+	analyzer.countIn(analyzer.erruleCodes, matchLevel, matchLevelCode, dataSource1, dataSource2, erruleCode, sample)
+}
+
+func (analyzer *Analyzer) countIn(buckets map[bucketKey]*Bucket, matchLevel int64, matchLevelCode string, dataSource1 string, dataSource2 string, value string, sample Sample) {
+	key := bucketKey{
+		dataSource1: dataSource1,
+		dataSource2: dataSource2,
+		matchLevel:  matchLevel,
+		value:       value,
+	}
+	bucket, ok := buckets[key]
+	if !ok {
+		bucket = &Bucket{
+			DataSource1:    dataSource1,
+			DataSource2:    dataSource2,
+			MatchLevel:     matchLevel,
+			MatchLevelCode: matchLevelCode,
+			Samples:        []Sample{},
+			Value:          value,
+		}
+		buckets[key] = bucket
+	}
+	bucket.Count++
+	if len(bucket.Samples) < analyzer.sampleSize {
+		bucket.Samples = append(bucket.Samples, sample)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func appendRows(rows [][]string, table string, buckets []Bucket) [][]string {
+	for _, bucket := range buckets {
+		samples := make([]string, 0, len(bucket.Samples))
+		for _, sample := range bucket.Samples {
+			text := strconv.FormatInt(sample.EntityID, 10)
+			if sample.RelatedEntityID != 0 {
+				text += "-" + strconv.FormatInt(sample.RelatedEntityID, 10)
+			}
+			samples = append(samples, text)
+		}
+		rows = append(rows, []string{
+			table,
+			bucket.MatchLevelCode,
+			bucket.DataSource1,
+			bucket.DataSource2,
+			bucket.Value,
+			strconv.FormatInt(bucket.Count, 10),
+			strings.Join(samples, " "),
+		})
+	}
+	return rows
+}
+
+func orderedPair(dataSource1 string, dataSource2 string) [2]string {
+	if dataSource2 < dataSource1 {
+		return [2]string{dataSource2, dataSource1}
+	}
+	return [2]string{dataSource1, dataSource2}
+}
+
+func sortedBuckets(buckets map[bucketKey]*Bucket) []Bucket {
+	result := make([]Bucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		result = append(result, *bucket)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		switch {
+		case a.MatchLevel != b.MatchLevel:
+			return a.MatchLevel < b.MatchLevel
+		case a.DataSource1 != b.DataSource1:
+			return a.DataSource1 < b.DataSource1
+		case a.DataSource2 != b.DataSource2:
+			return a.DataSource2 < b.DataSource2
+		case a.Count != b.Count:
+			return a.Count > b.Count
+		default:
+			return a.Value < b.Value
+		}
+	})
+	return result
+}
@@ -0,0 +1,211 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/entityexport"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	exportFilename = "../testdata/export/export-json-entity-report.jsonl"
+	printResults   = false
+)
+
+var expectedCsv = `TABLE,MATCH_LEVEL_CODE,DATA_SOURCE_1,DATA_SOURCE_2,VALUE,COUNT,SAMPLE_ENTITY_IDS
+MATCH_KEY,RESOLVED,CUSTOMERS,CUSTOMERS,+NAME+DOB+PHONE,2,1 5
+MATCH_KEY,RESOLVED,CUSTOMERS,CUSTOMERS,+NAME+DOB+EMAIL,1,1
+MATCH_KEY,RESOLVED,CUSTOMERS,REFERENCE,+NAME+DOB+PHONE,1,5
+MATCH_KEY,RESOLVED,CUSTOMERS,WATCHLIST,+NAME+DOB+ADDRESS,2,2 5
+MATCH_KEY,POSSIBLY_SAME,CUSTOMERS,WATCHLIST,+NAME+DOB-SSN,1,1-4
+MATCH_KEY,POSSIBLY_RELATED,CUSTOMERS,REFERENCE,+ADDRESS,1,2-3
+MATCH_KEY,POSSIBLY_RELATED,REFERENCE,WATCHLIST,+ADDRESS,1,2-3
+MATCH_KEY,NAME_ONLY,CUSTOMERS,CUSTOMERS,+NAME,1,5-6
+MATCH_KEY,NAME_ONLY,CUSTOMERS,REFERENCE,+NAME,1,5-6
+MATCH_KEY,NAME_ONLY,CUSTOMERS,WATCHLIST,+NAME,1,5-6
+MATCH_KEY,DISCLOSED,CUSTOMERS,REFERENCE,+REL_POINTER(OWNER:OWNS),1,3-6
+ERRULE_CODE,RESOLVED,CUSTOMERS,CUSTOMERS,CNAME_CFF_CEXCL,2,1 5
+ERRULE_CODE,RESOLVED,CUSTOMERS,CUSTOMERS,SF1_PNAME_CSTAB,1,1
+ERRULE_CODE,RESOLVED,CUSTOMERS,REFERENCE,CNAME_CFF_CEXCL,1,5
+ERRULE_CODE,RESOLVED,CUSTOMERS,WATCHLIST,CNAME_CFF,2,2 5
+ERRULE_CODE,POSSIBLY_SAME,CUSTOMERS,WATCHLIST,CNAME_CFF_DEXCL,1,1-4
+ERRULE_CODE,POSSIBLY_RELATED,CUSTOMERS,REFERENCE,SFF,1,2-3
+ERRULE_CODE,POSSIBLY_RELATED,REFERENCE,WATCHLIST,SFF,1,2-3
+ERRULE_CODE,NAME_ONLY,CUSTOMERS,CUSTOMERS,SNAME,1,5-6
+ERRULE_CODE,NAME_ONLY,CUSTOMERS,REFERENCE,SNAME,1,5-6
+ERRULE_CODE,NAME_ONLY,CUSTOMERS,WATCHLIST,SNAME,1,5-6
+ERRULE_CODE,DISCLOSED,CUSTOMERS,REFERENCE,,1,3-6
+`
+
+// Type mockSzEngine serves a fixed export and records explanations requested.
+type mockSzEngine struct {
+	senzing.SzEngine
+	remaining string
+	requests  []string
+}
+
+func (szEngine *mockSzEngine) CloseExport(ctx context.Context, exportHandle uintptr) error {
+	_ = ctx
+	_ = exportHandle
+	return nil
+}
+
+func (szEngine *mockSzEngine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	_ = ctx
+	_ = flags
+	return 1, nil
+}
+
+func (szEngine *mockSzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	_ = ctx
+	_ = exportHandle
+	result := szEngine.remaining
+	szEngine.remaining = ""
+	return result, nil
+}
+
+func (szEngine *mockSzEngine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	_ = ctx
+	_ = flags
+	result, err := json.Marshal([]int64{entityID1, entityID2})
+	szEngine.requests = append(szEngine.requests, "WhyEntities")
+	return string(result), err
+}
+
+func (szEngine *mockSzEngine) WhyRecordInEntity(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	_ = ctx
+	_ = flags
+	szEngine.requests = append(szEngine.requests, "WhyRecordInEntity")
+	return dataSourceCode + ":" + recordID, nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestAnalytics_Compute(test *testing.T) {
+	actual, err := Compute(strings.NewReader(readExportFile(test)), 0)
+	require.NoError(test, err)
+	printActual(test, actual)
+	require.Len(test, actual.MatchKeys, 11)
+	require.Len(test, actual.ErruleCodes, 11)
+	assert.Equal(test, Bucket{
+		MatchLevel:     1,
+		MatchLevelCode: MatchLevelCodeResolved,
+		DataSource1:    "CUSTOMERS",
+		DataSource2:    "WATCHLIST",
+		Value:          "+NAME+DOB+ADDRESS",
+		Count:          2,
+		Samples: []Sample{
+			{EntityID: 2, DataSource: "WATCHLIST", RecordID: "1012"},
+			{EntityID: 5, DataSource: "WATCHLIST", RecordID: "1022"},
+		},
+	}, actual.MatchKeys[3])
+	assert.Equal(test, []Sample{{EntityID: 1, RelatedEntityID: 4}}, actual.MatchKeys[4].Samples)
+}
+
+func TestAnalytics_Compute_badJSON(test *testing.T) {
+	_, err := Compute(strings.NewReader(`{"RESOLVED_ENTITY":`), 0)
+	require.Error(test, err)
+}
+
+func TestAnalytics_Compute_sampleSize(test *testing.T) {
+	actual, err := Compute(strings.NewReader(readExportFile(test)), 1)
+	require.NoError(test, err)
+	assert.Equal(test, int64(2), actual.MatchKeys[0].Count)
+	assert.Len(test, actual.MatchKeys[0].Samples, 1)
+}
+
+func TestAnalytics_Add_relationshipCountedOncePerPair(test *testing.T) {
+	analyzer := New(0)
+	entity := &entityexport.Entity{
+		ResolvedEntity: entityexport.ResolvedEntity{
+			EntityID: 1,
+			Records: []entityexport.Record{
+				{DataSource: "CUSTOMERS", RecordID: "1"},
+				{DataSource: "WATCHLIST", RecordID: "2", MatchKey: "+NAME", MatchLevel: 1, ErruleCode: "SNAME"},
+			},
+		},
+		RelatedEntities: []entityexport.RelatedEntity{
+			{
+				EntityID:       2,
+				MatchLevel:     2,
+				MatchLevelCode: "POSSIBLY_SAME",
+				MatchKey:       "+NAME",
+				RecordSummary: []entityexport.RecordSummary{
+					{DataSource: "CUSTOMERS", RecordCount: 1},
+					{DataSource: "WATCHLIST", RecordCount: 1},
+				},
+			},
+		},
+	}
+	analyzer.Add(entity)
+	actual := analyzer.Report()
+	require.Len(test, actual.MatchKeys, 4)
+	for _, bucket := range actual.MatchKeys {
+		assert.Equal(test, int64(1), bucket.Count)
+	}
+}
+
+func TestAnalytics_ComputeFromEngine(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := &mockSzEngine{remaining: readExportFile(test)}
+	actual, err := ComputeFromEngine(ctx, szEngine, 0)
+	require.NoError(test, err)
+	assert.Len(test, actual.MatchKeys, 11)
+}
+
+func TestAnalytics_DrillDown(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := &mockSzEngine{}
+	report, err := Compute(strings.NewReader(readExportFile(test)), 0)
+	require.NoError(test, err)
+	actual, err := DrillDown(ctx, szEngine, report.MatchKeys[0].Samples[1], senzing.SzWhyRecordInEntityIDefaultFlags)
+	require.NoError(test, err)
+	assert.Equal(test, "CUSTOMERS:1021", actual)
+	actual, err = DrillDown(ctx, szEngine, report.MatchKeys[4].Samples[0], senzing.SzWhyEntitiesDefaultFlags)
+	require.NoError(test, err)
+	assert.Equal(test, "[1,4]", actual)
+	assert.Equal(test, []string{"WhyRecordInEntity", "WhyEntities"}, szEngine.requests)
+}
+
+func TestAnalytics_WriteCSV(test *testing.T) {
+	report, err := Compute(strings.NewReader(readExportFile(test)), 0)
+	require.NoError(test, err)
+	var buffer bytes.Buffer
+	require.NoError(test, report.WriteCSV(&buffer))
+	assert.Equal(test, expectedCsv, buffer.String())
+}
+
+func TestAnalytics_WriteJSON(test *testing.T) {
+	report, err := Compute(strings.NewReader(readExportFile(test)), 0)
+	require.NoError(test, err)
+	var buffer bytes.Buffer
+	require.NoError(test, report.WriteJSON(&buffer))
+	actual := &Report{}
+	require.NoError(test, json.Unmarshal(buffer.Bytes(), actual))
+	assert.Equal(test, report, actual)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("%+v", actual)
+	}
+}
+
+func readExportFile(test *testing.T) string {
+	result, err := os.ReadFile(exportFilename)
+	require.NoError(test, err)
+	return string(result)
+}
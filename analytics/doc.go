@@ -0,0 +1,18 @@
+/*
+Package analytics is used to compute MATCH_KEY and ERRULE_CODE frequency tables
+from the JSON entity export of [senzing.SzEngine].
+
+Frequencies are computed for resolved records (MATCH_LEVEL 1) and for relationships
+(possibly same, possibly related, name only and disclosed),
+broken down by the pair of data sources involved.
+Each bucket keeps a few sample entity IDs and record keys
+which can be explained with [DrillDown] using WhyRecordInEntity() or WhyEntities().
+
+For a resolved record, the data source pair is the data source of the record
+and the data source of the record that started the entity (the record having no MATCH_KEY).
+For a relationship, one bucket is counted for each pair of data sources
+found in the two related entities. Each relationship is counted once.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+package analytics
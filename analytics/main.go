@@ -0,0 +1,66 @@
+package analytics
+
+import (
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Type Bucket is the frequency of a MATCH_KEY or ERRULE_CODE for a match level and data source pair.
+type Bucket struct {
+	MatchLevel     int64    `json:"MATCH_LEVEL"`
+	MatchLevelCode string   `json:"MATCH_LEVEL_CODE"`
+	DataSource1    string   `json:"DATA_SOURCE_1"`
+	DataSource2    string   `json:"DATA_SOURCE_2"`
+	Value          string   `json:"VALUE"`
+	Count          int64    `json:"COUNT"`
+	Samples        []Sample `json:"SAMPLES"`
+}
+
+// Type Report is the result of an analysis.
+type Report struct {
+	MatchKeys   []Bucket `json:"MATCH_KEYS"`
+	ErruleCodes []Bucket `json:"ERRULE_CODES"`
+}
+
+/*
+Type Sample is an example of a bucket.
+For resolved records, DataSource and RecordID identify the record within EntityID.
+For relationships, RelatedEntityID is the entity related to EntityID.
+*/
+type Sample struct {
+	EntityID        int64  `json:"ENTITY_ID"`
+	RelatedEntityID int64  `json:"RELATED_ENTITY_ID,omitempty"`
+	DataSource      string `json:"DATA_SOURCE,omitempty"`
+	RecordID        string `json:"RECORD_ID,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+DefaultSampleSize is the number of samples kept per bucket when no size is given.
+*/
+const DefaultSampleSize = 5
+
+/*
+ExportFlags are the minimal flags needed by ExportJSONEntityReport() to compute the analytics.
+*/
+const ExportFlags = senzing.SzExportIncludeAllEntities |
+	senzing.SzEntityIncludeAllRelations |
+	senzing.SzEntityIncludeRecordData |
+	senzing.SzEntityIncludeRecordMatchingInfo |
+	senzing.SzEntityIncludeRelatedMatchingInfo |
+	senzing.SzEntityIncludeRelatedRecordSummary
+
+/*
+Match level codes used for buckets.
+*/
+const (
+	MatchLevelCodeResolved = "RESOLVED"
+)
+
+var csvHeader = []string{"TABLE", "MATCH_LEVEL_CODE", "DATA_SOURCE_1", "DATA_SOURCE_2", "VALUE", "COUNT", "SAMPLE_ENTITY_IDS"}
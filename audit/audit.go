@@ -0,0 +1,300 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Interface functions
+// ----------------------------------------------------------------------------
+
+/*
+The ActorFromContext function returns the actor set by WithActor.
+
+Input
+  - ctx: A context.
+
+Output
+  - The actor. Empty if not set.
+*/
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(contextKey{}).(string)
+	return actor
+}
+
+/*
+The AffectedEntities function returns the entity IDs listed in the "AFFECTED_ENTITIES"
+of the result of a call made with the SzWithInfo flag.
+
+Input
+  - withInfo: The result of the call.
+
+Output
+  - The entity IDs. nil if the result has none, as when the call was made without SzWithInfo.
+*/
+func AffectedEntities(withInfo string) []int64 {
+	var info struct {
+		AffectedEntities []struct {
+			EntityID int64 `json:"ENTITY_ID"`
+		} `json:"AFFECTED_ENTITIES"`
+	}
+	if len(withInfo) == 0 || json.Unmarshal([]byte(withInfo), &info) != nil {
+		return nil
+	}
+	var result []int64
+	for _, affected := range info.AffectedEntities {
+		result = append(result, affected.EntityID)
+	}
+	return result
+}
+
+/*
+The Open function opens an audit log, creating it if needed.
+An existing log is verified first, and entries are appended after its last one.
+
+Input
+  - path: The path of the JSON-lines file.
+
+Output
+  - The log, to be closed by Close.
+    The error wraps ErrTampered if the existing log does not verify.
+*/
+func Open(path string) (*Log, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, fileMode)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := Verify(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	result := &Log{
+		file:     file,
+		lastHash: summary.LastHash,
+		sequence: summary.Entries,
+	}
+	return result, nil
+}
+
+/*
+The RecordKey function returns the data source and record ID of a JSON document,
+such as a redo record.
+
+Input
+  - document: The document.
+
+Output
+  - The "DATA_SOURCE" of the document. Empty if it has none.
+  - The "RECORD_ID" of the document. Empty if it has none.
+*/
+func RecordKey(document string) (string, string) {
+	var key struct {
+		DataSource string `json:"DATA_SOURCE"`
+		RecordID   string `json:"RECORD_ID"`
+	}
+	if json.Unmarshal([]byte(document), &key) != nil {
+		return "", ""
+	}
+	return key.DataSource, key.RecordID
+}
+
+/*
+The Verify function checks that every entry of an audit log is unchanged and chained to the previous one.
+Changing, inserting, removing or reordering entries is detected.
+Removing the last entries is only detected by comparing the LastHash with one kept elsewhere.
+
+Input
+  - reader: The content of the log.
+
+Output
+  - The summary of the log.
+    The error wraps ErrTampered, naming the first line that does not verify.
+*/
+func Verify(reader io.Reader) (Summary, error) {
+	var result Summary
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineLength)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		lineNumber := result.Entries + 1
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.DisallowUnknownFields()
+		var entry Entry
+		if err := decoder.Decode(&entry); err != nil {
+			return result, fmt.Errorf("%w: line %d: %w", ErrTampered, lineNumber, err)
+		}
+		switch {
+		case entry.Sequence != lineNumber:
+			return result, fmt.Errorf("%w: line %d: sequence %d", ErrTampered, lineNumber, entry.Sequence)
+		case entry.PreviousHash != result.LastHash:
+			return result, fmt.Errorf("%w: line %d: previous hash does not match", ErrTampered, lineNumber)
+		case entry.Hash != hashOf(entry):
+			return result, fmt.Errorf("%w: line %d: hash does not match", ErrTampered, lineNumber)
+		}
+		encoded, err := encode(entry)
+		if err != nil || !bytes.Equal(encoded, line) {
+			return result, fmt.Errorf("%w: line %d: not in canonical form", ErrTampered, lineNumber)
+		}
+		result.Entries = lineNumber
+		result.LastHash = entry.Hash
+	}
+	if err := scanner.Err(); err != nil {
+		return result, fmt.Errorf("%w: line %d: %w", ErrTampered, result.Entries+1, err)
+	}
+	return result, nil
+}
+
+/*
+The WithActor function returns a context holding the identity of the caller,
+recorded in the audit entries of calls made with it.
+
+Input
+  - ctx: The parent context.
+  - actor: The caller, such as a user or service name.
+
+Output
+  - The context.
+*/
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, contextKey{}, actor)
+}
+
+// ----------------------------------------------------------------------------
+// Log methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Append adds an entry to the log and syncs it to disk.
+
+Input
+  - entry: The entry. Its Sequence, PreviousHash and Hash are set, and its Time if zero.
+*/
+func (log *Log) Append(entry Entry) error {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+	if log.file == nil {
+		return ErrClosed
+	}
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	entry.Time = entry.Time.UTC()
+	entry.Sequence = log.sequence + 1
+	entry.PreviousHash = log.lastHash
+	entry.Hash = hashOf(entry)
+	encoded, err := encode(entry)
+	if err != nil {
+		return err
+	}
+	_, err = log.file.Write(append(encoded, '\n'))
+	if err != nil {
+		return err
+	}
+	err = log.file.Sync()
+	if err != nil {
+		return err
+	}
+	log.sequence = entry.Sequence
+	log.lastHash = entry.Hash
+	return nil
+}
+
+/*
+Method Close closes the log. Later calls to Append return ErrClosed.
+*/
+func (log *Log) Close() error {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+	if log.file == nil {
+		return nil
+	}
+	err := log.file.Close()
+	log.file = nil
+	return err
+}
+
+/*
+Method LastHash returns the hash of the last entry, to be kept elsewhere
+so that Verify can detect the removal of the last entries.
+
+Output
+  - The hash. Empty if the log has no entries.
+*/
+func (log *Log) LastHash() string {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+	return log.lastHash
+}
+
+/*
+Method Record adds an entry to the log like Append, but reports a failure to the error handler
+instead of returning it, so that the call being audited is not affected.
+Without an error handler, failures are logged by the default slog logger.
+
+Input
+  - entry: The entry.
+*/
+func (log *Log) Record(entry Entry) {
+	err := log.Append(entry)
+	if err == nil {
+		return
+	}
+	log.lock.Lock()
+	errorHandler := log.errorHandler
+	log.lock.Unlock()
+	if errorHandler == nil {
+		slog.Error("audit: cannot append entry", "componentID", entry.ComponentID, "operation", entry.Operation, "error", err)
+		return
+	}
+	errorHandler(entry, err)
+}
+
+/*
+Method SetErrorHandler sets the function called by Record when an entry cannot be appended,
+such as to alert an operator or to stop accepting requests.
+
+Input
+  - errorHandler: The function. nil restores logging by the default slog logger.
+*/
+func (log *Log) SetErrorHandler(errorHandler ErrorHandler) {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+	log.errorHandler = errorHandler
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// The line of an entry, without its newline.
+func encode(entry Entry) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(entry); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+// The hash of an entry: SHA-256 of its line with an empty Hash.
+func hashOf(entry Entry) string {
+	entry.Hash = ""
+	encoded, err := encode(entry)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
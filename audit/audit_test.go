@@ -0,0 +1,192 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	printResults = false
+	withInfo     = `{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001", "AFFECTED_ENTITIES": [{"ENTITY_ID": 1}, {"ENTITY_ID": 100001}]}`
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestAudit_ActorFromContext(test *testing.T) {
+	ctx := context.TODO()
+	assert.Empty(test, ActorFromContext(ctx))
+	assert.Equal(test, "jane.doe", ActorFromContext(WithActor(ctx, "jane.doe")))
+}
+
+func TestAudit_AffectedEntities(test *testing.T) {
+	assert.Equal(test, []int64{1, 100001}, AffectedEntities(withInfo))
+	assert.Nil(test, AffectedEntities(""))
+	assert.Nil(test, AffectedEntities("{}"))
+	assert.Nil(test, AffectedEntities("{"))
+}
+
+func TestAudit_RecordKey(test *testing.T) {
+	dataSourceCode, recordID := RecordKey(withInfo)
+	assert.Equal(test, "CUSTOMERS", dataSourceCode)
+	assert.Equal(test, "1001", recordID)
+	dataSourceCode, recordID = RecordKey("not JSON")
+	assert.Empty(test, dataSourceCode)
+	assert.Empty(test, recordID)
+}
+
+func TestAudit_Open(test *testing.T) {
+	path := writeLog(test, 3)
+	auditLog, err := Open(path)
+	require.NoError(test, err)
+	lastHash := auditLog.LastHash()
+	require.NoError(test, auditLog.Append(Entry{ComponentID: 6004, Operation: "DeleteRecord", Outcome: Success}))
+	require.NoError(test, auditLog.Close())
+	require.NoError(test, auditLog.Close())
+	require.ErrorIs(test, auditLog.Append(Entry{}), ErrClosed)
+	summary := verifyFile(test, path)
+	assert.Equal(test, int64(4), summary.Entries)
+	lines := readLines(test, path)
+	printActual(test, lines[3])
+	assert.Contains(test, lines[3], `"sequence":4,`)
+	assert.Contains(test, lines[3], `"previousHash":"`+lastHash+`"`)
+}
+
+func TestAudit_Open_tampered(test *testing.T) {
+	path := writeLog(test, 2)
+	lines := readLines(test, path)
+	lines[0] = strings.Replace(lines[0], `"recordID":"1"`, `"recordID":"2"`, 1)
+	require.NoError(test, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), fileMode))
+	_, err := Open(path)
+	require.ErrorIs(test, err, ErrTampered)
+}
+
+func TestAudit_Verify(test *testing.T) {
+	path := writeLog(test, 3)
+	summary := verifyFile(test, path)
+	assert.Equal(test, int64(3), summary.Entries)
+	assert.Len(test, summary.LastHash, 64)
+	summary, err := Verify(strings.NewReader(""))
+	require.NoError(test, err)
+	assert.Equal(test, Summary{}, summary)
+}
+
+func TestAudit_Verify_tampered(test *testing.T) {
+	path := writeLog(test, 3)
+	lines := readLines(test, path)
+	testCases := []struct {
+		name  string
+		lines []string
+	}{
+		{name: "changed", lines: []string{lines[0], strings.Replace(lines[1], `"success"`, `"failure"`, 1), lines[2]}},
+		{name: "removed", lines: []string{lines[0], lines[2]}},
+		{name: "reordered", lines: []string{lines[1], lines[0], lines[2]}},
+		{name: "inserted", lines: []string{lines[0], lines[0], lines[1], lines[2]}},
+		{name: "unknown field", lines: []string{strings.Replace(lines[0], `{`, `{"extra":1,`, 1)}},
+		{name: "reformatted", lines: []string{strings.ReplaceAll(lines[0], `,"`, `, "`)}},
+		{name: "truncated line", lines: []string{lines[0], lines[1][:len(lines[1])/2]}},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			summary, err := Verify(strings.NewReader(strings.Join(testCase.lines, "\n") + "\n"))
+			printActual(test, err)
+			require.ErrorIs(test, err, ErrTampered)
+			assert.Less(test, summary.Entries, int64(len(testCase.lines)))
+		})
+	}
+}
+
+func TestAudit_Record(test *testing.T) {
+	path := writeLog(test, 1)
+	auditLog, err := Open(path)
+	require.NoError(test, err)
+	var failures []error
+	auditLog.SetErrorHandler(func(entry Entry, err error) {
+		assert.Equal(test, "DeleteRecord", entry.Operation)
+		failures = append(failures, err)
+	})
+	auditLog.Record(Entry{ComponentID: 6004, Operation: "DeleteRecord", Outcome: Success})
+	assert.Empty(test, failures)
+	require.NoError(test, auditLog.Close())
+	auditLog.Record(Entry{ComponentID: 6004, Operation: "DeleteRecord", Outcome: Success})
+	require.Len(test, failures, 1)
+	require.ErrorIs(test, failures[0], ErrClosed)
+	auditLog.SetErrorHandler(nil)
+	auditLog.Record(Entry{ComponentID: 6004, Operation: "DeleteRecord", Outcome: Success})
+	assert.Len(test, failures, 1)
+	assert.Equal(test, int64(2), verifyFile(test, path).Entries)
+}
+
+func TestAudit_Append_canonical(test *testing.T) {
+	path := filepath.Join(test.TempDir(), "audit.jsonl")
+	auditLog, err := Open(path)
+	require.NoError(test, err)
+	entry := Entry{
+		ComponentID: 6002,
+		Details:     map[string]string{"configComment": "<&> \"quoted\""},
+		Error:       "SENZ0033|Unknown record",
+		Operation:   "AddConfig",
+		Outcome:     Failure,
+	}
+	require.NoError(test, auditLog.Append(entry))
+	require.NoError(test, auditLog.Close())
+	summary := verifyFile(test, path)
+	assert.Equal(test, int64(1), summary.Entries)
+	assert.Empty(test, entry.Hash)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %v", actual)
+	}
+}
+
+func readLines(test *testing.T, path string) []string {
+	test.Helper()
+	content, err := os.ReadFile(path)
+	require.NoError(test, err)
+	return strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+}
+
+func verifyFile(test *testing.T, path string) Summary {
+	test.Helper()
+	content, err := os.ReadFile(path)
+	require.NoError(test, err)
+	summary, err := Verify(bytes.NewReader(content))
+	require.NoError(test, err)
+	return summary
+}
+
+func writeLog(test *testing.T, entries int) string {
+	test.Helper()
+	path := filepath.Join(test.TempDir(), "audit.jsonl")
+	auditLog, err := Open(path)
+	require.NoError(test, err)
+	ctx := WithActor(context.TODO(), "jane.doe")
+	for index := range entries {
+		entry := Entry{
+			Actor:            ActorFromContext(ctx),
+			AffectedEntities: AffectedEntities(withInfo),
+			ComponentID:      6004,
+			DataSourceCode:   "CUSTOMERS",
+			Operation:        "AddRecord",
+			Outcome:          Success,
+			RecordID:         string(rune('1' + index)),
+		}
+		require.NoError(test, auditLog.Append(entry))
+	}
+	require.NoError(test, auditLog.Close())
+	return path
+}
@@ -0,0 +1,43 @@
+/*
+Package audit records the mutating calls of Sz objects in a tamper-evident log.
+
+An audit [Log] is a local JSON-lines file. Each line is an [Entry] describing one call:
+who made it, when, what it changed, whether it succeeded and which entities it affected.
+Each entry holds the SHA-256 hash of the previous one, so changing, inserting, reordering or removing
+entries, other than the last ones, breaks the chain, which [Verify] detects.
+
+Audit logging is opt-in. Pass a Log to the SetAuditLog method of [szengine.Szengine] or
+[szconfigmanager.Szconfigmanager], or set the AuditLog of an [szabstractfactory.Szabstractfactory]:
+
+	auditLog, err := audit.Open("/var/log/senzing/audit.jsonl")
+	szEngine.SetAuditLog(ctx, auditLog)
+	ctx = audit.WithActor(ctx, "jane.doe")
+	szEngine.AddRecord(ctx, "CUSTOMERS", "1001", record, senzing.SzWithInfo)
+
+The calls audited are:
+
+	Component        Methods
+	szengine         AddRecord, DeleteRecord, ProcessRedoRecord, ReevaluateEntity, ReevaluateRecord
+	szconfigmanager  AddConfig, ReplaceDefaultConfigID, SetDefaultConfigID
+
+Failed calls are audited too. The affected entities are only known for calls made with the SzWithInfo flag.
+Auditing never changes the outcome of a call: Senzing has already applied the change when the entry is appended.
+If an entry cannot be appended, the failure is passed to the [ErrorHandler] of the log, or logged by the default slog logger:
+
+	auditLog.SetErrorHandler(func(entry audit.Entry, err error) {
+		alerts <- fmt.Errorf("audit entry %s lost: %w", entry.Operation, err)
+	})
+
+To detect the removal of the last entries, keep the LastHash of the log, or of the [Summary] returned by Verify,
+outside of the log, and compare it later:
+
+	summary, err := audit.Verify(file)
+	if err == nil && summary.LastHash != anchoredHash {
+		// Entries were removed.
+	}
+
+[szabstractfactory.Szabstractfactory]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szabstractfactory#Szabstractfactory
+[szconfigmanager.Szconfigmanager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfigmanager#Szconfigmanager
+[szengine.Szengine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine#Szengine
+*/
+package audit
@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Entry struct is one line of an audit log, describing one mutating call.
+Sequence, Time, PreviousHash and Hash are set by Append.
+*/
+type Entry struct {
+	Sequence         int64             `json:"sequence"`                   // Position in the log, from 1.
+	Time             time.Time         `json:"time"`                       // When the call completed, in UTC.
+	Actor            string            `json:"actor,omitempty"`            // The caller, as set by WithActor.
+	RequestID        string            `json:"requestID,omitempty"`        // The request ID, as set by notification.WithRequestID.
+	ComponentID      int               `json:"componentID"`                // The component called, such as 6004 for szengine.
+	Operation        string            `json:"operation"`                  // The method called, such as "AddRecord".
+	DataSourceCode   string            `json:"dataSourceCode,omitempty"`   // The data source of the record, if any.
+	RecordID         string            `json:"recordID,omitempty"`         // The identifier of the record, if any.
+	EntityID         int64             `json:"entityID,omitempty"`         // The entity, if any.
+	ConfigID         int64             `json:"configID,omitempty"`         // The configuration ID, if any.
+	Details          map[string]string `json:"details,omitempty"`          // Other arguments of the call.
+	Outcome          string            `json:"outcome"`                    // Success or Failure.
+	Error            string            `json:"error,omitempty"`            // The error of a failed call.
+	AffectedEntities []int64           `json:"affectedEntities,omitempty"` // The entities changed, if the call returned them.
+	PreviousHash     string            `json:"previousHash"`               // The Hash of the previous entry. Empty for the first.
+	Hash             string            `json:"hash"`                       // SHA-256 of the entry, with an empty Hash, in hexadecimal.
+}
+
+/*
+Type ErrorHandler func is called by Record with an entry that could not be appended and the reason.
+*/
+type ErrorHandler func(entry Entry, err error)
+
+/*
+Type Log struct appends hash-chained entries to a JSON-lines file.
+A Log may be used by several Sz objects and goroutines at once.
+*/
+type Log struct {
+	errorHandler ErrorHandler
+	file         *os.File
+	lastHash     string
+	lock         sync.Mutex
+	sequence     int64
+}
+
+/*
+Type Summary struct describes a verified audit log.
+*/
+type Summary struct {
+	Entries  int64  // Number of entries.
+	LastHash string // Hash of the last entry. Empty if there are none.
+}
+
+type contextKey struct{}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Outcomes of a call.
+const (
+	Failure = "failure"
+	Success = "success"
+)
+
+// Mode of a file created by Open.
+const fileMode = 0o600
+
+// Longest line read by Verify.
+const maxLineLength = 64 * 1024 * 1024
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var (
+	// ErrClosed is returned by Append after Close.
+	ErrClosed = errors.New("audit log is closed")
+
+	// ErrTampered is returned when an audit log does not verify.
+	ErrTampered = errors.New("audit log has been tampered with")
+)
@@ -0,0 +1,203 @@
+package callstats
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szmetrics"
+)
+
+// ----------------------------------------------------------------------------
+// Interface functions
+// ----------------------------------------------------------------------------
+
+/*
+The Write function writes reports as a text table, one line per method, such as:
+
+	COMPONENT  METHOD     CALLS  ERRORS  MEAN   P50    P90    P99    MAX
+	szengine   AddRecord  1000   2       1.2ms  1.1ms  1.9ms  4.7ms  12ms
+
+Input
+  - writer: Where the table is written.
+  - reports: The reports, such as those returned by the GetCallStatistics methods of the Sz objects.
+*/
+func Write(writer io.Writer, reports ...Report) error {
+	tableWriter := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tableWriter, "COMPONENT\tMETHOD\tCALLS\tERRORS\tMEAN\tP50\tP90\tP99\tMAX")
+	for _, report := range reports {
+		component := componentName(report.ComponentID)
+		for _, method := range report.Methods {
+			fmt.Fprintf(tableWriter, "%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\n",
+				component, method.Method, method.Calls, method.Errors,
+				formatDuration(method.Mean), formatDuration(method.P50), formatDuration(method.P90),
+				formatDuration(method.P99), formatDuration(method.Maximum))
+		}
+	}
+	return tableWriter.Flush()
+}
+
+// ----------------------------------------------------------------------------
+// Statistics methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Observe records a method call.
+It is meant to be deferred at the start of the method:
+
+	defer statistics.Observe("AddRecord", time.Now(), &err)
+
+Input
+  - method: The name of the method.
+  - entryTime: When the call started.
+  - err: The error returned by the call, read when Observe runs. May be nil.
+*/
+func (statistics *Statistics) Observe(method string, entryTime time.Time, err *error) {
+	duration := time.Since(entryTime)
+	sketch := statistics.getTable().sketch(method)
+	sketch.calls.Add(1)
+	if err != nil && *err != nil {
+		sketch.errors.Add(1)
+	}
+	sketch.total.Add(int64(duration))
+	for {
+		maximum := sketch.maximum.Load()
+		if int64(duration) <= maximum || sketch.maximum.CompareAndSwap(maximum, int64(duration)) {
+			break
+		}
+	}
+	sketch.buckets[bucketIndex(duration)].Add(1)
+}
+
+/*
+Method Reset forgets every call recorded.
+Calls completing while Reset runs may be recorded before or after it.
+*/
+func (statistics *Statistics) Reset() {
+	statistics.table.Store(&table{})
+}
+
+/*
+Method Snapshot returns the statistics of the methods called since the Statistics was created or reset.
+
+Output
+  - The statistics, sorted by method name.
+*/
+func (statistics *Statistics) Snapshot() []MethodStatistics {
+	result := []MethodStatistics{}
+	statistics.getTable().methods.Range(func(key, value any) bool {
+		method, _ := key.(string)
+		sketch, _ := value.(*methodSketch)
+		result = append(result, sketch.summarize(method))
+		return true
+	})
+	sort.Slice(result, func(i, j int) bool { return result[i].Method < result[j].Method })
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// The current table, created on first use.
+func (statistics *Statistics) getTable() *table {
+	current := statistics.table.Load()
+	if current != nil {
+		return current
+	}
+	statistics.table.CompareAndSwap(nil, &table{})
+	return statistics.table.Load()
+}
+
+// The latency at a quantile: the middle of the bucket holding it, capped by the maximum.
+func (sketch *methodSketch) quantile(counts []uint64, calls uint64, quantile float64) time.Duration {
+	if calls == 0 {
+		return 0
+	}
+	rank := max(uint64(math.Ceil(quantile*float64(calls))), 1)
+	var cumulative uint64
+	for index, count := range counts {
+		cumulative += count
+		if cumulative >= rank {
+			return min(bucketMiddle(index), time.Duration(sketch.maximum.Load()))
+		}
+	}
+	return time.Duration(sketch.maximum.Load())
+}
+
+// The statistics of a method from its sketch.
+func (sketch *methodSketch) summarize(method string) MethodStatistics {
+	counts := make([]uint64, bucketCount)
+	var calls uint64
+	for index := range counts {
+		counts[index] = sketch.buckets[index].Load()
+		calls += counts[index]
+	}
+	result := MethodStatistics{
+		Method:  method,
+		Calls:   sketch.calls.Load(),
+		Errors:  sketch.errors.Load(),
+		Total:   time.Duration(sketch.total.Load()),
+		P50:     sketch.quantile(counts, calls, 0.50),
+		P90:     sketch.quantile(counts, calls, 0.90),
+		P99:     sketch.quantile(counts, calls, 0.99),
+		Maximum: time.Duration(sketch.maximum.Load()),
+	}
+	if result.Calls > 0 {
+		result.Mean = result.Total / time.Duration(result.Calls)
+	}
+	return result
+}
+
+// The sketch of a method, created on first use.
+func (methods *table) sketch(method string) *methodSketch {
+	value, isFound := methods.methods.Load(method)
+	if !isFound {
+		value, _ = methods.methods.LoadOrStore(method, &methodSketch{})
+	}
+	result, _ := value.(*methodSketch)
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// The bucket of a latency.
+func bucketIndex(duration time.Duration) int {
+	if duration <= 1 {
+		return 0
+	}
+	index := int(math.Ceil(math.Log(float64(duration)) / logGrowth))
+	return min(index, bucketCount-1)
+}
+
+// The middle of a bucket, between its lower and upper bounds.
+func bucketMiddle(index int) time.Duration {
+	if index == 0 {
+		return 1
+	}
+	upper := math.Pow(growth, float64(index))
+	return time.Duration(math.Round((upper/growth + upper) / 2))
+}
+
+// The name of a component, such as "szengine" for 6004.
+func componentName(componentID int) string {
+	if name, isFound := szmetrics.ComponentNames[componentID]; isFound {
+		return name
+	}
+	return strconv.Itoa(componentID)
+}
+
+// A duration rounded to 3 significant digits, such as "1.23ms".
+func formatDuration(duration time.Duration) string {
+	unit := time.Duration(1)
+	for duration/unit >= 1000 {
+		unit *= 10
+	}
+	return duration.Round(unit).String()
+}
@@ -0,0 +1,136 @@
+package callstats
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const printResults = false
+
+var errTest = errors.New("test error")
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestCallstats_Observe(test *testing.T) {
+	statistics := &Statistics{}
+	var err error
+	for milliseconds := 1; milliseconds <= 100; milliseconds++ {
+		statistics.Observe("AddRecord", time.Now().Add(-time.Duration(milliseconds)*time.Millisecond), &err)
+	}
+	err = errTest
+	statistics.Observe("GetEntityByEntityID", time.Now(), &err)
+	statistics.Observe("GetEntityByEntityID", time.Now(), nil)
+	actual := statistics.Snapshot()
+	printActual(test, actual)
+	require.Len(test, actual, 2)
+	addRecord := actual[0]
+	assert.Equal(test, "AddRecord", addRecord.Method)
+	assert.Equal(test, uint64(100), addRecord.Calls)
+	assert.Equal(test, uint64(0), addRecord.Errors)
+	assertNear(test, 50*time.Millisecond, addRecord.P50)
+	assertNear(test, 90*time.Millisecond, addRecord.P90)
+	assertNear(test, 99*time.Millisecond, addRecord.P99)
+	assertNear(test, 50500*time.Microsecond, addRecord.Mean)
+	assert.GreaterOrEqual(test, addRecord.Maximum, 100*time.Millisecond)
+	assert.LessOrEqual(test, addRecord.P99, addRecord.Maximum)
+	assert.Equal(test, addRecord.Total/time.Duration(addRecord.Calls), addRecord.Mean)
+	assert.Equal(test, "GetEntityByEntityID", actual[1].Method)
+	assert.Equal(test, uint64(2), actual[1].Calls)
+	assert.Equal(test, uint64(1), actual[1].Errors)
+}
+
+func TestCallstats_Observe_concurrent(test *testing.T) {
+	statistics := &Statistics{}
+	var waitGroup sync.WaitGroup
+	for range 8 {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for range 1000 {
+				var err error
+				statistics.Observe("AddRecord", time.Now(), &err)
+			}
+		}()
+	}
+	waitGroup.Wait()
+	actual := statistics.Snapshot()
+	require.Len(test, actual, 1)
+	assert.Equal(test, uint64(8000), actual[0].Calls)
+}
+
+func TestCallstats_Reset(test *testing.T) {
+	statistics := &Statistics{}
+	statistics.Reset()
+	statistics.Observe("AddRecord", time.Now(), nil)
+	require.Len(test, statistics.Snapshot(), 1)
+	statistics.Reset()
+	assert.Empty(test, statistics.Snapshot())
+	statistics.Observe("DeleteRecord", time.Now(), nil)
+	actual := statistics.Snapshot()
+	require.Len(test, actual, 1)
+	assert.Equal(test, "DeleteRecord", actual[0].Method)
+}
+
+func TestCallstats_Snapshot_empty(test *testing.T) {
+	statistics := &Statistics{}
+	actual := statistics.Snapshot()
+	assert.NotNil(test, actual)
+	assert.Empty(test, actual)
+}
+
+func TestCallstats_Write(test *testing.T) {
+	statistics := &Statistics{}
+	statistics.Observe("AddRecord", time.Now().Add(-1234567*time.Nanosecond), nil)
+	var buffer bytes.Buffer
+	err := Write(&buffer, Report{ComponentID: 6004, Methods: statistics.Snapshot()}, Report{ComponentID: 9999})
+	require.NoError(test, err)
+	actual := buffer.String()
+	printActual(test, actual)
+	assert.Regexp(test, `^COMPONENT +METHOD +CALLS +ERRORS +MEAN +P50 +P90 +P99 +MAX\n`, actual)
+	assert.Regexp(test, `\nszengine +AddRecord +1 +0 +1\.2\dms +`, actual)
+}
+
+// ----------------------------------------------------------------------------
+// Test internal functions
+// ----------------------------------------------------------------------------
+
+func TestCallstats_bucketIndex(test *testing.T) {
+	assert.Equal(test, 0, bucketIndex(-time.Second))
+	assert.Equal(test, 0, bucketIndex(1))
+	assert.Equal(test, bucketCount-1, bucketIndex(1000*time.Hour))
+	for _, duration := range []time.Duration{2, 999, time.Microsecond, 7 * time.Millisecond, 3 * time.Second} {
+		index := bucketIndex(duration)
+		assertNear(test, duration, bucketMiddle(index))
+	}
+}
+
+func TestCallstats_formatDuration(test *testing.T) {
+	assert.Equal(test, "999ns", formatDuration(999))
+	assert.Equal(test, "1.23ms", formatDuration(1234567))
+	assert.Equal(test, "123ms", formatDuration(123456789))
+	assert.Equal(test, "12.3s", formatDuration(12345678901))
+	assert.Equal(test, "0s", formatDuration(0))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func assertNear(test *testing.T, expected time.Duration, actual time.Duration) {
+	test.Helper()
+	assert.InEpsilon(test, float64(expected), float64(actual), 0.05)
+}
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %v", actual)
+	}
+}
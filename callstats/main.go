@@ -0,0 +1,74 @@
+package callstats
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type MethodStatistics struct summarizes the calls of one method.
+Latencies are estimated from a sketch, within about 2.5% of the exact value.
+*/
+type MethodStatistics struct {
+	Method  string        `json:"method"`  // The method, such as "AddRecord".
+	Calls   uint64        `json:"calls"`   // Number of calls.
+	Errors  uint64        `json:"errors"`  // Number of failed calls.
+	Total   time.Duration `json:"total"`   // Time spent in all calls.
+	Mean    time.Duration `json:"mean"`    // Average latency.
+	P50     time.Duration `json:"p50"`     // Median latency.
+	P90     time.Duration `json:"p90"`     // 90th percentile latency.
+	P99     time.Duration `json:"p99"`     // 99th percentile latency.
+	Maximum time.Duration `json:"maximum"` // Longest latency.
+}
+
+/*
+Type Report struct holds the call statistics of a component.
+*/
+type Report struct {
+	ComponentID int                `json:"componentID"` // The component, such as 6004 for szengine.
+	Methods     []MethodStatistics `json:"methods"`     // The methods called, sorted by name.
+}
+
+/*
+Type Statistics struct counts the calls of the methods of a component and sketches their latency.
+The zero value is ready to use. Its methods may be called concurrently, and Observe does not lock.
+*/
+type Statistics struct {
+	table atomic.Pointer[table]
+}
+
+// The sketch of one method: counters and a histogram of latencies in exponentially growing buckets.
+type methodSketch struct {
+	buckets [bucketCount]atomic.Uint64
+	calls   atomic.Uint64
+	errors  atomic.Uint64
+	maximum atomic.Int64
+	total   atomic.Int64
+}
+
+// The sketches of the methods called since the Statistics was created or reset.
+type table struct {
+	methods sync.Map // method name -> *methodSketch
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Ratio between the upper bounds of consecutive buckets. Bucket i holds latencies in (growth^(i-1), growth^i] nanoseconds.
+const growth = 1.05
+
+// Number of buckets. The last one, from about 9 hours, also holds longer latencies.
+const bucketCount = 640
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var logGrowth = math.Log(growth)
@@ -0,0 +1,236 @@
+package dispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/go-observing/subject"
+)
+
+// ----------------------------------------------------------------------------
+// Constructor
+// ----------------------------------------------------------------------------
+
+/*
+The New function returns a dispatcher with a bounded queue.
+
+Input
+  - capacity: The number of notifications queued before the policy applies. If not positive, DefaultCapacity is used.
+  - policy: What Dispatch does when the queue is full.
+
+Output
+  - The dispatcher.
+*/
+func New(capacity int, policy Policy) *Dispatcher {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return &Dispatcher{
+		policy: policy,
+		queue:  make(chan notification, capacity),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Dispatcher methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Dispatch queues a notification for the observers registered now.
+The message has the same JSON format as one sent by notifier.Notify of go-observing,
+with "messageTime" set when Dispatch is called.
+
+Observers run on the delivering goroutine, so with the Block policy an observer whose call
+leads back to the same dispatcher cannot wait for room in a full queue.
+Such a call, recognized by the context the observer was given, drops the notification instead.
+
+Input
+  - ctx: A context to control lifecycle. It is passed to the observers.
+  - observers: The observers to notify.
+  - origin: The "origin" value of the message. Omitted if empty.
+  - subjectID: The component ID of the sender.
+  - messageID: The identifier of the message.
+  - err: The error of the call, if any.
+  - details: Key/value pairs of the message.
+
+Output
+  - False if the notification was dropped.
+*/
+func (dispatcher *Dispatcher) Dispatch(ctx context.Context, observers subject.Subject, origin string, subjectID int, messageID int, err error, details map[string]string) bool {
+	if observers == nil {
+		return true
+	}
+	return dispatcher.DispatchTo(ctx, observers.GetObservers(ctx), origin, subjectID, messageID, err, details)
+}
+
+/*
+Method DispatchTo queues a notification for a list of observers,
+such as those of a subject whose filters accept the notification.
+The message is the one Dispatch would send, and a full queue is handled as in Dispatch.
+
+Input
+  - ctx: A context to control lifecycle. It is passed to the observers.
+  - observers: The observers to notify. The list must not be changed afterwards.
+  - origin: The "origin" value of the message. Omitted if empty.
+  - subjectID: The component ID of the sender.
+  - messageID: The identifier of the message.
+  - err: The error of the call, if any.
+  - details: Key/value pairs of the message.
+
+Output
+  - False if the notification was dropped.
+*/
+func (dispatcher *Dispatcher) DispatchTo(ctx context.Context, observers []observer.Observer, origin string, subjectID int, messageID int, err error, details map[string]string) bool {
+	if len(observers) == 0 {
+		return true
+	}
+	item := notification{
+		ctx:       ctx,
+		message:   newMessage(origin, subjectID, messageID, err, details),
+		observers: observers,
+	}
+	if !dispatcher.enqueue(ctx, item, dispatcher.policy) {
+		atomic.AddUint64(&dispatcher.dropped, 1)
+		return false
+	}
+	return true
+}
+
+/*
+Method Dropped returns the number of notifications that were not delivered
+because the queue was full, the context of Dispatch was done,
+or an observer dispatched to its own full dispatcher.
+
+Output
+  - The count since the dispatcher was created.
+*/
+func (dispatcher *Dispatcher) Dropped() uint64 {
+	return atomic.LoadUint64(&dispatcher.dropped)
+}
+
+/*
+Method Flush waits until the notifications queued before it have been delivered.
+
+Input
+  - ctx: A context to control lifecycle. Flush returns its error if it is done first,
+    or ErrReentrantFlush if it is the context given to an observer of this dispatcher.
+*/
+func (dispatcher *Dispatcher) Flush(ctx context.Context) error {
+	if dispatcher.isDelivering(ctx) {
+		return ErrReentrantFlush
+	}
+	item := notification{flushed: make(chan struct{})}
+	if !dispatcher.enqueue(ctx, item, Block) {
+		return ctx.Err()
+	}
+	select {
+	case <-item.flushed:
+		return nil
+	default:
+	}
+	select {
+	case <-item.flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Deliver an item, telling observers through their context that they run on this dispatcher.
+func (dispatcher *Dispatcher) deliver(item notification) {
+	if item.flushed != nil {
+		close(item.flushed)
+		return
+	}
+	ctx := context.WithValue(item.ctx, deliveringKey{dispatcher: dispatcher}, true)
+	for _, observer := range item.observers {
+		observer.UpdateObserver(ctx, item.message)
+	}
+}
+
+// Queue an item and make sure the delivering goroutine runs.
+func (dispatcher *Dispatcher) enqueue(ctx context.Context, item notification, policy Policy) bool {
+	switch policy {
+	case Drop:
+		select {
+		case dispatcher.queue <- item:
+		default:
+			return false
+		}
+	default:
+		// Send without waiting first, so a done context only matters when the queue is full.
+		select {
+		case dispatcher.queue <- item:
+		default:
+			if dispatcher.isDelivering(ctx) {
+				return false // Only the delivering goroutine, which is calling, could make room.
+			}
+			select {
+			case dispatcher.queue <- item:
+			case <-ctx.Done():
+				return false
+			}
+		}
+	}
+	dispatcher.lock.Lock()
+	defer dispatcher.lock.Unlock()
+	if !dispatcher.isRunning {
+		dispatcher.isRunning = true
+		go dispatcher.run()
+	}
+	return true
+}
+
+// Report whether ctx is, or derives from, the context given to an observer of this dispatcher.
+func (dispatcher *Dispatcher) isDelivering(ctx context.Context) bool {
+	return ctx.Value(deliveringKey{dispatcher: dispatcher}) != nil
+}
+
+// Deliver queued items in order until the queue is empty.
+func (dispatcher *Dispatcher) run() {
+	for {
+		select {
+		case item := <-dispatcher.queue:
+			dispatcher.deliver(item)
+		default:
+			dispatcher.lock.Lock()
+			if len(dispatcher.queue) == 0 {
+				dispatcher.isRunning = false
+				dispatcher.lock.Unlock()
+				return
+			}
+			dispatcher.lock.Unlock()
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Build a message as notifier.Notify of go-observing does.
+func newMessage(origin string, subjectID int, messageID int, err error, details map[string]string) string {
+	message := make(map[string]string, len(details)+5)
+	for key, value := range details {
+		message[key] = value
+	}
+	if len(origin) > 0 {
+		message["origin"] = origin
+	}
+	message["subjectId"] = strconv.Itoa(subjectID)
+	message["messageId"] = strconv.Itoa(messageID)
+	message["messageTime"] = time.Now().UTC().Format(time.RFC3339Nano)
+	if err != nil {
+		message["error"] = err.Error()
+	}
+	result, _ := json.Marshal(message) // A map[string]string always marshals.
+	return string(result)
+}
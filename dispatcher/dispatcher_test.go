@@ -0,0 +1,299 @@
+package dispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/go-observing/subject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	componentID  = 6004
+	printResults = false
+)
+
+type recordingObserver struct {
+	id       string
+	lock     sync.Mutex
+	messages []map[string]string
+	release  chan struct{}
+}
+
+// Type reentrantObserver calls its dispatcher again when notified.
+type reentrantObserver struct {
+	dispatcher *Dispatcher
+	done       chan struct{}
+	flushErr   error
+	isQueued   []bool
+	subject    subject.Subject
+}
+
+func (observer *recordingObserver) GetObserverID(ctx context.Context) string {
+	_ = ctx
+	return observer.id
+}
+
+func (observer *recordingObserver) UpdateObserver(ctx context.Context, message string) {
+	_ = ctx
+	if observer.release != nil {
+		<-observer.release
+	}
+	decoded := map[string]string{}
+	_ = json.Unmarshal([]byte(message), &decoded)
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	observer.messages = append(observer.messages, decoded)
+}
+
+func (observer *recordingObserver) received() []map[string]string {
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	return append([]map[string]string{}, observer.messages...)
+}
+
+func (observer *reentrantObserver) GetObserverID(ctx context.Context) string {
+	_ = ctx
+	return "reentrant"
+}
+
+func (observer *reentrantObserver) UpdateObserver(ctx context.Context, message string) {
+	decoded := map[string]string{}
+	_ = json.Unmarshal([]byte(message), &decoded)
+	if decoded["messageId"] != "8001" {
+		return
+	}
+	for range 2 {
+		isQueued := observer.dispatcher.Dispatch(ctx, observer.subject, "", componentID, 8002, nil, map[string]string{})
+		observer.isQueued = append(observer.isQueued, isQueued)
+	}
+	observer.flushErr = observer.dispatcher.Flush(ctx)
+	close(observer.done)
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestDispatcher_Dispatch(test *testing.T) {
+	ctx := context.TODO()
+	observers, observer := newObservers(ctx, test, nil)
+	dispatcher := New(0, Block)
+	isQueued := dispatcher.Dispatch(ctx, observers, "test", componentID, 8001, errors.New("failed"), map[string]string{"recordID": "1001"})
+	require.True(test, isQueued)
+	require.NoError(test, dispatcher.Flush(ctx))
+	messages := observer.received()
+	require.Len(test, messages, 1)
+	printActual(test, messages[0])
+	assert.Equal(test, "1001", messages[0]["recordID"])
+	assert.Equal(test, "test", messages[0]["origin"])
+	assert.Equal(test, "6004", messages[0]["subjectId"])
+	assert.Equal(test, "8001", messages[0]["messageId"])
+	assert.Equal(test, "failed", messages[0]["error"])
+	assert.NotEmpty(test, messages[0]["messageTime"])
+}
+
+func TestDispatcher_Dispatch_inOrder(test *testing.T) {
+	ctx := context.TODO()
+	observers, observer := newObservers(ctx, test, nil)
+	dispatcher := New(16, Block)
+	for index := range 1000 {
+		dispatcher.Dispatch(ctx, observers, "", componentID, 8001, nil, map[string]string{"index": strconv.Itoa(index)})
+	}
+	require.NoError(test, dispatcher.Flush(ctx))
+	messages := observer.received()
+	require.Len(test, messages, 1000)
+	for index, message := range messages {
+		assert.Equal(test, strconv.Itoa(index), message["index"])
+		assert.NotContains(test, message, "origin")
+		assert.NotContains(test, message, "error")
+	}
+	assert.Zero(test, dispatcher.Dropped())
+}
+
+func TestDispatcher_Dispatch_boundedGoroutines(test *testing.T) {
+	ctx := context.TODO()
+	release := make(chan struct{})
+	observers, observer := newObservers(ctx, test, release)
+	dispatcher := New(100, Drop)
+	before := runtime.NumGoroutine()
+	for range 10000 {
+		dispatcher.Dispatch(ctx, observers, "", componentID, 8001, nil, map[string]string{})
+	}
+	assert.LessOrEqual(test, runtime.NumGoroutine(), before+1)
+	close(release)
+	require.NoError(test, dispatcher.Flush(ctx))
+	delivered := uint64(len(observer.received()))
+	assert.Equal(test, uint64(10000), delivered+dispatcher.Dropped())
+	assert.Positive(test, dispatcher.Dropped())
+}
+
+func TestDispatcher_Dispatch_drop(test *testing.T) {
+	ctx := context.TODO()
+	release := make(chan struct{})
+	observers, observer := newObservers(ctx, test, release)
+	dispatcher := New(1, Drop)
+	dispatcher.Dispatch(ctx, observers, "", componentID, 8001, nil, map[string]string{})
+	waitForEmptyQueue(test, dispatcher)
+	assert.True(test, dispatcher.Dispatch(ctx, observers, "", componentID, 8002, nil, map[string]string{}))
+	assert.False(test, dispatcher.Dispatch(ctx, observers, "", componentID, 8003, nil, map[string]string{}))
+	assert.Equal(test, uint64(1), dispatcher.Dropped())
+	close(release)
+	require.NoError(test, dispatcher.Flush(ctx))
+	messages := observer.received()
+	require.Len(test, messages, 2)
+	assert.Equal(test, "8001", messages[0]["messageId"])
+	assert.Equal(test, "8002", messages[1]["messageId"])
+}
+
+func TestDispatcher_Dispatch_block(test *testing.T) {
+	ctx := context.TODO()
+	release := make(chan struct{})
+	observers, observer := newObservers(ctx, test, release)
+	dispatcher := New(1, Block)
+	dispatcher.Dispatch(ctx, observers, "", componentID, 8001, nil, map[string]string{})
+	waitForEmptyQueue(test, dispatcher)
+	dispatcher.Dispatch(ctx, observers, "", componentID, 8002, nil, map[string]string{})
+	isQueued := make(chan bool)
+	go func() {
+		isQueued <- dispatcher.Dispatch(ctx, observers, "", componentID, 8003, nil, map[string]string{})
+	}()
+	select {
+	case <-isQueued:
+		assert.Fail(test, "Dispatch did not block on a full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	assert.True(test, <-isQueued)
+	require.NoError(test, dispatcher.Flush(ctx))
+	assert.Len(test, observer.received(), 3)
+	assert.Zero(test, dispatcher.Dropped())
+}
+
+func TestDispatcher_Dispatch_blockReentrant(test *testing.T) {
+	ctx := context.TODO()
+	dispatcher := New(1, Block)
+	observers := &subject.SimpleSubject{}
+	observer := &reentrantObserver{dispatcher: dispatcher, done: make(chan struct{}), subject: observers}
+	require.NoError(test, observers.RegisterObserver(ctx, observer))
+	dispatcher.Dispatch(ctx, observers, "", componentID, 8001, nil, map[string]string{})
+	select {
+	case <-observer.done:
+	case <-time.After(5 * time.Second):
+		require.FailNow(test, "observer blocked on its own dispatcher")
+	}
+	require.NoError(test, dispatcher.Flush(ctx))
+	printActual(test, observer.isQueued)
+	assert.Equal(test, []bool{true, false}, observer.isQueued)
+	require.ErrorIs(test, observer.flushErr, ErrReentrantFlush)
+	assert.Equal(test, uint64(1), dispatcher.Dropped())
+}
+
+func TestDispatcher_Dispatch_blockCanceled(test *testing.T) {
+	ctx := context.TODO()
+	release := make(chan struct{})
+	defer close(release)
+	observers, _ := newObservers(ctx, test, release)
+	dispatcher := New(1, Block)
+	dispatcher.Dispatch(ctx, observers, "", componentID, 8001, nil, map[string]string{})
+	waitForEmptyQueue(test, dispatcher)
+	dispatcher.Dispatch(ctx, observers, "", componentID, 8002, nil, map[string]string{})
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.False(test, dispatcher.Dispatch(canceledCtx, observers, "", componentID, 8003, nil, map[string]string{}))
+	assert.Equal(test, uint64(1), dispatcher.Dropped())
+	timeoutCtx, cancelTimeout := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancelTimeout()
+	require.ErrorIs(test, dispatcher.Flush(timeoutCtx), context.DeadlineExceeded)
+}
+
+func TestDispatcher_Dispatch_canceledWithRoom(test *testing.T) {
+	ctx := context.TODO()
+	observers, observer := newObservers(ctx, test, nil)
+	dispatcher := New(1024, Block)
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	for index := range 1000 {
+		isQueued := dispatcher.Dispatch(canceledCtx, observers, "", componentID, 8001, nil, map[string]string{"index": strconv.Itoa(index)})
+		require.True(test, isQueued)
+	}
+	require.NoError(test, dispatcher.Flush(ctx))
+	assert.Len(test, observer.received(), 1000)
+	assert.Zero(test, dispatcher.Dropped())
+}
+
+func TestDispatcher_Dispatch_noObservers(test *testing.T) {
+	ctx := context.TODO()
+	dispatcher := New(1, Drop)
+	assert.True(test, dispatcher.Dispatch(ctx, nil, "", componentID, 8001, nil, map[string]string{}))
+	assert.True(test, dispatcher.Dispatch(ctx, &subject.SimpleSubject{}, "", componentID, 8001, nil, map[string]string{}))
+	assert.Empty(test, dispatcher.queue)
+}
+
+func TestDispatcher_Dispatch_concurrent(test *testing.T) {
+	ctx := context.TODO()
+	observers, observer := newObservers(ctx, test, nil)
+	dispatcher := New(8, Block)
+	var waitGroup sync.WaitGroup
+	for range 8 {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for range 100 {
+				dispatcher.Dispatch(ctx, observers, "", componentID, 8001, nil, map[string]string{})
+			}
+		}()
+	}
+	waitGroup.Wait()
+	require.NoError(test, dispatcher.Flush(ctx))
+	assert.Len(test, observer.received(), 800)
+}
+
+func TestDispatcher_DispatchTo(test *testing.T) {
+	ctx := context.TODO()
+	_, first := newObservers(ctx, test, nil)
+	_, second := newObservers(ctx, test, nil)
+	dispatcher := New(0, Block)
+	assert.True(test, dispatcher.DispatchTo(ctx, []observer.Observer{second}, "", componentID, 8001, nil, map[string]string{}))
+	assert.True(test, dispatcher.DispatchTo(ctx, nil, "", componentID, 8002, nil, map[string]string{}))
+	require.NoError(test, dispatcher.Flush(ctx))
+	assert.Empty(test, first.received())
+	messages := second.received()
+	require.Len(test, messages, 1)
+	assert.Equal(test, "8001", messages[0]["messageId"])
+}
+
+func TestDispatcher_Flush_empty(test *testing.T) {
+	ctx := context.TODO()
+	require.NoError(test, New(1, Drop).Flush(ctx))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func newObservers(ctx context.Context, test *testing.T, release chan struct{}) (subject.Subject, *recordingObserver) {
+	observer := &recordingObserver{id: "recorder", release: release}
+	observers := &subject.SimpleSubject{}
+	require.NoError(test, observers.RegisterObserver(ctx, observer))
+	return observers, observer
+}
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %v", actual)
+	}
+}
+
+// Wait until the delivering goroutine has taken every queued notification.
+func waitForEmptyQueue(test *testing.T, dispatcher *Dispatcher) {
+	require.Eventually(test, func() bool { return len(dispatcher.queue) == 0 }, time.Second, time.Millisecond)
+}
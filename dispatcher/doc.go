@@ -0,0 +1,16 @@
+/*
+Package dispatcher delivers observer notifications in order through a bounded queue.
+
+Each Sz object, such as an [szengine.Szengine], sends its notifications through a [Dispatcher].
+A single goroutine per Dispatcher delivers them, oldest first, and exits when the queue is empty,
+so a burst of calls no longer parks one goroutine per notification.
+When the queue is full, the [Policy] decides whether the caller waits ([Block]) or the notification
+is counted by Dropped and discarded ([Drop]).
+Flush waits until every queued notification has been delivered; Destroy calls it.
+An observer calling back into its own dispatcher never waits for it, as described in Dispatch and Flush.
+
+	szEngine.SetDispatcher(ctx, dispatcher.New(10000, dispatcher.Drop))
+
+[szengine.Szengine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine#Szengine
+*/
+package dispatcher
@@ -0,0 +1,60 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/senzing-garage/go-observing/observer"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Dispatcher struct queues notifications and delivers them to observers in order.
+Its methods may be called concurrently.
+*/
+type Dispatcher struct {
+	dropped   uint64
+	isRunning bool
+	lock      sync.Mutex
+	policy    Policy
+	queue     chan notification
+}
+
+// Type Policy chooses what Dispatch does when the queue is full.
+type Policy int
+
+// The key of a context passed to the observers of a Dispatcher.
+type deliveringKey struct {
+	dispatcher *Dispatcher
+}
+
+type notification struct {
+	ctx       context.Context
+	flushed   chan struct{}
+	message   string
+	observers []observer.Observer
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Policies for a full queue.
+const (
+	Block Policy = iota // Dispatch waits for room in the queue, or until its context is done. See Dispatch for observers.
+	Drop                // Dispatch discards the notification.
+)
+
+// DefaultCapacity is the queue capacity of the Dispatcher an Sz object creates when none is set.
+const DefaultCapacity = 1024
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// ErrReentrantFlush is returned by Flush when called by an observer of the same Dispatcher, which would wait for itself.
+var ErrReentrantFlush = errors.New("dispatcher: Flush called by an observer of the same dispatcher")
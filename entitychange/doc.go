@@ -0,0 +1,20 @@
+/*
+Package entitychange is used to describe the effect of a mutation of the Senzing datastore
+as events such as "entity created" or "entities merged" rather than bare entity IDs.
+
+A [Classifier] looks up the records of the entities involved before the mutation with [Classifier.Before],
+and the records of the affected entities after the mutation with [Classifier.After].
+Comparing which entity held each record before and after gives the [Change] list.
+[Classify] does the comparison on two [State] values without looking anything up.
+
+Mutations are the with-info variants of AddRecord, DeleteRecord, ProcessRedoRecord,
+ReevaluateEntity and ReevaluateRecord of [senzing.SzEngine].
+Before AddRecord, DeleteRecord or ReevaluateRecord, look up the record being mutated.
+Before ReevaluateEntity, look up the entity.
+Entities that merge with a new record are not known before the mutation;
+an affected entity which was not looked up and no longer exists is reported as merged
+into the entity that now holds the mutated record.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+package entitychange
@@ -0,0 +1,306 @@
+package entitychange
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// Type Classifier struct looks up entities before and after a mutation to classify its effect.
+type Classifier struct {
+	szEngine senzing.SzEngine
+}
+
+// Order of change types within one entity.
+var changeTypeOrder = map[ChangeType]int{
+	ChangeTypeEntityCreated:  0,
+	ChangeTypeEntitiesMerged: 1,
+	ChangeTypeEntitySplit:    2,
+	ChangeTypeRecordAdded:    3,
+	ChangeTypeRecordRemoved:  4,
+	ChangeTypeEntityDeleted:  5,
+	ChangeTypeEntityUpdated:  6,
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The New function creates a classifier.
+
+Input
+  - szEngine: The engine used to look up entities.
+
+Output
+  - A classifier.
+*/
+func New(szEngine senzing.SzEngine) *Classifier {
+	return &Classifier{szEngine: szEngine}
+}
+
+// ----------------------------------------------------------------------------
+// Classifier methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Before looks up the entities involved in a mutation before it is made.
+Records and entities which do not exist are skipped.
+
+Input
+  - ctx: A context to control lifecycle.
+  - recordKeys: The records to be mutated.
+  - entityIDs: The entities to be mutated.
+
+Output
+  - The records held by the entities.
+*/
+func (classifier *Classifier) Before(ctx context.Context, recordKeys []szmodel.RecordKey, entityIDs []int64) (*State, error) {
+	result := &State{Entities: map[int64][]szmodel.RecordKey{}}
+	for _, recordKey := range recordKeys {
+		response, err := classifier.szEngine.GetEntityByRecordID(ctx, recordKey.DataSource, recordKey.RecordID, EntityFlags)
+		if err := result.add(response, err); err != nil {
+			return nil, err
+		}
+	}
+	for _, entityID := range entityIDs {
+		if result.Has(entityID) {
+			continue
+		}
+		response, err := classifier.szEngine.GetEntityByEntityID(ctx, entityID, EntityFlags)
+		if err := result.add(response, err); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+/*
+Method After looks up the affected entities after a mutation and classifies its effect.
+
+Input
+  - ctx: A context to control lifecycle.
+  - before: The result of [Classifier.Before].
+  - withInfo: The with-info response of the mutation.
+
+Output
+  - The changes, ordered by entity ID.
+*/
+func (classifier *Classifier) After(ctx context.Context, before *State, withInfo *szmodel.WithInfo) ([]Change, error) {
+	after := &State{Entities: map[int64][]szmodel.RecordKey{}}
+	entityIDs := withInfo.AffectedEntityIDs()
+	for entityID := range before.Entities {
+		entityIDs = append(entityIDs, entityID)
+	}
+	for _, entityID := range entityIDs {
+		if after.Has(entityID) {
+			continue
+		}
+		response, err := classifier.szEngine.GetEntityByEntityID(ctx, entityID, EntityFlags)
+		if err := after.add(response, err); err != nil {
+			return nil, err
+		}
+	}
+	return Classify(before, after, withInfo), nil
+}
+
+// ----------------------------------------------------------------------------
+// Classification
+// ----------------------------------------------------------------------------
+
+/*
+The Classify function compares the records held by entities before and after a mutation.
+
+Input
+  - before: The entities looked up before the mutation.
+  - after: The affected entities that exist after the mutation.
+  - withInfo: The with-info response of the mutation.
+
+Output
+  - The changes, ordered by entity ID.
+*/
+func Classify(before *State, after *State, withInfo *szmodel.WithInfo) []Change {
+	beforeOwners := before.owners()
+	afterOwners := after.owners()
+	mutated := szmodel.RecordKey{DataSource: withInfo.DataSource, RecordID: withInfo.RecordID}
+	mutatedOwner, hasMutatedOwner := afterOwners[mutated]
+	_, wasMutatedKnown := beforeOwners[mutated]
+	explained := map[int64]bool{}
+	result := []Change{}
+	add := func(change Change) {
+		explained[change.EntityID] = true
+		for _, entityID := range change.RelatedEntityIDs {
+			explained[entityID] = true
+		}
+		result = append(result, change)
+	}
+
+	// Affected entities which were not looked up before and no longer exist
+	// can only have merged into the entity holding the mutated record.
+
+	vanished := []int64{}
+	for _, entityID := range withInfo.AffectedEntityIDs() {
+		if !before.Has(entityID) && !after.Has(entityID) {
+			vanished = append(vanished, entityID)
+		}
+	}
+
+	// Changes seen from the entities that exist after the mutation.
+
+	for _, entityID := range after.entityIDs() {
+		sources := map[int64]bool{}
+		added := []szmodel.RecordKey{}
+		for _, record := range after.Entities[entityID] {
+			owner, isKnown := beforeOwners[record]
+			switch {
+			case isKnown && owner != entityID:
+				sources[owner] = true
+			case !isKnown:
+				added = append(added, record)
+			}
+		}
+		if hasMutatedOwner && mutatedOwner == entityID {
+			for _, vanishedEntityID := range vanished {
+				sources[vanishedEntityID] = true
+			}
+		}
+		if !before.Has(entityID) && len(sources) == 0 {
+			records := after.Entities[entityID]
+			if len(records) == 1 && records[0] == mutated {
+				add(Change{Type: ChangeTypeEntityCreated, EntityID: entityID, Records: added})
+			} else if hasMutatedOwner && mutatedOwner == entityID {
+				add(Change{Type: ChangeTypeRecordAdded, EntityID: entityID, Records: []szmodel.RecordKey{mutated}})
+			}
+			continue
+		}
+		if len(sources) > 0 && (before.Has(entityID) || len(sources) > 1 || !after.Has(firstKey(sources))) {
+			add(Change{Type: ChangeTypeEntitiesMerged, EntityID: entityID, RelatedEntityIDs: sortedKeys(sources)})
+		}
+		if before.Has(entityID) && len(added) > 0 {
+			add(Change{Type: ChangeTypeRecordAdded, EntityID: entityID, Records: added})
+		} else if !before.Has(entityID) && hasMutatedOwner && mutatedOwner == entityID && !wasMutatedKnown {
+			add(Change{Type: ChangeTypeRecordAdded, EntityID: entityID, Records: []szmodel.RecordKey{mutated}})
+		}
+	}
+
+	// Changes seen from the entities that existed before the mutation.
+
+	for _, entityID := range before.entityIDs() {
+		targets := map[int64]bool{}
+		removed := []szmodel.RecordKey{}
+		for _, record := range before.Entities[entityID] {
+			owner, isKnown := afterOwners[record]
+			switch {
+			case isKnown && owner != entityID:
+				targets[owner] = true
+			case !isKnown:
+				removed = append(removed, record)
+			}
+		}
+		switch {
+		case after.Has(entityID):
+			if len(targets) > 0 {
+				add(Change{Type: ChangeTypeEntitySplit, EntityID: entityID, RelatedEntityIDs: sortedKeys(targets)})
+			}
+			if len(removed) > 0 {
+				add(Change{Type: ChangeTypeRecordRemoved, EntityID: entityID, Records: removed})
+			}
+		case len(targets) > 1:
+			add(Change{Type: ChangeTypeEntitySplit, EntityID: entityID, RelatedEntityIDs: sortedKeys(targets)})
+		case len(targets) == 0:
+			add(Change{Type: ChangeTypeEntityDeleted, EntityID: entityID, Records: removed})
+		}
+	}
+
+	// Affected entities not explained otherwise.
+
+	for _, entityID := range withInfo.AffectedEntityIDs() {
+		if explained[entityID] {
+			continue
+		}
+		if after.Has(entityID) {
+			add(Change{Type: ChangeTypeEntityUpdated, EntityID: entityID})
+		} else {
+			add(Change{Type: ChangeTypeEntityDeleted, EntityID: entityID})
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].EntityID != result[j].EntityID {
+			return result[i].EntityID < result[j].EntityID
+		}
+		return changeTypeOrder[result[i].Type] < changeTypeOrder[result[j].Type]
+	})
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// State methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Has reports whether the entity exists in the state.
+
+Input
+  - entityID: The unique identifier of an entity.
+*/
+func (state *State) Has(entityID int64) bool {
+	_, isFound := state.Entities[entityID]
+	return isFound
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Add the entity in a GetEntityBy...() response. A missing entity is not an error.
+func (state *State) add(response string, err error) error {
+	if errors.Is(err, szerror.ErrSzNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	entity, err := szmodel.DecodeEntity(response)
+	if err != nil {
+		return err
+	}
+	state.Entities[entity.ResolvedEntity.EntityID] = entity.RecordKeys()
+	return nil
+}
+
+func (state *State) entityIDs() []int64 {
+	result := make([]int64, 0, len(state.Entities))
+	for entityID := range state.Entities {
+		result = append(result, entityID)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+func (state *State) owners() map[szmodel.RecordKey]int64 {
+	result := map[szmodel.RecordKey]int64{}
+	for entityID, records := range state.Entities {
+		for _, record := range records {
+			result[record] = entityID
+		}
+	}
+	return result
+}
+
+func firstKey(set map[int64]bool) int64 {
+	return sortedKeys(set)[0]
+}
+
+func sortedKeys(set map[int64]bool) []int64 {
+	result := make([]int64, 0, len(set))
+	for key := range set {
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
@@ -0,0 +1,201 @@
+package entitychange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Type mockSzEngine serves entities from a map of entity ID to records.
+type mockSzEngine struct {
+	senzing.SzEngine
+	entities map[int64][]szmodel.RecordKey
+	err      error
+}
+
+func (szEngine *mockSzEngine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	_ = ctx
+	_ = flags
+	if szEngine.err != nil {
+		return "", szEngine.err
+	}
+	records, isFound := szEngine.entities[entityID]
+	if !isFound {
+		return "", szerror.New(37, fmt.Sprintf("Unknown resolved entity value '%d'", entityID))
+	}
+	entity := szmodel.Entity{ResolvedEntity: szmodel.ResolvedEntity{EntityID: entityID}}
+	for _, record := range records {
+		entity.ResolvedEntity.Records = append(entity.ResolvedEntity.Records, szmodel.Record{DataSource: record.DataSource, RecordID: record.RecordID})
+	}
+	response, err := json.Marshal(entity)
+	return string(response), err
+}
+
+func (szEngine *mockSzEngine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	for entityID, records := range szEngine.entities {
+		for _, record := range records {
+			if record.DataSource == dataSourceCode && record.RecordID == recordID {
+				return szEngine.GetEntityByEntityID(ctx, entityID, flags)
+			}
+		}
+	}
+	return "", szerror.New(33, fmt.Sprintf("Unknown record: dsrc[%s], record[%s]", dataSourceCode, recordID))
+}
+
+var (
+	record1 = szmodel.RecordKey{DataSource: "CUSTOMERS", RecordID: "1001"}
+	record2 = szmodel.RecordKey{DataSource: "CUSTOMERS", RecordID: "1002"}
+	record3 = szmodel.RecordKey{DataSource: "CUSTOMERS", RecordID: "1003"}
+	record4 = szmodel.RecordKey{DataSource: "WATCHLIST", RecordID: "1004"}
+)
+
+var testCasesForClassify = []struct {
+	name     string
+	before   map[int64][]szmodel.RecordKey
+	after    map[int64][]szmodel.RecordKey
+	withInfo string
+	expected []Change
+}{
+	{
+		name:     "entityCreated",
+		before:   map[int64][]szmodel.RecordKey{},
+		after:    map[int64][]szmodel.RecordKey{1: {record1}},
+		withInfo: `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001","AFFECTED_ENTITIES":[{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`,
+		expected: []Change{{Type: ChangeTypeEntityCreated, EntityID: 1, Records: []szmodel.RecordKey{record1}}},
+	},
+	{
+		name:     "recordAdded",
+		before:   map[int64][]szmodel.RecordKey{},
+		after:    map[int64][]szmodel.RecordKey{1: {record1, record2}},
+		withInfo: `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1002","AFFECTED_ENTITIES":[{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`,
+		expected: []Change{{Type: ChangeTypeRecordAdded, EntityID: 1, Records: []szmodel.RecordKey{record2}}},
+	},
+	{
+		name:     "entitiesMerged",
+		before:   map[int64][]szmodel.RecordKey{},
+		after:    map[int64][]szmodel.RecordKey{1: {record1, record2, record3}},
+		withInfo: `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1003","AFFECTED_ENTITIES":[{"ENTITY_ID":1},{"ENTITY_ID":2}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`,
+		expected: []Change{
+			{Type: ChangeTypeEntitiesMerged, EntityID: 1, RelatedEntityIDs: []int64{2}},
+			{Type: ChangeTypeRecordAdded, EntityID: 1, Records: []szmodel.RecordKey{record3}},
+		},
+	},
+	{
+		name:     "entitiesMergedByReevaluation",
+		before:   map[int64][]szmodel.RecordKey{1: {record1}, 2: {record2}},
+		after:    map[int64][]szmodel.RecordKey{1: {record1, record2}},
+		withInfo: `{"AFFECTED_ENTITIES":[{"ENTITY_ID":1},{"ENTITY_ID":2}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`,
+		expected: []Change{{Type: ChangeTypeEntitiesMerged, EntityID: 1, RelatedEntityIDs: []int64{2}}},
+	},
+	{
+		name:     "entitySplit",
+		before:   map[int64][]szmodel.RecordKey{1: {record1, record2, record3}},
+		after:    map[int64][]szmodel.RecordKey{1: {record1, record2}, 5: {record3}},
+		withInfo: `{"AFFECTED_ENTITIES":[{"ENTITY_ID":1},{"ENTITY_ID":5}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`,
+		expected: []Change{{Type: ChangeTypeEntitySplit, EntityID: 1, RelatedEntityIDs: []int64{5}}},
+	},
+	{
+		name:     "recordRemovedWithSplit",
+		before:   map[int64][]szmodel.RecordKey{1: {record1, record2, record3}},
+		after:    map[int64][]szmodel.RecordKey{1: {record1}, 5: {record3}},
+		withInfo: `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1002","AFFECTED_ENTITIES":[{"ENTITY_ID":1},{"ENTITY_ID":5}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`,
+		expected: []Change{
+			{Type: ChangeTypeEntitySplit, EntityID: 1, RelatedEntityIDs: []int64{5}},
+			{Type: ChangeTypeRecordRemoved, EntityID: 1, Records: []szmodel.RecordKey{record2}},
+		},
+	},
+	{
+		name:     "entityDeleted",
+		before:   map[int64][]szmodel.RecordKey{1: {record1}},
+		after:    map[int64][]szmodel.RecordKey{},
+		withInfo: `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001","AFFECTED_ENTITIES":[{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`,
+		expected: []Change{{Type: ChangeTypeEntityDeleted, EntityID: 1, Records: []szmodel.RecordKey{record1}}},
+	},
+	{
+		name:     "entityUpdated",
+		before:   map[int64][]szmodel.RecordKey{1: {record1, record2}},
+		after:    map[int64][]szmodel.RecordKey{1: {record1, record2}, 7: {record4}},
+		withInfo: `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001","AFFECTED_ENTITIES":[{"ENTITY_ID":1},{"ENTITY_ID":7}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`,
+		expected: []Change{
+			{Type: ChangeTypeEntityUpdated, EntityID: 1},
+			{Type: ChangeTypeEntityUpdated, EntityID: 7},
+		},
+	},
+	{
+		name:     "noChange",
+		before:   map[int64][]szmodel.RecordKey{},
+		after:    map[int64][]szmodel.RecordKey{},
+		withInfo: `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1003","AFFECTED_ENTITIES":[],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`,
+		expected: []Change{},
+	},
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestEntitychange_Classify(test *testing.T) {
+	for _, testCase := range testCasesForClassify {
+		test.Run(testCase.name, func(test *testing.T) {
+			withInfo, err := szmodel.DecodeWithInfo(testCase.withInfo)
+			require.NoError(test, err)
+			actual := Classify(&State{Entities: testCase.before}, &State{Entities: testCase.after}, withInfo)
+			assert.Equal(test, testCase.expected, actual)
+		})
+	}
+}
+
+func TestClassifier_After(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := &mockSzEngine{entities: map[int64][]szmodel.RecordKey{
+		1: {record1},
+		2: {record2, record4},
+	}}
+	classifier := New(szEngine)
+	before, err := classifier.Before(ctx, []szmodel.RecordKey{record3}, nil)
+	require.NoError(test, err)
+	assert.Empty(test, before.Entities)
+
+	// Adding record3 merges entities 1 and 2.
+
+	szEngine.entities = map[int64][]szmodel.RecordKey{1: {record1, record2, record3, record4}}
+	withInfo, err := szmodel.DecodeWithInfo(`{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1003","AFFECTED_ENTITIES":[{"ENTITY_ID":2},{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`)
+	require.NoError(test, err)
+	actual, err := classifier.After(ctx, before, withInfo)
+	require.NoError(test, err)
+	assert.Equal(test, []Change{
+		{Type: ChangeTypeEntitiesMerged, EntityID: 1, RelatedEntityIDs: []int64{2}},
+		{Type: ChangeTypeRecordAdded, EntityID: 1, Records: []szmodel.RecordKey{record3}},
+	}, actual)
+
+	// Reevaluating entity 1 splits it.
+
+	before, err = classifier.Before(ctx, nil, []int64{1, 9})
+	require.NoError(test, err)
+	assert.True(test, before.Has(1))
+	assert.False(test, before.Has(9))
+	szEngine.entities = map[int64][]szmodel.RecordKey{1: {record1, record2, record3}, 3: {record4}}
+	withInfo, err = szmodel.DecodeWithInfo(`{"AFFECTED_ENTITIES":[{"ENTITY_ID":1},{"ENTITY_ID":3}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`)
+	require.NoError(test, err)
+	actual, err = classifier.After(ctx, before, withInfo)
+	require.NoError(test, err)
+	assert.Equal(test, []Change{{Type: ChangeTypeEntitySplit, EntityID: 1, RelatedEntityIDs: []int64{3}}}, actual)
+}
+
+func TestClassifier_Before_error(test *testing.T) {
+	ctx := context.TODO()
+	expected := errors.New("engine unavailable")
+	classifier := New(&mockSzEngine{err: expected})
+	_, err := classifier.Before(ctx, nil, []int64{1})
+	require.ErrorIs(test, err, expected)
+	_, err = classifier.After(ctx, &State{Entities: map[int64][]szmodel.RecordKey{}}, &szmodel.WithInfo{AffectedEntities: []szmodel.AffectedEntity{{EntityID: 1}}})
+	require.ErrorIs(test, err, expected)
+}
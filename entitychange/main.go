@@ -0,0 +1,60 @@
+package entitychange
+
+import (
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Change is one effect of a mutation.
+RelatedEntityIDs are the entities merged into EntityID for ChangeTypeEntitiesMerged,
+and the entities split from EntityID for ChangeTypeEntitySplit.
+Records are the records added to or removed from EntityID.
+*/
+type Change struct {
+	Type             ChangeType          `json:"TYPE"`
+	EntityID         int64               `json:"ENTITY_ID"`
+	RelatedEntityIDs []int64             `json:"RELATED_ENTITY_IDS,omitempty"`
+	Records          []szmodel.RecordKey `json:"RECORDS,omitempty"`
+}
+
+// Type ChangeType identifies the kind of a [Change].
+type ChangeType string
+
+// Type State is the records held by a set of entities at one point in time.
+type State struct {
+	Entities map[int64][]szmodel.RecordKey `json:"ENTITIES"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+Change types.
+  - ChangeTypeEntityCreated: A new entity holds only records that were in no entity before.
+  - ChangeTypeRecordAdded: Records were added to an existing entity.
+  - ChangeTypeRecordRemoved: Records were removed from an entity that still exists.
+  - ChangeTypeEntitiesMerged: The records of other entities are now in the entity.
+  - ChangeTypeEntitySplit: Records of the entity are now in other entities.
+  - ChangeTypeEntityDeleted: The entity no longer exists and its records were not merged elsewhere.
+  - ChangeTypeEntityUpdated: The entity was affected, but holds the same records.
+*/
+const (
+	ChangeTypeEntityCreated  ChangeType = "ENTITY_CREATED"
+	ChangeTypeRecordAdded    ChangeType = "RECORD_ADDED"
+	ChangeTypeRecordRemoved  ChangeType = "RECORD_REMOVED"
+	ChangeTypeEntitiesMerged ChangeType = "ENTITIES_MERGED"
+	ChangeTypeEntitySplit    ChangeType = "ENTITY_SPLIT"
+	ChangeTypeEntityDeleted  ChangeType = "ENTITY_DELETED"
+	ChangeTypeEntityUpdated  ChangeType = "ENTITY_UPDATED"
+)
+
+/*
+EntityFlags are the flags used to look up entities. Only the record keys are needed.
+*/
+const EntityFlags = senzing.SzEntityIncludeRecordData
@@ -0,0 +1,439 @@
+package entityexport
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/sz-sdk-go-core/dispatcher"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+/*
+Type CheckpointedExport struct writes a JSON entity export as numbered chunk files
+together with a checkpoint manifest.
+
+The export handle of ExportJSONEntityReport() cannot be resumed,
+so on restart a new export is started and entities up to the last completed entity ID
+in the manifest are skipped. This relies on Senzing exporting entities in ascending entity ID order,
+so an export whose entity IDs go backwards or repeat fails rather than skipping or duplicating entities.
+The concatenation of the chunks, in order, is the same as the output of an uninterrupted run.
+*/
+type CheckpointedExport struct {
+	ChunkSize      int    // Number of entities per chunk file. If not positive, DefaultChunkSize is used.
+	Directory      string // Directory holding the chunk files and the manifest.
+	Flags          int64  // Flags passed to ExportJSONEntityReport().
+	dispatcher     *dispatcher.Dispatcher
+	observerOrigin string
+	observers      subject.Subject
+}
+
+// Type Chunk describes a completed chunk file.
+type Chunk struct {
+	Number        int    `json:"NUMBER"`
+	Filename      string `json:"FILENAME"`
+	FirstEntityID int64  `json:"FIRST_ENTITY_ID"`
+	LastEntityID  int64  `json:"LAST_ENTITY_ID"`
+	EntityCount   int64  `json:"ENTITY_COUNT"`
+	ByteCount     int64  `json:"BYTE_COUNT"`
+	Sha256        string `json:"SHA256"`
+}
+
+// Type Manifest is the checkpoint of a CheckpointedExport.
+type Manifest struct {
+	Flags        int64   `json:"FLAGS"`
+	ChunkSize    int     `json:"CHUNK_SIZE"`
+	LastEntityID int64   `json:"LAST_ENTITY_ID"`
+	EntityCount  int64   `json:"ENTITY_COUNT"`
+	IsComplete   bool    `json:"IS_COMPLETE"`
+	Chunks       []Chunk `json:"CHUNKS"`
+}
+
+type exportedEntityID struct {
+	ResolvedEntity struct {
+		EntityID int64 `json:"ENTITY_ID"`
+	} `json:"RESOLVED_ENTITY"`
+}
+
+// ----------------------------------------------------------------------------
+// CheckpointedExport methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Export runs the export until it is complete, resuming from the manifest if one exists.
+If the manifest is already complete, nothing is exported.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine used to export entities.
+
+Output
+  - The final manifest.
+*/
+func (export *CheckpointedExport) Export(ctx context.Context, szEngine senzing.SzEngine) (*Manifest, error) {
+	manifest, err := export.export(ctx, szEngine)
+	if export.dispatcher != nil {
+		flushErr := export.dispatcher.Flush(ctx)
+		if err == nil {
+			err = flushErr
+		}
+	}
+	return manifest, err
+}
+
+/*
+Method ReadManifest returns the manifest in the export directory.
+
+Output
+  - The manifest, or an error satisfying errors.Is(err, os.ErrNotExist) if there is none.
+*/
+func (export *CheckpointedExport) ReadManifest() (*Manifest, error) {
+	data, err := os.ReadFile(export.manifestPath())
+	if err != nil {
+		return nil, err
+	}
+	result := &Manifest{}
+	err = json.Unmarshal(data, result)
+	return result, err
+}
+
+/*
+Method RegisterObserver adds the observer to the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+*/
+func (export *CheckpointedExport) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	if export.observers == nil {
+		export.observers = &subject.SimpleSubject{}
+	}
+	return export.observers.RegisterObserver(ctx, observer)
+}
+
+/*
+Method SetObserverOrigin sets the "origin" value in future Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - origin: The value sent in the Observer's "origin" key/value pair.
+*/
+func (export *CheckpointedExport) SetObserverOrigin(ctx context.Context, origin string) {
+	_ = ctx
+	export.observerOrigin = origin
+}
+
+/*
+Method UnregisterObserver removes the observer from the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+*/
+func (export *CheckpointedExport) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	var err error
+	if export.observers != nil {
+		err = export.observers.UnregisterObserver(ctx, observer)
+		if !export.observers.HasObservers(ctx) {
+			export.observers = nil
+		}
+	}
+	return err
+}
+
+/*
+Method WriteTo writes the concatenation of all chunks, verifying each checksum.
+
+Input
+  - writer: Where the export is written.
+
+Output
+  - The number of bytes written.
+*/
+func (export *CheckpointedExport) WriteTo(writer io.Writer) (int64, error) {
+	var result int64
+	manifest, err := export.ReadManifest()
+	if err != nil {
+		return result, err
+	}
+	if !manifest.IsComplete {
+		return result, errors.New("entityexport: export is not complete")
+	}
+	for _, chunk := range manifest.Chunks {
+		file, err := os.Open(filepath.Join(export.Directory, chunk.Filename))
+		if err != nil {
+			return result, err
+		}
+		chunkHash := sha256.New()
+		count, err := io.Copy(io.MultiWriter(writer, chunkHash), file)
+		result += count
+		file.Close()
+		if err != nil {
+			return result, err
+		}
+		if hex.EncodeToString(chunkHash.Sum(nil)) != chunk.Sha256 {
+			return result, fmt.Errorf("entityexport: checksum mismatch in %s", chunk.Filename)
+		}
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (export *CheckpointedExport) chunkSize() int {
+	if export.ChunkSize <= 0 {
+		return DefaultChunkSize
+	}
+	return export.ChunkSize
+}
+
+func (export *CheckpointedExport) copyChunks(ctx context.Context, reader io.Reader, manifest *Manifest) error {
+	var (
+		chunkFile        *os.File
+		chunk            Chunk
+		chunkHash        = sha256.New()
+		previousEntityID int64
+		writer           *bufio.Writer
+	)
+	defer func() {
+		if chunkFile != nil {
+			_ = chunkFile.Close()
+			_ = os.Remove(export.temporaryPath(chunk.Filename))
+		}
+	}()
+	decoder := json.NewDecoder(bufio.NewReader(reader))
+	for {
+		var line json.RawMessage
+		err := decoder.Decode(&line)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		entityID := exportedEntityID{}
+		if err := json.Unmarshal(line, &entityID); err != nil {
+			return err
+		}
+		if entityID.ResolvedEntity.EntityID <= previousEntityID {
+			return fmt.Errorf("entityexport: entity ID %d follows entity ID %d; the export is not in ascending entity ID order", entityID.ResolvedEntity.EntityID, previousEntityID)
+		}
+		previousEntityID = entityID.ResolvedEntity.EntityID
+		if entityID.ResolvedEntity.EntityID <= manifest.LastEntityID {
+			continue
+		}
+
+		if chunkFile == nil {
+			chunk = Chunk{
+				FirstEntityID: entityID.ResolvedEntity.EntityID,
+				Number:        len(manifest.Chunks) + 1,
+			}
+			chunk.Filename = chunkFilename(chunk.Number)
+			chunkFile, err = os.Create(export.temporaryPath(chunk.Filename))
+			if err != nil {
+				return err
+			}
+			chunkHash.Reset()
+			writer = bufio.NewWriter(io.MultiWriter(chunkFile, chunkHash))
+		}
+
+		count, err := writer.Write(append(line, '\n'))
+		if err != nil {
+			return err
+		}
+		chunk.ByteCount += int64(count)
+		chunk.EntityCount++
+		chunk.LastEntityID = entityID.ResolvedEntity.EntityID
+
+		if chunk.EntityCount >= int64(export.chunkSize()) {
+			if err := export.completeChunk(ctx, manifest, &chunk, chunkFile, writer, chunkHash); err != nil {
+				return err
+			}
+			chunkFile = nil
+		}
+	}
+	if chunkFile != nil {
+		if err := export.completeChunk(ctx, manifest, &chunk, chunkFile, writer, chunkHash); err != nil {
+			return err
+		}
+		chunkFile = nil
+	}
+	return nil
+}
+
+func (export *CheckpointedExport) completeChunk(ctx context.Context, manifest *Manifest, chunk *Chunk, chunkFile *os.File, writer *bufio.Writer, chunkHash hash.Hash) error {
+	err := writer.Flush()
+	if err == nil {
+		err = chunkFile.Sync()
+	}
+	closeErr := chunkFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	chunk.Sha256 = hex.EncodeToString(chunkHash.Sum(nil))
+	if err := os.Rename(export.temporaryPath(chunk.Filename), filepath.Join(export.Directory, chunk.Filename)); err != nil {
+		return err
+	}
+	manifest.Chunks = append(manifest.Chunks, *chunk)
+	manifest.EntityCount += chunk.EntityCount
+	manifest.LastEntityID = chunk.LastEntityID
+	err = export.writeManifest(manifest)
+	export.notify(ctx, 8001, err, map[string]string{
+		"chunk":        strconv.Itoa(chunk.Number),
+		"entityCount":  strconv.FormatInt(manifest.EntityCount, 10),
+		"filename":     chunk.Filename,
+		"lastEntityID": strconv.FormatInt(chunk.LastEntityID, 10),
+	})
+	return err
+}
+
+// Run the export, as described in Export, without waiting for the notifications to be delivered.
+func (export *CheckpointedExport) export(ctx context.Context, szEngine senzing.SzEngine) (*Manifest, error) {
+	manifest, err := export.prepare()
+	if err != nil {
+		return nil, err
+	}
+	if manifest.IsComplete {
+		return manifest, nil
+	}
+	if manifest.LastEntityID > 0 {
+		export.notify(ctx, 8003, nil, map[string]string{
+			"entityCount":  strconv.FormatInt(manifest.EntityCount, 10),
+			"lastEntityID": strconv.FormatInt(manifest.LastEntityID, 10),
+		})
+	}
+
+	reader, err := NewJSONReader(ctx, szEngine, manifest.Flags)
+	if err != nil {
+		return manifest, err
+	}
+	err = export.copyChunks(ctx, reader, manifest)
+	closeErr := reader.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return manifest, err
+	}
+
+	manifest.IsComplete = true
+	err = export.writeManifest(manifest)
+	export.notify(ctx, 8002, err, map[string]string{
+		"chunkCount":  strconv.Itoa(len(manifest.Chunks)),
+		"entityCount": strconv.FormatInt(manifest.EntityCount, 10),
+	})
+	return manifest, err
+}
+
+// Get the notification dispatcher, creating one on first use.
+func (export *CheckpointedExport) getDispatcher() *dispatcher.Dispatcher {
+	if export.dispatcher == nil {
+		export.dispatcher = dispatcher.New(dispatcher.DefaultCapacity, dispatcher.Block)
+	}
+	return export.dispatcher
+}
+
+func (export *CheckpointedExport) manifestPath() string {
+	return filepath.Join(export.Directory, ManifestFilename)
+}
+
+func (export *CheckpointedExport) notify(ctx context.Context, messageID int, err error, details map[string]string) {
+	if export.observers != nil {
+		export.getDispatcher().Dispatch(ctx, export.observers, export.observerOrigin, ComponentID, messageID, err, details)
+	}
+}
+
+/*
+The prepare method reads or creates the manifest, verifies completed chunks
+and removes any partially written chunk left by an interrupted run.
+*/
+func (export *CheckpointedExport) prepare() (*Manifest, error) {
+	if err := os.MkdirAll(export.Directory, 0750); err != nil {
+		return nil, err
+	}
+	manifest, err := export.ReadManifest()
+	if errors.Is(err, os.ErrNotExist) {
+		manifest = &Manifest{
+			ChunkSize: export.chunkSize(),
+			Chunks:    []Chunk{},
+			Flags:     export.Flags,
+		}
+		return manifest, export.writeManifest(manifest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Flags != export.Flags {
+		return nil, fmt.Errorf("entityexport: manifest flags %d do not match export flags %d", manifest.Flags, export.Flags)
+	}
+	if manifest.ChunkSize != export.chunkSize() {
+		return nil, fmt.Errorf("entityexport: manifest chunk size %d does not match export chunk size %d", manifest.ChunkSize, export.chunkSize())
+	}
+	for _, chunk := range manifest.Chunks {
+		sha, err := fileSha256(filepath.Join(export.Directory, chunk.Filename))
+		if err != nil {
+			return nil, err
+		}
+		if sha != chunk.Sha256 {
+			return nil, fmt.Errorf("entityexport: checksum mismatch in %s", chunk.Filename)
+		}
+	}
+	partial := export.temporaryPath(chunkFilename(len(manifest.Chunks) + 1))
+	if err := os.Remove(partial); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func (export *CheckpointedExport) temporaryPath(filename string) string {
+	return filepath.Join(export.Directory, filename+".partial")
+}
+
+func (export *CheckpointedExport) writeManifest(manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	temporaryPath := export.temporaryPath(ManifestFilename)
+	if err := os.WriteFile(temporaryPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(temporaryPath, export.manifestPath())
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func chunkFilename(number int) string {
+	return fmt.Sprintf(ChunkFilenameTemplate, number)
+}
+
+func fileSha256(path string) (string, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	fileHash := sha256.New()
+	if _, err := io.Copy(fileHash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(fileHash.Sum(nil)), nil
+}
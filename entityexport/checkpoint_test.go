@@ -0,0 +1,227 @@
+package entityexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Type failingSzEngine fails FetchNext after a number of fragments have been fetched.
+type failingSzEngine struct {
+	*mockSzEngine
+	fetchCount int
+	fetchLimit int
+}
+
+func (szEngine *failingSzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	szEngine.fetchCount++
+	if szEngine.fetchCount > szEngine.fetchLimit {
+		return "", errors.New("export interrupted")
+	}
+	return szEngine.mockSzEngine.FetchNext(ctx, exportHandle)
+}
+
+// Type channelObserver sends each notification's messageId to a channel.
+type channelObserver struct {
+	messages chan string
+}
+
+func (observer *channelObserver) GetObserverID(ctx context.Context) string {
+	_ = ctx
+	return "channelObserver"
+}
+
+func (observer *channelObserver) UpdateObserver(ctx context.Context, message string) {
+	_ = ctx
+	details := map[string]string{}
+	if err := json.Unmarshal([]byte(message), &details); err == nil {
+		observer.messages <- details["messageId"]
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestCheckpointedExport_Export(test *testing.T) {
+	ctx := context.TODO()
+	expected := readExportFile(test)
+	export := &CheckpointedExport{
+		ChunkSize: 4,
+		Directory: test.TempDir(),
+		Flags:     DefaultFlags,
+	}
+	manifest, err := export.Export(ctx, &mockSzEngine{fragmentSize: 100, remaining: expected})
+	require.NoError(test, err)
+	assert.True(test, manifest.IsComplete)
+	assert.Equal(test, int64(6), manifest.EntityCount)
+	assert.Equal(test, int64(6), manifest.LastEntityID)
+	require.Len(test, manifest.Chunks, 2)
+	assert.Equal(test, Chunk{
+		Number:        2,
+		Filename:      "chunk-000002.jsonl",
+		FirstEntityID: 5,
+		LastEntityID:  6,
+		EntityCount:   2,
+		ByteCount:     manifest.Chunks[1].ByteCount,
+		Sha256:        manifest.Chunks[1].Sha256,
+	}, manifest.Chunks[1])
+	assert.Len(test, manifest.Chunks[0].Sha256, 64)
+
+	var buffer bytes.Buffer
+	count, err := export.WriteTo(&buffer)
+	require.NoError(test, err)
+	assert.Equal(test, int64(len(expected)), count)
+	assert.Equal(test, expected, buffer.String())
+
+	manifest, err = export.Export(ctx, nil)
+	require.NoError(test, err)
+	assert.True(test, manifest.IsComplete)
+}
+
+func TestCheckpointedExport_Export_resume(test *testing.T) {
+	ctx := context.TODO()
+	expected := readExportFile(test)
+	directory := test.TempDir()
+	export := &CheckpointedExport{
+		ChunkSize: 2,
+		Directory: directory,
+		Flags:     DefaultFlags,
+	}
+
+	// Interrupt the export after the third chunk has started.
+
+	szEngine := &failingSzEngine{
+		fetchLimit:   5,
+		mockSzEngine: &mockSzEngine{fragmentSize: len(expected) / 6, remaining: expected},
+	}
+	manifest, err := export.Export(ctx, szEngine)
+	require.Error(test, err)
+	assert.False(test, manifest.IsComplete)
+	require.Len(test, manifest.Chunks, 2)
+	assert.Equal(test, int64(4), manifest.LastEntityID)
+	_, err = export.WriteTo(&bytes.Buffer{})
+	require.Error(test, err)
+
+	// Resume.
+
+	manifest, err = export.Export(ctx, &mockSzEngine{fragmentSize: 100, remaining: expected})
+	require.NoError(test, err)
+	assert.True(test, manifest.IsComplete)
+	assert.Equal(test, int64(6), manifest.EntityCount)
+	require.Len(test, manifest.Chunks, 3)
+	assert.Equal(test, int64(5), manifest.Chunks[2].FirstEntityID)
+	var buffer bytes.Buffer
+	_, err = export.WriteTo(&buffer)
+	require.NoError(test, err)
+	assert.Equal(test, expected, buffer.String())
+
+	partials, err := filepath.Glob(filepath.Join(directory, "*.partial"))
+	require.NoError(test, err)
+	assert.Empty(test, partials)
+}
+
+func TestCheckpointedExport_Export_badChecksum(test *testing.T) {
+	ctx := context.TODO()
+	expected := readExportFile(test)
+	export := &CheckpointedExport{
+		ChunkSize: 4,
+		Directory: test.TempDir(),
+		Flags:     DefaultFlags,
+	}
+	szEngine := &failingSzEngine{
+		fetchLimit:   1,
+		mockSzEngine: &mockSzEngine{fragmentSize: len(expected) - 1, remaining: expected},
+	}
+	_, err := export.Export(ctx, szEngine)
+	require.Error(test, err)
+	chunkPath := filepath.Join(export.Directory, "chunk-000001.jsonl")
+	require.NoError(test, os.WriteFile(chunkPath, []byte("tampered\n"), 0600))
+	_, err = export.Export(ctx, &mockSzEngine{fragmentSize: 100, remaining: expected})
+	require.ErrorContains(test, err, "checksum mismatch")
+}
+
+func TestCheckpointedExport_Export_outOfOrder(test *testing.T) {
+	ctx := context.TODO()
+	lines := strings.SplitAfter(readExportFile(test), "\n")
+	lines[4], lines[5] = lines[5], lines[4]
+	export := &CheckpointedExport{
+		ChunkSize: 4,
+		Directory: test.TempDir(),
+		Flags:     DefaultFlags,
+	}
+	manifest, err := export.Export(ctx, &mockSzEngine{fragmentSize: 100, remaining: strings.Join(lines, "")})
+	require.ErrorContains(test, err, "not in ascending entity ID order")
+	assert.False(test, manifest.IsComplete)
+	require.Len(test, manifest.Chunks, 1)
+	assert.Equal(test, int64(4), manifest.LastEntityID)
+}
+
+func TestCheckpointedExport_Export_badJSON(test *testing.T) {
+	ctx := context.TODO()
+	directory := test.TempDir()
+	export := &CheckpointedExport{
+		ChunkSize: 4,
+		Directory: directory,
+		Flags:     DefaultFlags,
+	}
+	lines := strings.SplitAfter(readExportFile(test), "\n")
+	manifest, err := export.Export(ctx, &mockSzEngine{fragmentSize: 100, remaining: strings.Join(lines[:5], "") + "{bad\n"})
+	require.Error(test, err)
+	require.Len(test, manifest.Chunks, 1)
+	partials, err := filepath.Glob(filepath.Join(directory, "*.partial"))
+	require.NoError(test, err)
+	assert.Empty(test, partials)
+}
+
+func TestCheckpointedExport_Export_changedFlags(test *testing.T) {
+	ctx := context.TODO()
+	directory := test.TempDir()
+	export := &CheckpointedExport{Directory: directory, Flags: DefaultFlags}
+	_, err := export.Export(ctx, &mockSzEngine{fragmentSize: 100, remaining: readExportFile(test)})
+	require.NoError(test, err)
+	export = &CheckpointedExport{Directory: directory, Flags: 0}
+	_, err = export.Export(ctx, &mockSzEngine{fragmentSize: 100, remaining: readExportFile(test)})
+	require.Error(test, err)
+	export = &CheckpointedExport{ChunkSize: 1, Directory: directory, Flags: DefaultFlags}
+	_, err = export.Export(ctx, &mockSzEngine{fragmentSize: 100, remaining: readExportFile(test)})
+	require.Error(test, err)
+}
+
+func TestCheckpointedExport_ReadManifest_noManifest(test *testing.T) {
+	export := &CheckpointedExport{Directory: test.TempDir()}
+	_, err := export.ReadManifest()
+	require.ErrorIs(test, err, os.ErrNotExist)
+}
+
+func TestCheckpointedExport_RegisterObserver(test *testing.T) {
+	ctx := context.TODO()
+	observer := &channelObserver{messages: make(chan string, 10)}
+	export := &CheckpointedExport{
+		ChunkSize: 4,
+		Directory: test.TempDir(),
+		Flags:     DefaultFlags,
+	}
+	require.NoError(test, export.RegisterObserver(ctx, observer))
+	export.SetObserverOrigin(ctx, "Test")
+	_, err := export.Export(ctx, &mockSzEngine{fragmentSize: 100, remaining: readExportFile(test)})
+	require.NoError(test, err)
+
+	// Every notification has been delivered, in order, when Export returns.
+
+	close(observer.messages)
+	actual := []string{}
+	for messageID := range observer.messages {
+		actual = append(actual, messageID)
+	}
+	assert.Equal(test, []string{"8001", "8001", "8002"}, actual)
+	require.NoError(test, export.UnregisterObserver(ctx, observer))
+}
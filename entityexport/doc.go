@@ -0,0 +1,18 @@
+/*
+Package entityexport is used to stream the entities produced by the
+ExportJSONEntityReport / FetchNext / CloseExport lifecycle of [senzing.SzEngine].
+
+The [Reader] type presents an export as an [io.ReadCloser] so that the JSON-lines
+output can be piped to files or decoders without holding the whole export in memory.
+The [Decoder] type reads one [Entity] at a time from any JSON-lines export.
+
+[CheckpointedExport] writes an export as numbered chunk files with a checkpoint manifest
+so that an interrupted export can be resumed.
+
+[FilterJSON] and [FilterCsv] select entities with a [Filter] while streaming,
+optionally projecting JSON entities to a few fields with a [Projection]
+or CSV rows to a subset of columns.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+package entityexport
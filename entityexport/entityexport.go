@@ -0,0 +1,209 @@
+package entityexport
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// Type Reader struct implements [io.ReadCloser] over an export handle.
+type Reader struct {
+	ctx          context.Context
+	exportHandle uintptr
+	isClosed     bool
+	isExhausted  bool
+	pending      []byte
+	szEngine     senzing.SzEngine
+}
+
+// Type Decoder struct reads [Entity] values from a JSON-lines entity export.
+type Decoder struct {
+	decoder *json.Decoder
+}
+
+// ----------------------------------------------------------------------------
+// Reader constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewCsvReader function starts a CSV entity export and returns a Reader over its output.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine used to export entities.
+  - csvColumnList: Use "*" to request all columns, empty string to request "standard" columns,
+    or a comma-separated list of column names for customized columns.
+  - flags: Flags used to control information returned.
+
+Output
+  - A Reader over the CSV export. The caller must call Close().
+*/
+func NewCsvReader(ctx context.Context, szEngine senzing.SzEngine, csvColumnList string, flags int64) (*Reader, error) {
+	exportHandle, err := szEngine.ExportCsvEntityReport(ctx, csvColumnList, flags)
+	if err != nil {
+		return nil, err
+	}
+	return NewReaderFromHandle(ctx, szEngine, exportHandle), nil
+}
+
+/*
+The NewJSONReader function starts a JSON entity export and returns a Reader over its output.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine used to export entities.
+  - flags: Flags used to control information returned. Use [DefaultFlags] for the information used by this package.
+
+Output
+  - A Reader over the JSON-lines export. The caller must call Close().
+*/
+func NewJSONReader(ctx context.Context, szEngine senzing.SzEngine, flags int64) (*Reader, error) {
+	exportHandle, err := szEngine.ExportJSONEntityReport(ctx, flags)
+	if err != nil {
+		return nil, err
+	}
+	return NewReaderFromHandle(ctx, szEngine, exportHandle), nil
+}
+
+/*
+The NewReaderFromHandle function returns a Reader over an export handle that has already been opened.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine that created the export handle.
+  - exportHandle: A handle created by ExportJSONEntityReport() or ExportCsvEntityReport().
+
+Output
+  - A Reader over the export. Close() will close the export handle.
+*/
+func NewReaderFromHandle(ctx context.Context, szEngine senzing.SzEngine, exportHandle uintptr) *Reader {
+	return &Reader{
+		ctx:          ctx,
+		exportHandle: exportHandle,
+		szEngine:     szEngine,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Reader methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Close closes the underlying export handle.
+Calling Close more than once is harmless.
+
+Output
+  - An error returned by CloseExport(), if any.
+*/
+func (reader *Reader) Close() error {
+	if reader.isClosed {
+		return nil
+	}
+	reader.isClosed = true
+	return reader.szEngine.CloseExport(reader.ctx, reader.exportHandle)
+}
+
+/*
+Method Read reads export output fetched by FetchNext().
+
+Input
+  - buffer: The buffer to fill.
+
+Output
+  - The number of bytes read.
+  - io.EOF when the export has been read completely.
+*/
+func (reader *Reader) Read(buffer []byte) (int, error) {
+	if reader.isClosed {
+		return 0, errors.New("entityexport: read from closed Reader")
+	}
+	for len(reader.pending) == 0 {
+		if reader.isExhausted {
+			return 0, io.EOF
+		}
+		if err := reader.ctx.Err(); err != nil {
+			return 0, err
+		}
+		fragment, err := reader.szEngine.FetchNext(reader.ctx, reader.exportHandle)
+		if err != nil {
+			return 0, err
+		}
+		if len(fragment) == 0 {
+			reader.isExhausted = true
+			continue
+		}
+		reader.pending = []byte(fragment)
+	}
+	count := copy(buffer, reader.pending)
+	reader.pending = reader.pending[count:]
+	return count, nil
+}
+
+// ----------------------------------------------------------------------------
+// Decoder
+// ----------------------------------------------------------------------------
+
+/*
+The NewDecoder function returns a Decoder that reads entities from a JSON-lines export.
+
+Input
+  - reader: The export output, e.g. a [Reader] or an exported file.
+
+Output
+  - A Decoder.
+*/
+func NewDecoder(reader io.Reader) *Decoder {
+	return &Decoder{
+		decoder: json.NewDecoder(bufio.NewReader(reader)),
+	}
+}
+
+/*
+Method Decode reads the next entity from the export.
+
+Input
+  - entity: The Entity to populate. It is reset before decoding.
+
+Output
+  - io.EOF when there are no more entities.
+*/
+func (decoder *Decoder) Decode(entity *Entity) error {
+	*entity = Entity{}
+	return decoder.decoder.Decode(entity)
+}
+
+/*
+The ForEach function decodes every entity in a JSON-lines export and calls handler for each one.
+Iteration stops at the first error returned by handler.
+
+Input
+  - reader: The export output.
+  - handler: A function called once per entity. The entity must not be retained after handler returns.
+
+Output
+  - The number of entities read.
+*/
+func ForEach(reader io.Reader, handler func(entity *Entity) error) (int64, error) {
+	var (
+		count  int64
+		entity Entity
+	)
+	decoder := NewDecoder(reader)
+	for {
+		err := decoder.Decode(&entity)
+		if errors.Is(err, io.EOF) {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		count++
+		if err := handler(&entity); err != nil {
+			return count, err
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package entityexport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	exportCsvFilename = "../testdata/export/export-csv-entity-report.csv"
+	exportFilename    = "../testdata/export/export-json-entity-report.jsonl"
+)
+
+// Type mockSzEngine serves a fixed export in fragments of fragmentSize bytes.
+type mockSzEngine struct {
+	senzing.SzEngine
+	closeCount   int
+	exportFlags  int64
+	fragmentSize int
+	remaining    string
+}
+
+func (szEngine *mockSzEngine) CloseExport(ctx context.Context, exportHandle uintptr) error {
+	_ = ctx
+	_ = exportHandle
+	szEngine.closeCount++
+	return nil
+}
+
+func (szEngine *mockSzEngine) ExportCsvEntityReport(ctx context.Context, csvColumnList string, flags int64) (uintptr, error) {
+	_ = ctx
+	_ = csvColumnList
+	szEngine.exportFlags = flags
+	return 2, nil
+}
+
+func (szEngine *mockSzEngine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	_ = ctx
+	szEngine.exportFlags = flags
+	return 1, nil
+}
+
+func (szEngine *mockSzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	_ = ctx
+	_ = exportHandle
+	size := min(szEngine.fragmentSize, len(szEngine.remaining))
+	result := szEngine.remaining[:size]
+	szEngine.remaining = szEngine.remaining[size:]
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestEntityexport_NewJSONReader(test *testing.T) {
+	ctx := context.TODO()
+	expected := readExportFile(test)
+	for _, fragmentSize := range []int{1, 7, 100, 65536} {
+		szEngine := &mockSzEngine{fragmentSize: fragmentSize, remaining: expected}
+		reader, err := NewJSONReader(ctx, szEngine, DefaultFlags)
+		require.NoError(test, err)
+		actual, err := io.ReadAll(reader)
+		require.NoError(test, err)
+		assert.Equal(test, expected, string(actual))
+		require.NoError(test, reader.Close())
+		require.NoError(test, reader.Close())
+		assert.Equal(test, 1, szEngine.closeCount)
+		assert.Equal(test, DefaultFlags, szEngine.exportFlags)
+	}
+}
+
+func TestEntityexport_Read_afterClose(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := &mockSzEngine{fragmentSize: 10, remaining: readExportFile(test)}
+	reader := NewReaderFromHandle(ctx, szEngine, 1)
+	require.NoError(test, reader.Close())
+	_, err := reader.Read(make([]byte, 10))
+	require.Error(test, err)
+}
+
+func TestEntityexport_Read_canceled(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	szEngine := &mockSzEngine{fragmentSize: 10, remaining: readExportFile(test)}
+	reader := NewReaderFromHandle(ctx, szEngine, 1)
+	defer reader.Close()
+	_, err := reader.Read(make([]byte, 10))
+	require.ErrorIs(test, err, context.Canceled)
+}
+
+func TestEntityexport_Decode(test *testing.T) {
+	var entity Entity
+	decoder := NewDecoder(strings.NewReader(readExportFile(test)))
+	require.NoError(test, decoder.Decode(&entity))
+	assert.Equal(test, int64(1), entity.ResolvedEntity.EntityID)
+	assert.Equal(test, "Robert Smith", entity.ResolvedEntity.EntityName)
+	assert.Len(test, entity.ResolvedEntity.Records, 3)
+	assert.Equal(test, "Bob Smith", entity.ResolvedEntity.Records[1].EntityDesc)
+	assert.Equal(test, "+NAME+DOB+PHONE", entity.ResolvedEntity.Records[1].MatchKey)
+	assert.Len(test, entity.ResolvedEntity.Features["NAME"], 3)
+	require.Len(test, entity.RelatedEntities, 1)
+	assert.Equal(test, int64(4), entity.RelatedEntities[0].EntityID)
+	assert.Equal(test, MatchLevelPossiblySame, entity.RelatedEntities[0].MatchLevel)
+	require.NoError(test, decoder.Decode(&entity))
+	assert.Equal(test, int64(2), entity.ResolvedEntity.EntityID)
+	assert.Len(test, entity.ResolvedEntity.Features["NAME"], 1)
+}
+
+func TestEntityexport_Decode_badJSON(test *testing.T) {
+	var entity Entity
+	decoder := NewDecoder(strings.NewReader(`{"RESOLVED_ENTITY":`))
+	err := decoder.Decode(&entity)
+	require.Error(test, err)
+	assert.False(test, errors.Is(err, io.EOF))
+}
+
+func TestEntityexport_ForEach(test *testing.T) {
+	var entityIDs []int64
+	count, err := ForEach(strings.NewReader(readExportFile(test)), func(entity *Entity) error {
+		entityIDs = append(entityIDs, entity.ResolvedEntity.EntityID)
+		return nil
+	})
+	require.NoError(test, err)
+	assert.Equal(test, int64(6), count)
+	assert.Equal(test, []int64{1, 2, 3, 4, 5, 6}, entityIDs)
+}
+
+func TestEntityexport_ForEach_handlerError(test *testing.T) {
+	expected := errors.New("stop")
+	count, err := ForEach(strings.NewReader(readExportFile(test)), func(entity *Entity) error {
+		_ = entity
+		return expected
+	})
+	require.ErrorIs(test, err, expected)
+	assert.Equal(test, int64(1), count)
+}
+
+func TestEntity_DataSources(test *testing.T) {
+	entities := readEntities(test)
+	assert.Equal(test, []string{"CUSTOMERS"}, entities[0].DataSources())
+	assert.Equal(test, []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"}, entities[4].DataSources())
+}
+
+func TestEntity_DataSources_recordSummaryOnly(test *testing.T) {
+	entity := readEntities(test)[4]
+	entity.ResolvedEntity.Records = nil
+	assert.Equal(test, []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"}, entity.DataSources())
+}
+
+func TestEntity_RecordCount(test *testing.T) {
+	entity := readEntities(test)[4]
+	assert.Equal(test, int64(4), entity.RecordCount())
+	assert.Equal(test, map[string]int64{"CUSTOMERS": 2, "REFERENCE": 1, "WATCHLIST": 1}, entity.RecordCountByDataSource())
+	entity.ResolvedEntity.Records = nil
+	assert.Equal(test, int64(4), entity.RecordCount())
+	assert.Equal(test, map[string]int64{"CUSTOMERS": 2, "REFERENCE": 1, "WATCHLIST": 1}, entity.RecordCountByDataSource())
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func readEntities(test *testing.T) []Entity {
+	var result []Entity
+	_, err := ForEach(strings.NewReader(readExportFile(test)), func(entity *Entity) error {
+		result = append(result, *entity)
+		return nil
+	})
+	require.NoError(test, err)
+	return result
+}
+
+func readExportCsvFile(test *testing.T) string {
+	result, err := os.ReadFile(exportCsvFilename)
+	require.NoError(test, err)
+	return string(result)
+}
+
+func readExportFile(test *testing.T) string {
+	result, err := os.ReadFile(exportFilename)
+	require.NoError(test, err)
+	return string(result)
+}
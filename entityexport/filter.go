@@ -0,0 +1,425 @@
+package entityexport
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+/*
+Type Filter struct selects entities while streaming an export.
+Zero values disable the corresponding check, so an empty Filter selects every entity.
+*/
+type Filter struct {
+	DataSources           []string           // Entity must have records from at least one of these data sources.
+	RequireAllDataSources bool               // If true, entity must have records from all of DataSources.
+	MinRecords            int64              // Minimum number of records in the entity.
+	MaxRecords            int64              // Maximum number of records in the entity.
+	MinEntityID           int64              // Minimum entity ID.
+	MaxEntityID           int64              // Maximum entity ID.
+	Predicate             func(*Entity) bool // Custom check applied after the other checks.
+}
+
+// Type FilterResult reports the number of entities read and written by a filtered export.
+type FilterResult struct {
+	EntitiesRead    int64 `json:"ENTITIES_READ"`
+	EntitiesWritten int64 `json:"ENTITIES_WRITTEN"`
+}
+
+/*
+Type Projection is a function that returns the value written for an entity in a JSON export.
+The value is marshalled as a single JSON line.
+*/
+type Projection func(entity *Entity) interface{}
+
+// ----------------------------------------------------------------------------
+// Projections
+// ----------------------------------------------------------------------------
+
+/*
+The ProjectEntityID projection writes {"ENTITY_ID": n}.
+*/
+func ProjectEntityID(entity *Entity) interface{} {
+	return struct {
+		EntityID int64 `json:"ENTITY_ID"`
+	}{
+		EntityID: entity.ResolvedEntity.EntityID,
+	}
+}
+
+/*
+The ProjectEntityName projection writes {"ENTITY_ID": n, "ENTITY_NAME": "..."}.
+ENTITY_NAME is the best name chosen by Senzing and requires senzing.SzEntityIncludeEntityName.
+*/
+func ProjectEntityName(entity *Entity) interface{} {
+	return struct {
+		EntityID   int64  `json:"ENTITY_ID"`
+		EntityName string `json:"ENTITY_NAME"`
+	}{
+		EntityID:   entity.ResolvedEntity.EntityID,
+		EntityName: entity.ResolvedEntity.EntityName,
+	}
+}
+
+/*
+The ProjectRecordKeys projection writes {"ENTITY_ID": n, "RECORDS": [{"DATA_SOURCE": "...", "RECORD_ID": "..."}]}.
+It requires senzing.SzEntityIncludeRecordData.
+*/
+func ProjectRecordKeys(entity *Entity) interface{} {
+	records := make([]RecordKey, 0, len(entity.ResolvedEntity.Records))
+	for _, record := range entity.ResolvedEntity.Records {
+		records = append(records, RecordKey{
+			DataSource: record.DataSource,
+			RecordID:   record.RecordID,
+		})
+	}
+	return struct {
+		EntityID int64       `json:"ENTITY_ID"`
+		Records  []RecordKey `json:"RECORDS"`
+	}{
+		EntityID: entity.ResolvedEntity.EntityID,
+		Records:  records,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Filter methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Match returns true if the entity is selected by the filter.
+A nil Filter selects every entity.
+
+Input
+  - entity: The entity to check.
+*/
+func (filter *Filter) Match(entity *Entity) bool {
+	if filter == nil {
+		return true
+	}
+	entityID := entity.ResolvedEntity.EntityID
+	if filter.MinEntityID > 0 && entityID < filter.MinEntityID {
+		return false
+	}
+	if filter.MaxEntityID > 0 && entityID > filter.MaxEntityID {
+		return false
+	}
+	if filter.MinRecords > 0 || filter.MaxRecords > 0 {
+		recordCount := entity.RecordCount()
+		if filter.MinRecords > 0 && recordCount < filter.MinRecords {
+			return false
+		}
+		if filter.MaxRecords > 0 && recordCount > filter.MaxRecords {
+			return false
+		}
+	}
+	if len(filter.DataSources) > 0 && !filter.matchDataSources(entity) {
+		return false
+	}
+	if filter.Predicate != nil {
+		return filter.Predicate(entity)
+	}
+	return true
+}
+
+func (filter *Filter) matchDataSources(entity *Entity) bool {
+	present := map[string]bool{}
+	for _, dataSource := range entity.DataSources() {
+		present[dataSource] = true
+	}
+	for _, dataSource := range filter.DataSources {
+		if present[dataSource] && !filter.RequireAllDataSources {
+			return true
+		}
+		if !present[dataSource] && filter.RequireAllDataSources {
+			return false
+		}
+	}
+	return filter.RequireAllDataSources
+}
+
+// ----------------------------------------------------------------------------
+// JSON
+// ----------------------------------------------------------------------------
+
+/*
+The ExportFilteredJSON function runs a JSON entity export, writing only the entities selected by the filter.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine used to export entities.
+  - flags: Flags passed to ExportJSONEntityReport(). They must include the information the filter and projection use.
+  - writer: Where the JSON lines are written.
+  - filter: Selects entities. If nil, all entities are selected.
+  - projection: The value written per entity. If nil, the exported line is written unchanged.
+
+Output
+  - The number of entities read and written.
+*/
+func ExportFilteredJSON(ctx context.Context, szEngine senzing.SzEngine, flags int64, writer io.Writer, filter *Filter, projection Projection) (FilterResult, error) {
+	reader, err := NewJSONReader(ctx, szEngine, flags)
+	if err != nil {
+		return FilterResult{}, err
+	}
+	result, err := FilterJSON(reader, writer, filter, projection)
+	closeErr := reader.Close()
+	if err == nil {
+		err = closeErr
+	}
+	return result, err
+}
+
+/*
+The FilterJSON function copies the entities of a JSON-lines export selected by the filter.
+
+Input
+  - reader: The output of ExportJSONEntityReport().
+  - writer: Where the JSON lines are written.
+  - filter: Selects entities. If nil, all entities are selected.
+  - projection: The value written per entity. If nil, the exported line is written unchanged.
+
+Output
+  - The number of entities read and written.
+*/
+func FilterJSON(reader io.Reader, writer io.Writer, filter *Filter, projection Projection) (FilterResult, error) {
+	var result FilterResult
+	decoder := json.NewDecoder(bufio.NewReader(reader))
+	bufferedWriter := bufio.NewWriter(writer)
+	encoder := json.NewEncoder(bufferedWriter)
+	for {
+		var line json.RawMessage
+		err := decoder.Decode(&line)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return result, err
+		}
+		result.EntitiesRead++
+		entity := &Entity{}
+		if err := json.Unmarshal(line, entity); err != nil {
+			return result, err
+		}
+		if !filter.Match(entity) {
+			continue
+		}
+		if projection == nil {
+			_, err = bufferedWriter.Write(append(line, '\n'))
+		} else {
+			err = encoder.Encode(projection(entity))
+		}
+		if err != nil {
+			return result, err
+		}
+		result.EntitiesWritten++
+	}
+	return result, bufferedWriter.Flush()
+}
+
+// ----------------------------------------------------------------------------
+// CSV
+// ----------------------------------------------------------------------------
+
+/*
+The ExportFilteredCsv function runs a CSV entity export, writing only the rows of entities selected by the filter.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine used to export entities.
+  - csvColumnList: Columns passed to ExportCsvEntityReport(). RESOLVED_ENTITY_ID is always needed.
+  - flags: Flags passed to ExportCsvEntityReport().
+  - writer: Where the CSV is written.
+  - filter: Selects entities. If nil, all entities are selected.
+  - columns: The columns written, in order. If empty, all exported columns are written.
+
+Output
+  - The number of entities read and written.
+*/
+func ExportFilteredCsv(ctx context.Context, szEngine senzing.SzEngine, csvColumnList string, flags int64, writer io.Writer, filter *Filter, columns []string) (FilterResult, error) {
+	reader, err := NewCsvReader(ctx, szEngine, csvColumnList, flags)
+	if err != nil {
+		return FilterResult{}, err
+	}
+	result, err := FilterCsv(reader, writer, filter, columns)
+	closeErr := reader.Close()
+	if err == nil {
+		err = closeErr
+	}
+	return result, err
+}
+
+/*
+The FilterCsv function copies the rows of a CSV export belonging to entities selected by the filter.
+
+Rows are grouped into entities by RESOLVED_ENTITY_ID; Senzing writes the rows of an entity consecutively.
+The entity given to the filter is built from the rows:
+rows having a RELATED_ENTITY_ID of 0 (or no such column) become records,
+and other rows become related entities.
+The DATA_SOURCE, RECORD_ID, MATCH_KEY, MATCH_LEVEL_CODE and ERRULE_CODE columns are used when present.
+
+Input
+  - reader: The output of ExportCsvEntityReport().
+  - writer: Where the CSV is written.
+  - filter: Selects entities. If nil, all entities are selected.
+  - columns: The columns written, in order. If empty, all exported columns are written.
+
+Output
+  - The number of entities read and written.
+*/
+func FilterCsv(reader io.Reader, writer io.Writer, filter *Filter, columns []string) (FilterResult, error) {
+	var result FilterResult
+	csvReader := csv.NewReader(bufio.NewReader(reader))
+	csvReader.FieldsPerRecord = -1
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return result, nil
+	}
+	if err != nil {
+		return result, err
+	}
+	columnIndex := map[string]int{}
+	for i, column := range header {
+		columnIndex[column] = i
+	}
+	if _, ok := columnIndex[csvColumnResolvedEntityID]; !ok {
+		return result, fmt.Errorf("entityexport: CSV export has no %s column", csvColumnResolvedEntityID)
+	}
+	if len(columns) == 0 {
+		columns = header
+	}
+	for _, column := range columns {
+		if _, ok := columnIndex[column]; !ok {
+			return result, fmt.Errorf("entityexport: CSV export has no %s column", column)
+		}
+	}
+
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(columns); err != nil {
+		return result, err
+	}
+
+	var group [][]string
+	flushGroup := func() error {
+		if len(group) == 0 {
+			return nil
+		}
+		result.EntitiesRead++
+		entity, err := entityFromCsvRows(group, columnIndex)
+		if err != nil {
+			return err
+		}
+		if filter.Match(entity) {
+			result.EntitiesWritten++
+			for _, row := range group {
+				projected := make([]string, len(columns))
+				for i, column := range columns {
+					projected[i] = csvValue(row, columnIndex, column)
+				}
+				if err := csvWriter.Write(projected); err != nil {
+					return err
+				}
+			}
+		}
+		group = nil
+		return nil
+	}
+
+	for {
+		row, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return result, err
+		}
+		if len(group) > 0 && csvValue(row, columnIndex, csvColumnResolvedEntityID) != csvValue(group[0], columnIndex, csvColumnResolvedEntityID) {
+			if err := flushGroup(); err != nil {
+				return result, err
+			}
+		}
+		group = append(group, row)
+	}
+	if err := flushGroup(); err != nil {
+		return result, err
+	}
+	csvWriter.Flush()
+	return result, csvWriter.Error()
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func csvValue(row []string, columnIndex map[string]int, column string) string {
+	index, ok := columnIndex[column]
+	if !ok || index >= len(row) {
+		return ""
+	}
+	return row[index]
+}
+
+func entityFromCsvRows(rows [][]string, columnIndex map[string]int) (*Entity, error) {
+	entityID, err := strconv.ParseInt(csvValue(rows[0], columnIndex, csvColumnResolvedEntityID), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	result := &Entity{
+		ResolvedEntity: ResolvedEntity{
+			EntityID: entityID,
+		},
+	}
+	related := map[int64]int{}
+	for _, row := range rows {
+		relatedEntityID := int64(0)
+		if text := csvValue(row, columnIndex, csvColumnRelatedEntityID); len(text) > 0 {
+			relatedEntityID, err = strconv.ParseInt(text, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if relatedEntityID == 0 {
+			result.ResolvedEntity.Records = append(result.ResolvedEntity.Records, Record{
+				DataSource:     csvValue(row, columnIndex, csvColumnDataSource),
+				ErruleCode:     csvValue(row, columnIndex, csvColumnErruleCode),
+				MatchKey:       csvValue(row, columnIndex, csvColumnMatchKey),
+				MatchLevelCode: csvValue(row, columnIndex, csvColumnMatchLevelCode),
+				RecordID:       csvValue(row, columnIndex, csvColumnRecordID),
+			})
+			continue
+		}
+		index, ok := related[relatedEntityID]
+		if !ok {
+			index = len(result.RelatedEntities)
+			related[relatedEntityID] = index
+			result.RelatedEntities = append(result.RelatedEntities, RelatedEntity{
+				EntityID:       relatedEntityID,
+				ErruleCode:     csvValue(row, columnIndex, csvColumnErruleCode),
+				MatchKey:       csvValue(row, columnIndex, csvColumnMatchKey),
+				MatchLevelCode: csvValue(row, columnIndex, csvColumnMatchLevelCode),
+			})
+		}
+		dataSource := csvValue(row, columnIndex, csvColumnDataSource)
+		if len(dataSource) > 0 {
+			relatedEntity := &result.RelatedEntities[index]
+			relatedEntity.RecordSummary = addToRecordSummary(relatedEntity.RecordSummary, dataSource)
+		}
+	}
+	return result, nil
+}
+
+func addToRecordSummary(recordSummary []RecordSummary, dataSource string) []RecordSummary {
+	for i := range recordSummary {
+		if recordSummary[i].DataSource == dataSource {
+			recordSummary[i].RecordCount++
+			return recordSummary
+		}
+	}
+	return append(recordSummary, RecordSummary{DataSource: dataSource, RecordCount: 1})
+}
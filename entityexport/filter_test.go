@@ -0,0 +1,214 @@
+package entityexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testCasesForFilter = []struct {
+	name     string
+	filter   *Filter
+	expected []int64
+}{
+	{
+		name:     "nil",
+		filter:   nil,
+		expected: []int64{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "empty",
+		filter:   &Filter{},
+		expected: []int64{1, 2, 3, 4, 5, 6},
+	},
+	{
+		name:     "dataSources",
+		filter:   &Filter{DataSources: []string{"WATCHLIST", "NO_SUCH_DATASOURCE"}},
+		expected: []int64{2, 4, 5},
+	},
+	{
+		name:     "allDataSources",
+		filter:   &Filter{DataSources: []string{"CUSTOMERS", "WATCHLIST"}, RequireAllDataSources: true},
+		expected: []int64{2, 5},
+	},
+	{
+		name:     "minRecords",
+		filter:   &Filter{MinRecords: 2},
+		expected: []int64{1, 2, 5},
+	},
+	{
+		name:     "maxRecords",
+		filter:   &Filter{MaxRecords: 1},
+		expected: []int64{3, 4, 6},
+	},
+	{
+		name:     "entityIDRange",
+		filter:   &Filter{MinEntityID: 2, MaxEntityID: 4},
+		expected: []int64{2, 3, 4},
+	},
+	{
+		name: "predicate",
+		filter: &Filter{
+			MinEntityID: 2,
+			Predicate: func(entity *Entity) bool {
+				return len(entity.RelatedEntities) == 2
+			},
+		},
+		expected: []int64{3, 6},
+	},
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestFilter_Match(test *testing.T) {
+	entities := readEntities(test)
+	for _, testCase := range testCasesForFilter {
+		test.Run(testCase.name, func(test *testing.T) {
+			actual := []int64{}
+			for i := range entities {
+				if testCase.filter.Match(&entities[i]) {
+					actual = append(actual, entities[i].ResolvedEntity.EntityID)
+				}
+			}
+			assert.Equal(test, testCase.expected, actual)
+		})
+	}
+}
+
+func TestEntityexport_FilterJSON(test *testing.T) {
+	for _, testCase := range testCasesForFilter {
+		test.Run(testCase.name, func(test *testing.T) {
+			var buffer bytes.Buffer
+			result, err := FilterJSON(strings.NewReader(readExportFile(test)), &buffer, testCase.filter, ProjectEntityID)
+			require.NoError(test, err)
+			assert.Equal(test, FilterResult{EntitiesRead: 6, EntitiesWritten: int64(len(testCase.expected))}, result)
+			actual := []int64{}
+			decoder := json.NewDecoder(&buffer)
+			for decoder.More() {
+				value := struct {
+					EntityID int64 `json:"ENTITY_ID"`
+				}{}
+				require.NoError(test, decoder.Decode(&value))
+				actual = append(actual, value.EntityID)
+			}
+			assert.Equal(test, testCase.expected, actual)
+		})
+	}
+}
+
+func TestEntityexport_FilterJSON_unchanged(test *testing.T) {
+	expected := readExportFile(test)
+	var buffer bytes.Buffer
+	_, err := FilterJSON(strings.NewReader(expected), &buffer, nil, nil)
+	require.NoError(test, err)
+	assert.Equal(test, expected, buffer.String())
+}
+
+func TestEntityexport_FilterJSON_projections(test *testing.T) {
+	filter := &Filter{MinEntityID: 2, MaxEntityID: 2}
+	var buffer bytes.Buffer
+	_, err := FilterJSON(strings.NewReader(readExportFile(test)), &buffer, filter, ProjectEntityName)
+	require.NoError(test, err)
+	assert.JSONEq(test, `{"ENTITY_ID":2,"ENTITY_NAME":"Kusha Patel"}`, buffer.String())
+	buffer.Reset()
+	_, err = FilterJSON(strings.NewReader(readExportFile(test)), &buffer, filter, ProjectRecordKeys)
+	require.NoError(test, err)
+	assert.JSONEq(test, `{"ENTITY_ID":2,"RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1009"},{"DATA_SOURCE":"WATCHLIST","RECORD_ID":"1012"}]}`, buffer.String())
+}
+
+func TestEntityexport_FilterJSON_badJSON(test *testing.T) {
+	_, err := FilterJSON(strings.NewReader(`{"RESOLVED_ENTITY":`), &bytes.Buffer{}, nil, nil)
+	require.Error(test, err)
+}
+
+func TestEntityexport_ExportFilteredJSON(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := &mockSzEngine{fragmentSize: 100, remaining: readExportFile(test)}
+	var buffer bytes.Buffer
+	result, err := ExportFilteredJSON(ctx, szEngine, DefaultFlags, &buffer, &Filter{MinRecords: 4}, ProjectEntityID)
+	require.NoError(test, err)
+	assert.Equal(test, FilterResult{EntitiesRead: 6, EntitiesWritten: 1}, result)
+	assert.Equal(test, "{\"ENTITY_ID\":5}\n", buffer.String())
+	assert.Equal(test, 1, szEngine.closeCount)
+}
+
+func TestEntityexport_FilterCsv(test *testing.T) {
+	var buffer bytes.Buffer
+	filter := &Filter{DataSources: []string{"WATCHLIST"}}
+	columns := []string{"RESOLVED_ENTITY_ID", "DATA_SOURCE", "RECORD_ID"}
+	result, err := FilterCsv(strings.NewReader(readExportCsvFile(test)), &buffer, filter, columns)
+	require.NoError(test, err)
+	assert.Equal(test, FilterResult{EntitiesRead: 6, EntitiesWritten: 3}, result)
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	require.Len(test, lines, 13)
+	assert.Equal(test, "RESOLVED_ENTITY_ID,DATA_SOURCE,RECORD_ID", lines[0])
+	assert.Equal(test, "2,CUSTOMERS,1009", lines[1])
+	assert.Equal(test, "4,WATCHLIST,1008", lines[4])
+}
+
+func TestEntityexport_FilterCsv_allColumns(test *testing.T) {
+	expected := readExportCsvFile(test)
+	var buffer bytes.Buffer
+	result, err := FilterCsv(strings.NewReader(expected), &buffer, nil, nil)
+	require.NoError(test, err)
+	assert.Equal(test, FilterResult{EntitiesRead: 6, EntitiesWritten: 6}, result)
+	assert.Equal(test, expected, buffer.String())
+}
+
+func TestEntityexport_FilterCsv_relatedEntities(test *testing.T) {
+	var buffer bytes.Buffer
+	var relatedEntities []RelatedEntity
+	filter := &Filter{
+		MinRecords: 4,
+		Predicate: func(entity *Entity) bool {
+			relatedEntities = entity.RelatedEntities
+			return true
+		},
+	}
+	result, err := FilterCsv(strings.NewReader(readExportCsvFile(test)), &buffer, filter, nil)
+	require.NoError(test, err)
+	assert.Equal(test, int64(1), result.EntitiesWritten)
+	assert.Equal(test, []RelatedEntity{
+		{
+			EntityID:       6,
+			MatchKey:       "+NAME",
+			MatchLevelCode: "NAME_ONLY",
+			RecordSummary:  []RecordSummary{{DataSource: "CUSTOMERS", RecordCount: 1}},
+		},
+	}, relatedEntities)
+}
+
+func TestEntityexport_FilterCsv_badColumns(test *testing.T) {
+	_, err := FilterCsv(strings.NewReader(readExportCsvFile(test)), &bytes.Buffer{}, nil, []string{"NO_SUCH_COLUMN"})
+	require.Error(test, err)
+	_, err = FilterCsv(strings.NewReader("DATA_SOURCE,RECORD_ID\nCUSTOMERS,1001\n"), &bytes.Buffer{}, nil, nil)
+	require.Error(test, err)
+	_, err = FilterCsv(strings.NewReader("RESOLVED_ENTITY_ID\nabc\n"), &bytes.Buffer{}, nil, nil)
+	require.Error(test, err)
+}
+
+func TestEntityexport_FilterCsv_empty(test *testing.T) {
+	var buffer bytes.Buffer
+	result, err := FilterCsv(strings.NewReader(""), &buffer, nil, nil)
+	require.NoError(test, err)
+	assert.Equal(test, FilterResult{}, result)
+	assert.Empty(test, buffer.String())
+}
+
+func TestEntityexport_ExportFilteredCsv(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := &mockSzEngine{fragmentSize: 100, remaining: readExportCsvFile(test)}
+	var buffer bytes.Buffer
+	result, err := ExportFilteredCsv(ctx, szEngine, "", DefaultFlags, &buffer, &Filter{MaxEntityID: 1}, []string{"RESOLVED_ENTITY_ID", "RECORD_ID"})
+	require.NoError(test, err)
+	assert.Equal(test, FilterResult{EntitiesRead: 6, EntitiesWritten: 1}, result)
+	assert.Equal(test, "RESOLVED_ENTITY_ID,RECORD_ID\n1,1001\n1,1002\n1,1003\n1,1008\n", buffer.String())
+	assert.Equal(test, 1, szEngine.closeCount)
+}
@@ -0,0 +1,106 @@
+package entityexport
+
+import (
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Entity is one line of the JSON entity export.
+It and the types it contains are the types of package szmodel.
+*/
+type Entity = szmodel.Entity
+
+// Type FeatureValue is a single value of a feature of a resolved entity.
+type FeatureValue = szmodel.Feature
+
+// Type FeatureDescValue is one of the values combined into a feature value.
+type FeatureDescValue = szmodel.FeatureDescValue
+
+// Type Record is a record that has been resolved into an entity.
+type Record = szmodel.Record
+
+// Type RecordKey identifies a record.
+type RecordKey = szmodel.RecordKey
+
+// Type RecordSummary is the count of records from a single data source within an entity.
+type RecordSummary = szmodel.RecordSummary
+
+// Type RelatedEntity is an entity related to the resolved entity.
+type RelatedEntity = szmodel.RelatedEntity
+
+// Type ResolvedEntity is the entity described by a line of the JSON entity export.
+type ResolvedEntity = szmodel.ResolvedEntity
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+ComponentID is the identifier of the entityexport package used as "subjectId" in observer notifications.
+Notifications sent by [CheckpointedExport] have these "messageId" values:
+  - 8001: A chunk file has been completed and the manifest updated.
+  - 8002: The export is complete.
+  - 8003: The export is resuming from a manifest.
+
+ChunkFilenameTemplate is the template for chunk file names; the chunk number is the parameter.
+
+DefaultChunkSize is the number of entities per chunk when no size is given.
+
+ManifestFilename is the name of the checkpoint manifest within the export directory.
+*/
+const (
+	ComponentID           = 6010
+	ChunkFilenameTemplate = "chunk-%06d.jsonl"
+	DefaultChunkSize      = 100000
+	ManifestFilename      = "manifest.json"
+)
+
+/*
+DefaultFlags are the flags used to export entities when no flags are given.
+They request entity names, features, records with matching information,
+record summaries and related entities with their matching information and record summaries.
+*/
+const DefaultFlags = senzing.SzExportIncludeAllEntities |
+	senzing.SzEntityIncludeAllRelations |
+	senzing.SzEntityIncludeEntityName |
+	senzing.SzEntityIncludeRepresentativeFeatures |
+	senzing.SzEntityIncludeRecordSummary |
+	senzing.SzEntityIncludeRecordData |
+	senzing.SzEntityIncludeRecordMatchingInfo |
+	senzing.SzEntityIncludeRelatedEntityName |
+	senzing.SzEntityIncludeRelatedMatchingInfo |
+	senzing.SzEntityIncludeRelatedRecordSummary
+
+// ----------------------------------------------------------------------------
+// CSV columns
+// ----------------------------------------------------------------------------
+
+const (
+	csvColumnDataSource       = "DATA_SOURCE"
+	csvColumnErruleCode       = "ERRULE_CODE"
+	csvColumnMatchKey         = "MATCH_KEY"
+	csvColumnMatchLevelCode   = "MATCH_LEVEL_CODE"
+	csvColumnRecordID         = "RECORD_ID"
+	csvColumnRelatedEntityID  = "RELATED_ENTITY_ID"
+	csvColumnResolvedEntityID = "RESOLVED_ENTITY_ID"
+)
+
+// ----------------------------------------------------------------------------
+// Match levels
+// ----------------------------------------------------------------------------
+
+/*
+Match levels reported in MATCH_LEVEL of records and related entities.
+*/
+const (
+	MatchLevelResolved        = szmodel.MatchLevelResolved
+	MatchLevelPossiblySame    = szmodel.MatchLevelPossiblySame
+	MatchLevelPossiblyRelated = szmodel.MatchLevelPossiblyRelated
+	MatchLevelNameOnly        = szmodel.MatchLevelNameOnly
+	MatchLevelDisclosed       = szmodel.MatchLevelDisclosed
+)
@@ -0,0 +1,19 @@
+/*
+Package entitygraph renders the networks and paths found by [senzing.SzEngine]
+as Graphviz DOT or Mermaid diagrams for investigation reports.
+
+[FromNetwork] and [FromPath] build a [Graph] from the results of FindNetworkByEntityID,
+FindNetworkByRecordID, FindPathByEntityID and FindPathByRecordID,
+decoded with [szmodel.DecodeNetwork] and [szmodel.DecodePath].
+Nodes are labeled with the best name, entity ID and data sources of each entity,
+and edges with the MATCH_KEY of the relationship.
+Edges on a path are drawn thick, and disclosed or ambiguous relationships are drawn dashed or dotted.
+
+[RenderDOT] and [RenderMermaid] write the diagram source.
+Rendering is pure Go; Graphviz is only needed to turn DOT source into an image.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+[szmodel.DecodeNetwork]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szmodel#DecodeNetwork
+[szmodel.DecodePath]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szmodel#DecodePath
+*/
+package entitygraph
@@ -0,0 +1,221 @@
+package entitygraph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+)
+
+// ----------------------------------------------------------------------------
+// Interface functions
+// ----------------------------------------------------------------------------
+
+/*
+The FromNetwork function builds a graph from the result of FindNetworkByEntityID or FindNetworkByRecordID.
+The entities at the ends of ENTITY_PATHS are marked as endpoints and links along them as on a path.
+
+Input
+  - network: The decoded network.
+
+Output
+  - The graph.
+*/
+func FromNetwork(network *szmodel.Network) *Graph {
+	return newGraph(network.Entities, network.Links(), network.EntityPaths)
+}
+
+/*
+The FromPath function builds a graph from the result of FindPathByEntityID or FindPathByRecordID.
+The start and end entities are marked as endpoints and links along the path as on the path.
+
+Input
+  - path: The decoded path.
+
+Output
+  - The graph.
+*/
+func FromPath(path *szmodel.Path) *Graph {
+	return newGraph(path.Entities, path.Links(), path.EntityPaths)
+}
+
+/*
+The RenderDOT function writes a graph as a Graphviz DOT undirected graph.
+
+Input
+  - writer: Where the DOT source is written.
+  - graph: The graph to render.
+*/
+func RenderDOT(writer io.Writer, graph *Graph) error {
+	bufferedWriter := bufio.NewWriter(writer)
+	fmt.Fprintln(bufferedWriter, "graph entities {")
+	fmt.Fprintln(bufferedWriter, "  node [shape=box];")
+	for _, node := range graph.Nodes {
+		attributes := []string{fmt.Sprintf("label=%s", dotString(strings.Join(nodeLabel(node), "\n")))}
+		if node.Endpoint {
+			attributes = append(attributes, "peripheries=2")
+		}
+		fmt.Fprintf(bufferedWriter, "  %d [%s];\n", node.EntityID, strings.Join(attributes, ", "))
+	}
+	for _, edge := range graph.Edges {
+		attributes := []string{fmt.Sprintf("label=%s", dotString(edgeLabel(edge)))}
+		if edge.OnPath {
+			attributes = append(attributes, "penwidth=2")
+		}
+		switch {
+		case edge.Disclosed:
+			attributes = append(attributes, "style=dashed")
+		case edge.Ambiguous:
+			attributes = append(attributes, "style=dotted")
+		}
+		fmt.Fprintf(bufferedWriter, "  %d -- %d [%s];\n", edge.MinEntityID, edge.MaxEntityID, strings.Join(attributes, ", "))
+	}
+	fmt.Fprintln(bufferedWriter, "}")
+	return bufferedWriter.Flush()
+}
+
+/*
+The RenderMermaid function writes a graph as a Mermaid flowchart.
+Links on a path are thick; other disclosed or ambiguous links are dotted.
+
+Input
+  - writer: Where the Mermaid source is written.
+  - graph: The graph to render.
+*/
+func RenderMermaid(writer io.Writer, graph *Graph) error {
+	bufferedWriter := bufio.NewWriter(writer)
+	fmt.Fprintln(bufferedWriter, "graph LR")
+	endpoints := []string{}
+	for _, node := range graph.Nodes {
+		lines := []string{}
+		for _, line := range nodeLabel(node) {
+			lines = append(lines, mermaidEscape(line))
+		}
+		fmt.Fprintf(bufferedWriter, "  %s[\"%s\"]\n", mermaidID(node.EntityID), strings.Join(lines, "<br/>"))
+		if node.Endpoint {
+			endpoints = append(endpoints, mermaidID(node.EntityID))
+		}
+	}
+	for _, edge := range graph.Edges {
+		link := "---"
+		switch {
+		case edge.OnPath:
+			link = "==="
+		case edge.Disclosed, edge.Ambiguous:
+			link = "-.-"
+		}
+		fmt.Fprintf(bufferedWriter, "  %s %s|\"%s\"| %s\n", mermaidID(edge.MinEntityID), link, mermaidEscape(edgeLabel(edge)), mermaidID(edge.MaxEntityID))
+	}
+	if len(endpoints) > 0 {
+		fmt.Fprintln(bufferedWriter, "  classDef endpoint stroke-width:3px")
+		fmt.Fprintf(bufferedWriter, "  class %s endpoint\n", strings.Join(endpoints, ","))
+	}
+	return bufferedWriter.Flush()
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Quote a DOT string, escaping backslashes and quotes and keeping line breaks as \n.
+func dotString(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// Label an edge with its MATCH_KEY, falling back to its MATCH_LEVEL_CODE.
+func edgeLabel(edge Edge) string {
+	if len(edge.MatchKey) > 0 {
+		return edge.MatchKey
+	}
+	return edge.MatchLevelCode
+}
+
+// Escape characters that Mermaid would otherwise interpret inside a quoted label.
+func mermaidEscape(value string) string {
+	replacer := strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;")
+	return replacer.Replace(value)
+}
+
+func mermaidID(entityID int64) string {
+	return fmt.Sprintf("e%d", entityID)
+}
+
+func newGraph(entities []szmodel.Entity, links []szmodel.EntityLink, entityPaths []szmodel.EntityPath) *Graph {
+	endpoints := map[int64]bool{}
+	onPath := map[[2]int64]bool{}
+	nodes := map[int64]*Node{}
+	for _, entityPath := range entityPaths {
+		endpoints[entityPath.StartEntityID] = true
+		endpoints[entityPath.EndEntityID] = true
+		for index, entityID := range entityPath.Entities {
+			nodes[entityID] = &Node{EntityID: entityID}
+			if index > 0 {
+				previous := entityPath.Entities[index-1]
+				onPath[[2]int64{min(previous, entityID), max(previous, entityID)}] = true
+			}
+		}
+	}
+	for _, link := range links {
+		for _, entityID := range []int64{link.MinEntityID, link.MaxEntityID} {
+			if _, ok := nodes[entityID]; !ok {
+				nodes[entityID] = &Node{EntityID: entityID}
+			}
+		}
+	}
+	for _, entity := range entities {
+		resolvedEntity := entity.ResolvedEntity
+		nodes[resolvedEntity.EntityID] = &Node{
+			EntityID:      resolvedEntity.EntityID,
+			Name:          resolvedEntity.EntityName,
+			RecordSummary: resolvedEntity.RecordSummary,
+		}
+	}
+	result := &Graph{
+		Nodes: []Node{},
+		Edges: []Edge{},
+	}
+	for entityID, node := range nodes {
+		if entityID == 0 {
+			continue
+		}
+		node.Endpoint = endpoints[entityID]
+		result.Nodes = append(result.Nodes, *node)
+	}
+	sort.Slice(result.Nodes, func(i, j int) bool {
+		return result.Nodes[i].EntityID < result.Nodes[j].EntityID
+	})
+	for _, link := range links {
+		result.Edges = append(result.Edges, Edge{
+			MinEntityID:    link.MinEntityID,
+			MaxEntityID:    link.MaxEntityID,
+			MatchKey:       link.MatchKey,
+			MatchLevelCode: link.MatchLevelCode,
+			ErruleCode:     link.ErruleCode,
+			Disclosed:      link.IsDisclosed != 0,
+			Ambiguous:      link.IsAmbiguous != 0,
+			OnPath:         onPath[[2]int64{link.MinEntityID, link.MaxEntityID}],
+		})
+	}
+	return result
+}
+
+// Label a node with its best name, entity ID and data sources, one per line.
+func nodeLabel(node Node) []string {
+	result := []string{}
+	if len(node.Name) > 0 {
+		result = append(result, node.Name)
+	}
+	result = append(result, fmt.Sprintf("Entity %d", node.EntityID))
+	dataSources := []string{}
+	for _, recordSummary := range node.RecordSummary {
+		dataSources = append(dataSources, fmt.Sprintf("%s (%d)", recordSummary.DataSource, recordSummary.RecordCount))
+	}
+	if len(dataSources) > 0 {
+		result = append(result, strings.Join(dataSources, ", "))
+	}
+	return result
+}
@@ -0,0 +1,171 @@
+package entitygraph
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	printResults      = false
+	responseDirectory = "../testdata/responses"
+)
+
+var pathResponse = `{"ENTITY_PATHS":[{"START_ENTITY_ID":3,"END_ENTITY_ID":1,"ENTITIES":[3,2,1]}],
+"ENTITY_PATH_LINKS":[
+{"MIN_ENTITY_ID":2,"MAX_ENTITY_ID":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS","ERRULE_CODE":"MFF","IS_DISCLOSED":0,"IS_AMBIGUOUS":1},
+{"MIN_ENTITY_ID":1,"MAX_ENTITY_ID":2,"MATCH_LEVEL_CODE":"DISCLOSED","MATCH_KEY":"","ERRULE_CODE":"DISCLOSED","IS_DISCLOSED":1,"IS_AMBIGUOUS":0}],
+"ENTITIES":[
+{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"ACME \"WIDGETS\" <LTD>","RECORD_SUMMARY":[{"DATA_SOURCE":"COMPANIES","RECORD_COUNT":1}]}},
+{"RESOLVED_ENTITY":{"ENTITY_ID":3,"ENTITY_NAME":"BOB SMITH","RECORD_SUMMARY":[{"DATA_SOURCE":"CUSTOMERS","RECORD_COUNT":2},{"DATA_SOURCE":"WATCHLIST","RECORD_COUNT":1}]}}]}`
+
+// Type failingWriter fails every write.
+type failingWriter struct{}
+
+func (writer *failingWriter) Write(buffer []byte) (int, error) {
+	_ = buffer
+	return 0, errors.New("write failed")
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestEntitygraph_FromNetwork(test *testing.T) {
+	graph := FromNetwork(readNetwork(test))
+	printActual(test, graph)
+	require.Len(test, graph.Nodes, 3)
+	assert.Equal(test, Node{
+		EntityID:      1,
+		Name:          "JOHNSON",
+		RecordSummary: graph.Nodes[0].RecordSummary,
+		Endpoint:      true,
+	}, graph.Nodes[0])
+	assert.False(test, graph.Nodes[2].Endpoint)
+	require.Len(test, graph.Edges, 3)
+	assert.True(test, graph.Edges[0].OnPath)
+	assert.False(test, graph.Edges[1].OnPath)
+}
+
+func TestEntitygraph_FromPath(test *testing.T) {
+	path, err := szmodel.DecodePath(pathResponse)
+	require.NoError(test, err)
+	graph := FromPath(path)
+	printActual(test, graph)
+	assert.Equal(test, []int64{1, 2, 3}, nodeIDs(graph))
+	assert.Empty(test, graph.Nodes[1].Name, "entities on the path without details still get a node")
+	assert.False(test, graph.Nodes[1].Endpoint)
+	assert.Equal(test, []Edge{
+		{MinEntityID: 1, MaxEntityID: 2, MatchLevelCode: "DISCLOSED", ErruleCode: "DISCLOSED", Disclosed: true, OnPath: true},
+		{MinEntityID: 2, MaxEntityID: 3, MatchKey: "+ADDRESS", MatchLevelCode: "POSSIBLY_RELATED", ErruleCode: "MFF", Ambiguous: true, OnPath: true},
+	}, graph.Edges)
+}
+
+func TestEntitygraph_RenderDOT(test *testing.T) {
+	var buffer bytes.Buffer
+	require.NoError(test, RenderDOT(&buffer, FromNetwork(readNetwork(test))))
+	printActual(test, buffer.String())
+	expected := `graph entities {
+  node [shape=box];
+  1 [label="JOHNSON\nEntity 1\nTEST (2)", peripheries=2];
+  2 [label="OCEANGUY\nEntity 2\nTEST (1)", peripheries=2];
+  3 [label="Smith\nEntity 3\nTEST (1)"];
+  1 -- 2 [label="+PHONE+ACCT_NUM-SSN", penwidth=2];
+  1 -- 3 [label="+PHONE+ACCT_NUM-DOB-SSN"];
+  2 -- 3 [label="+ADDRESS+PHONE+ACCT_NUM-DOB-SSN"];
+}
+`
+	assert.Equal(test, expected, buffer.String())
+}
+
+func TestEntitygraph_RenderDOT_path(test *testing.T) {
+	path, err := szmodel.DecodePath(pathResponse)
+	require.NoError(test, err)
+	var buffer bytes.Buffer
+	require.NoError(test, RenderDOT(&buffer, FromPath(path)))
+	printActual(test, buffer.String())
+	expected := `graph entities {
+  node [shape=box];
+  1 [label="ACME \"WIDGETS\" <LTD>\nEntity 1\nCOMPANIES (1)", peripheries=2];
+  2 [label="Entity 2"];
+  3 [label="BOB SMITH\nEntity 3\nCUSTOMERS (2), WATCHLIST (1)", peripheries=2];
+  1 -- 2 [label="DISCLOSED", penwidth=2, style=dashed];
+  2 -- 3 [label="+ADDRESS", penwidth=2, style=dotted];
+}
+`
+	assert.Equal(test, expected, buffer.String())
+}
+
+func TestEntitygraph_RenderDOT_writeError(test *testing.T) {
+	require.Error(test, RenderDOT(&failingWriter{}, FromNetwork(readNetwork(test))))
+}
+
+func TestEntitygraph_RenderMermaid(test *testing.T) {
+	var buffer bytes.Buffer
+	require.NoError(test, RenderMermaid(&buffer, FromNetwork(readNetwork(test))))
+	printActual(test, buffer.String())
+	expected := `graph LR
+  e1["JOHNSON<br/>Entity 1<br/>TEST (2)"]
+  e2["OCEANGUY<br/>Entity 2<br/>TEST (1)"]
+  e3["Smith<br/>Entity 3<br/>TEST (1)"]
+  e1 ===|"+PHONE+ACCT_NUM-SSN"| e2
+  e1 ---|"+PHONE+ACCT_NUM-DOB-SSN"| e3
+  e2 ---|"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN"| e3
+  classDef endpoint stroke-width:3px
+  class e1,e2 endpoint
+`
+	assert.Equal(test, expected, buffer.String())
+}
+
+func TestEntitygraph_RenderMermaid_escaping(test *testing.T) {
+	path, err := szmodel.DecodePath(pathResponse)
+	require.NoError(test, err)
+	path.EntityPaths = nil
+	var buffer bytes.Buffer
+	require.NoError(test, RenderMermaid(&buffer, FromPath(path)))
+	printActual(test, buffer.String())
+	expected := `graph LR
+  e1["ACME #quot;WIDGETS#quot; #lt;LTD#gt;<br/>Entity 1<br/>COMPANIES (1)"]
+  e2["Entity 2"]
+  e3["BOB SMITH<br/>Entity 3<br/>CUSTOMERS (2), WATCHLIST (1)"]
+  e1 -.-|"DISCLOSED"| e2
+  e2 -.-|"+ADDRESS"| e3
+`
+	assert.Equal(test, expected, buffer.String())
+}
+
+func TestEntitygraph_RenderMermaid_writeError(test *testing.T) {
+	require.Error(test, RenderMermaid(&failingWriter{}, FromNetwork(readNetwork(test))))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func nodeIDs(graph *Graph) []int64 {
+	result := []int64{}
+	for _, node := range graph.Nodes {
+		result = append(result, node.EntityID)
+	}
+	return result
+}
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %+v", actual)
+	}
+}
+
+func readNetwork(test *testing.T) *szmodel.Network {
+	response, err := os.ReadFile(filepath.Join(responseDirectory, "find-network-by-record-id.json"))
+	require.NoError(test, err)
+	result, err := szmodel.DecodeNetwork(string(response))
+	require.NoError(test, err)
+	return result
+}
@@ -0,0 +1,33 @@
+package entitygraph
+
+import "github.com/senzing-garage/sz-sdk-go-core/szmodel"
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Type Edge is a relationship between two entities of a graph.
+type Edge struct {
+	MinEntityID    int64
+	MaxEntityID    int64
+	MatchKey       string
+	MatchLevelCode string
+	ErruleCode     string
+	Disclosed      bool
+	Ambiguous      bool
+	OnPath         bool
+}
+
+// Type Graph is the entities and relationships of a network or path, ordered by entity IDs.
+type Graph struct {
+	Nodes []Node
+	Edges []Edge
+}
+
+// Type Node is an entity of a graph.
+type Node struct {
+	EntityID      int64
+	Name          string
+	RecordSummary []szmodel.RecordSummary
+	Endpoint      bool
+}
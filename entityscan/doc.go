@@ -0,0 +1,11 @@
+/*
+Package entityscan is used to find oversized and suspicious entities in the JSON entity export of [senzing.SzEngine].
+
+Over-merged entities typically have many records and many distinct names or identifiers.
+A [Scanner] flags each entity exceeding any of the configured [Thresholds],
+ranks the flagged entities by how far they exceed the thresholds,
+and gathers HowEntityByEntityID() output showing the resolution steps that built each one.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+package entityscan
@@ -0,0 +1,209 @@
+package entityscan
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/senzing-garage/sz-sdk-go-core/entityexport"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// Type Scanner struct flags entities one at a time.
+type Scanner struct {
+	flagged         []FlaggedEntity
+	scannedEntities int64
+	thresholds      Thresholds
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The New function returns a Scanner using the given thresholds.
+
+Input
+  - thresholds: The maximum values an entity may have before it is flagged. See [DefaultThresholds].
+*/
+func New(thresholds Thresholds) *Scanner {
+	return &Scanner{
+		flagged:    []FlaggedEntity{},
+		thresholds: thresholds,
+	}
+}
+
+/*
+The Scan function exports multi-record entities using [ExportFlags],
+flags those exceeding the thresholds and gathers HowEntityByEntityID() output for the highest ranked.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine used to export and explain entities.
+  - thresholds: The maximum values an entity may have before it is flagged.
+  - maxEntities: The maximum number of flagged entities reported. Zero reports all.
+
+Output
+  - The ranked report.
+*/
+func Scan(ctx context.Context, szEngine senzing.SzEngine, thresholds Thresholds, maxEntities int) (*Report, error) {
+	scanner := New(thresholds)
+	reader, err := entityexport.NewJSONReader(ctx, szEngine, ExportFlags)
+	if err != nil {
+		return nil, err
+	}
+	err = scanner.ScanReader(reader)
+	closeErr := reader.Close()
+	if err != nil {
+		return nil, err
+	}
+	if closeErr != nil {
+		return nil, closeErr
+	}
+	return scanner.Report(ctx, szEngine, maxEntities), nil
+}
+
+// ----------------------------------------------------------------------------
+// Scanner methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Add checks an entity against the thresholds.
+
+Input
+  - entity: An entity from the JSON entity export. FEATURES are needed to count distinct values.
+
+Output
+  - True if the entity was flagged.
+*/
+func (scanner *Scanner) Add(entity *entityexport.Entity) bool {
+	scanner.scannedEntities++
+	flagged := FlaggedEntity{
+		DistinctDOBs:  distinctFeatureValues(entity, FeatureDOB),
+		DistinctNames: distinctFeatureValues(entity, FeatureName),
+		DistinctSSNs:  distinctFeatureValues(entity, FeatureSSN),
+		EntityID:      entity.ResolvedEntity.EntityID,
+		EntityName:    entity.ResolvedEntity.EntityName,
+		Reasons:       []string{},
+		RecordCount:   entity.RecordCount(),
+	}
+	flagged.check(ReasonRecords, flagged.RecordCount, scanner.thresholds.MaxRecords)
+	flagged.check(ReasonDistinctNames, flagged.DistinctNames, scanner.thresholds.MaxDistinctNames)
+	flagged.check(ReasonDistinctDOBs, flagged.DistinctDOBs, scanner.thresholds.MaxDistinctDOBs)
+	flagged.check(ReasonDistinctSSNs, flagged.DistinctSSNs, scanner.thresholds.MaxDistinctSSNs)
+	if len(flagged.Reasons) == 0 {
+		return false
+	}
+	scanner.flagged = append(scanner.flagged, flagged)
+	return true
+}
+
+/*
+Method Report ranks the flagged entities and gathers HowEntityByEntityID() output for each reported entity.
+Entities are ranked by descending score, then ascending entity ID.
+The score is the sum, over exceeded thresholds, of value divided by threshold.
+A failure of HowEntityByEntityID() is recorded in the entity rather than failing the report.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine used to explain entities. If nil, HowEntityByEntityID() is not called.
+  - maxEntities: The maximum number of flagged entities reported. Zero reports all.
+
+Output
+  - The ranked report.
+*/
+func (scanner *Scanner) Report(ctx context.Context, szEngine senzing.SzEngine, maxEntities int) *Report {
+	entities := make([]FlaggedEntity, len(scanner.flagged))
+	copy(entities, scanner.flagged)
+	sort.Slice(entities, func(i, j int) bool {
+		if entities[i].Score != entities[j].Score {
+			return entities[i].Score > entities[j].Score
+		}
+		return entities[i].EntityID < entities[j].EntityID
+	})
+	if maxEntities > 0 && len(entities) > maxEntities {
+		entities = entities[:maxEntities]
+	}
+	for i := range entities {
+		entities[i].Rank = i + 1
+		if szEngine == nil {
+			continue
+		}
+		howEntity, err := szEngine.HowEntityByEntityID(ctx, entities[i].EntityID, senzing.SzHowEntityDefaultFlags)
+		switch {
+		case err != nil:
+			entities[i].HowEntityError = err.Error()
+		case json.Valid([]byte(howEntity)):
+			entities[i].HowEntity = json.RawMessage(howEntity)
+		default:
+			entities[i].HowEntityError = "invalid JSON returned by HowEntityByEntityID"
+		}
+	}
+	return &Report{
+		Entities:        entities,
+		FlaggedEntities: int64(len(scanner.flagged)),
+		ScannedEntities: scanner.scannedEntities,
+		Thresholds:      scanner.thresholds,
+	}
+}
+
+/*
+Method ScanReader checks every entity of a JSON-lines entity export.
+
+Input
+  - reader: The output of ExportJSONEntityReport(), e.g. an [entityexport.Reader] or an exported file.
+*/
+func (scanner *Scanner) ScanReader(reader io.Reader) error {
+	_, err := entityexport.ForEach(reader, func(entity *entityexport.Entity) error {
+		scanner.Add(entity)
+		return nil
+	})
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Report methods
+// ----------------------------------------------------------------------------
+
+/*
+Method WriteJSON writes the report as indented JSON.
+
+Input
+  - writer: Where the JSON is written.
+*/
+func (report *Report) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (flagged *FlaggedEntity) check(reason string, value int64, threshold int64) {
+	if threshold <= 0 || value <= threshold {
+		return
+	}
+	flagged.Reasons = append(flagged.Reasons, reason)
+	flagged.Score += float64(value) / float64(threshold)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func distinctFeatureValues(entity *entityexport.Entity, featureType string) int64 {
+	seen := map[string]bool{}
+	for _, feature := range entity.ResolvedEntity.Features[featureType] {
+		if len(feature.FeatDescValues) == 0 {
+			seen[feature.FeatDesc] = true
+			continue
+		}
+		for _, value := range feature.FeatDescValues {
+			seen[value.FeatDesc] = true
+		}
+	}
+	return int64(len(seen))
+}
@@ -0,0 +1,162 @@
+package entityscan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	exportFilename = "../testdata/export/export-json-entity-report.jsonl"
+	printResults   = false
+)
+
+var testThresholds = Thresholds{
+	MaxRecords:       3,
+	MaxDistinctNames: 2,
+	MaxDistinctDOBs:  1,
+	MaxDistinctSSNs:  1,
+}
+
+// Type mockSzEngine serves a fixed export and canned HowEntityByEntityID() results.
+type mockSzEngine struct {
+	senzing.SzEngine
+	howErrors map[int64]error
+	remaining string
+}
+
+func (szEngine *mockSzEngine) CloseExport(ctx context.Context, exportHandle uintptr) error {
+	_ = ctx
+	_ = exportHandle
+	return nil
+}
+
+func (szEngine *mockSzEngine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	_ = ctx
+	_ = flags
+	return 1, nil
+}
+
+func (szEngine *mockSzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	_ = ctx
+	_ = exportHandle
+	result := szEngine.remaining
+	szEngine.remaining = ""
+	return result, nil
+}
+
+func (szEngine *mockSzEngine) HowEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	_ = ctx
+	_ = flags
+	if err, ok := szEngine.howErrors[entityID]; ok {
+		return "", err
+	}
+	return fmt.Sprintf(`{"HOW_RESULTS":{"FINAL_STATE":{"VIRTUAL_ENTITIES":[{"VIRTUAL_ENTITY_ID":"V%d"}]}}}`, entityID), nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestEntityscan_Add(test *testing.T) {
+	scanner := New(testThresholds)
+	require.NoError(test, scanner.ScanReader(strings.NewReader(readExportFile(test))))
+	actual := scanner.Report(context.TODO(), nil, 0)
+	printActual(test, actual)
+	assert.Equal(test, int64(6), actual.ScannedEntities)
+	assert.Equal(test, int64(2), actual.FlaggedEntities)
+	require.Len(test, actual.Entities, 2)
+
+	entity := actual.Entities[0]
+	assert.Equal(test, 1, entity.Rank)
+	assert.Equal(test, int64(5), entity.EntityID)
+	assert.Equal(test, "Edward Kusha", entity.EntityName)
+	assert.Equal(test, int64(4), entity.RecordCount)
+	assert.Equal(test, int64(3), entity.DistinctNames)
+	assert.Equal(test, int64(2), entity.DistinctDOBs)
+	assert.Equal(test, int64(2), entity.DistinctSSNs)
+	assert.Equal(test, []string{ReasonRecords, ReasonDistinctNames, ReasonDistinctDOBs, ReasonDistinctSSNs}, entity.Reasons)
+	assert.InDelta(test, 4.0/3.0+1.5+2+2, entity.Score, 0.0001)
+	assert.Nil(test, entity.HowEntity)
+
+	entity = actual.Entities[1]
+	assert.Equal(test, 2, entity.Rank)
+	assert.Equal(test, int64(1), entity.EntityID)
+	assert.Equal(test, []string{ReasonDistinctNames, ReasonDistinctDOBs}, entity.Reasons)
+}
+
+func TestEntityscan_Add_disabledThresholds(test *testing.T) {
+	scanner := New(Thresholds{})
+	require.NoError(test, scanner.ScanReader(strings.NewReader(readExportFile(test))))
+	actual := scanner.Report(context.TODO(), nil, 0)
+	assert.Equal(test, int64(0), actual.FlaggedEntities)
+	assert.Empty(test, actual.Entities)
+}
+
+func TestEntityscan_Report_maxEntities(test *testing.T) {
+	scanner := New(testThresholds)
+	require.NoError(test, scanner.ScanReader(strings.NewReader(readExportFile(test))))
+	actual := scanner.Report(context.TODO(), nil, 1)
+	assert.Equal(test, int64(2), actual.FlaggedEntities)
+	require.Len(test, actual.Entities, 1)
+	assert.Equal(test, int64(5), actual.Entities[0].EntityID)
+}
+
+func TestEntityscan_Scan(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := &mockSzEngine{
+		howErrors: map[int64]error{1: errors.New("how failed")},
+		remaining: readExportFile(test),
+	}
+	actual, err := Scan(ctx, szEngine, testThresholds, 0)
+	require.NoError(test, err)
+	require.Len(test, actual.Entities, 2)
+	assert.JSONEq(test, `{"HOW_RESULTS":{"FINAL_STATE":{"VIRTUAL_ENTITIES":[{"VIRTUAL_ENTITY_ID":"V5"}]}}}`, string(actual.Entities[0].HowEntity))
+	assert.Empty(test, actual.Entities[0].HowEntityError)
+	assert.Nil(test, actual.Entities[1].HowEntity)
+	assert.Equal(test, "how failed", actual.Entities[1].HowEntityError)
+}
+
+func TestEntityscan_ScanReader_badJSON(test *testing.T) {
+	scanner := New(DefaultThresholds)
+	require.Error(test, scanner.ScanReader(strings.NewReader(`{"RESOLVED_ENTITY":`)))
+}
+
+func TestEntityscan_WriteJSON(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := &mockSzEngine{remaining: readExportFile(test)}
+	report, err := Scan(ctx, szEngine, testThresholds, 0)
+	require.NoError(test, err)
+	var buffer bytes.Buffer
+	require.NoError(test, report.WriteJSON(&buffer))
+	actual := &Report{}
+	require.NoError(test, json.Unmarshal(buffer.Bytes(), actual))
+	assert.Equal(test, report.Thresholds, actual.Thresholds)
+	assert.Equal(test, report.Entities[0].EntityID, actual.Entities[0].EntityID)
+	assert.JSONEq(test, string(report.Entities[0].HowEntity), string(actual.Entities[0].HowEntity))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("%+v", actual)
+	}
+}
+
+func readExportFile(test *testing.T) string {
+	result, err := os.ReadFile(exportFilename)
+	require.NoError(test, err)
+	return string(result)
+}
@@ -0,0 +1,93 @@
+package entityscan
+
+import (
+	"encoding/json"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Type FlaggedEntity is an entity that exceeded at least one threshold.
+type FlaggedEntity struct {
+	Rank           int             `json:"RANK"`
+	Score          float64         `json:"SCORE"`
+	EntityID       int64           `json:"ENTITY_ID"`
+	EntityName     string          `json:"ENTITY_NAME"`
+	RecordCount    int64           `json:"RECORD_COUNT"`
+	DistinctNames  int64           `json:"DISTINCT_NAMES"`
+	DistinctDOBs   int64           `json:"DISTINCT_DOBS"`
+	DistinctSSNs   int64           `json:"DISTINCT_SSNS"`
+	Reasons        []string        `json:"REASONS"`
+	HowEntity      json.RawMessage `json:"HOW_ENTITY,omitempty"`
+	HowEntityError string          `json:"HOW_ENTITY_ERROR,omitempty"`
+}
+
+// Type Report is the ranked result of a scan.
+type Report struct {
+	Thresholds      Thresholds      `json:"THRESHOLDS"`
+	ScannedEntities int64           `json:"SCANNED_ENTITIES"`
+	FlaggedEntities int64           `json:"FLAGGED_ENTITIES"`
+	Entities        []FlaggedEntity `json:"ENTITIES"`
+}
+
+/*
+Type Thresholds holds the maximum values an entity may have before it is flagged.
+An entity is flagged when a value is greater than its threshold.
+A threshold of zero disables the check.
+*/
+type Thresholds struct {
+	MaxRecords       int64 `json:"MAX_RECORDS"`
+	MaxDistinctNames int64 `json:"MAX_DISTINCT_NAMES"`
+	MaxDistinctDOBs  int64 `json:"MAX_DISTINCT_DOBS"`
+	MaxDistinctSSNs  int64 `json:"MAX_DISTINCT_SSNS"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+ExportFlags are the flags needed by ExportJSONEntityReport() to scan entities.
+Entities having a single record cannot be over-merged, so only multi-record entities are exported.
+*/
+const ExportFlags = senzing.SzExportIncludeMultiRecordEntities |
+	senzing.SzEntityIncludeEntityName |
+	senzing.SzEntityIncludeRepresentativeFeatures |
+	senzing.SzEntityIncludeRecordData |
+	senzing.SzEntityIncludeRecordSummary
+
+/*
+Feature types counted by the scanner.
+*/
+const (
+	FeatureDOB  = "DOB"
+	FeatureName = "NAME"
+	FeatureSSN  = "SSN"
+)
+
+/*
+Reasons reported in [FlaggedEntity].
+*/
+const (
+	ReasonDistinctDOBs  = "DISTINCT_DOBS"
+	ReasonDistinctNames = "DISTINCT_NAMES"
+	ReasonDistinctSSNs  = "DISTINCT_SSNS"
+	ReasonRecords       = "RECORDS"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+/*
+DefaultThresholds are reasonable starting values for most repositories.
+*/
+var DefaultThresholds = Thresholds{
+	MaxRecords:       100,
+	MaxDistinctNames: 10,
+	MaxDistinctDOBs:  3,
+	MaxDistinctSSNs:  2,
+}
@@ -0,0 +1,17 @@
+/*
+Package explain renders the Why and How explanations of [senzing.SzEngine]
+as plain text or Markdown narratives for data stewards.
+
+[RenderWhy] renders the results of WhyEntities, WhyRecords and WhyRecordInEntity,
+one sentence per result such as "Entity 1 and entity 2 matched on NAME+DOB+ADDRESS; SSN conflicted",
+followed by the feature scores.
+[RenderHow] renders the result of HowEntityByEntityID as a step-by-step timeline
+of the virtual entities that were combined to form the entity.
+
+Responses are decoded with [szmodel.DecodeWhy] and [szmodel.DecodeHow].
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+[szmodel.DecodeWhy]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szmodel#DecodeWhy
+[szmodel.DecodeHow]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szmodel#DecodeHow
+*/
+package explain
@@ -0,0 +1,266 @@
+package explain
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+)
+
+// ----------------------------------------------------------------------------
+// Renderers
+// ----------------------------------------------------------------------------
+
+/*
+The RenderWhy function writes a narrative for each result of WhyEntities, WhyRecords or WhyRecordInEntity.
+Entity names are used when the response includes them.
+
+Input
+  - writer: Where the narrative is written.
+  - why: The decoded response.
+  - format: FormatText or FormatMarkdown.
+*/
+func RenderWhy(writer io.Writer, why *szmodel.WhyResponse, format Format) error {
+	names := entityNames(why.Entities)
+	var builder strings.Builder
+	for i := range why.WhyResults {
+		whyResult := &why.WhyResults[i]
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		if format == FormatMarkdown {
+			fmt.Fprintf(&builder, "## %s\n\n", capitalize(subject(whyResult, names)))
+		}
+		builder.WriteString(whyNarrative(whyResult, names, format))
+		builder.WriteString("\n")
+		writeFeatureScores(&builder, whyResult.MatchInfo.FeatureScores, format)
+	}
+	_, err := io.WriteString(writer, builder.String())
+	return err
+}
+
+/*
+The RenderHow function writes the resolution steps of HowEntityByEntityID as a timeline,
+followed by the final state.
+
+Input
+  - writer: Where the timeline is written.
+  - how: The decoded response.
+  - format: FormatText or FormatMarkdown.
+*/
+func RenderHow(writer io.Writer, how *szmodel.HowResponse, format Format) error {
+	var builder strings.Builder
+	steps := how.HowResults.ResolutionSteps
+	if format == FormatMarkdown {
+		builder.WriteString("## How the entity was resolved\n\n")
+	} else {
+		builder.WriteString("How the entity was resolved:\n")
+	}
+	if len(steps) == 0 {
+		builder.WriteString("No resolution steps; the entity has a single record.\n")
+	}
+	for i := range steps {
+		step := &steps[i]
+		if format == FormatMarkdown {
+			fmt.Fprintf(&builder, "%d. %s\n", step.Step, howNarrative(step, format))
+		} else {
+			fmt.Fprintf(&builder, "Step %d: %s\n", step.Step, howNarrative(step, format))
+		}
+	}
+	finalState := how.HowResults.FinalState
+	if format == FormatMarkdown {
+		builder.WriteString("\n**Final state:** ")
+	} else {
+		builder.WriteString("Final state: ")
+	}
+	parts := make([]string, 0, len(finalState.VirtualEntities))
+	for i := range finalState.VirtualEntities {
+		parts = append(parts, describeVirtualEntity(&finalState.VirtualEntities[i], format))
+	}
+	builder.WriteString(strings.Join(parts, "; "))
+	builder.WriteString(".\n")
+	if finalState.NeedReevaluation != 0 {
+		builder.WriteString("The entity needs reevaluation.\n")
+	}
+	_, err := io.WriteString(writer, builder.String())
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func capitalize(value string) string {
+	if len(value) == 0 {
+		return value
+	}
+	return strings.ToUpper(value[:1]) + value[1:]
+}
+
+func code(value string, format Format) string {
+	if format == FormatMarkdown {
+		return "`" + value + "`"
+	}
+	return value
+}
+
+func describeEntity(entityID int64, names map[int64]string) string {
+	if name := names[entityID]; len(name) > 0 {
+		return fmt.Sprintf("entity %d (%s)", entityID, name)
+	}
+	return fmt.Sprintf("entity %d", entityID)
+}
+
+func describeRecords(records []szmodel.RecordKey) string {
+	parts := make([]string, 0, min(len(records), MaxListedRecords))
+	for i, record := range records {
+		if i == MaxListedRecords {
+			parts = append(parts, fmt.Sprintf("and %d more", len(records)-MaxListedRecords))
+			break
+		}
+		parts = append(parts, record.DataSource+":"+record.RecordID)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Describe focus records as "record X" or "records X, Y".
+func describeFocusRecords(records []szmodel.RecordKey) string {
+	if len(records) == 1 {
+		return "record " + describeRecords(records)
+	}
+	return "records " + describeRecords(records)
+}
+
+func describeVirtualEntity(virtualEntity *szmodel.VirtualEntity, format Format) string {
+	virtualEntityID := virtualEntity.VirtualEntityID
+	if format == FormatMarkdown {
+		virtualEntityID = "**" + virtualEntityID + "**"
+	}
+	return fmt.Sprintf("%s (%s)", virtualEntityID, describeRecords(virtualEntity.RecordKeys()))
+}
+
+func entityNames(entities []szmodel.Entity) map[int64]string {
+	result := map[int64]string{}
+	for _, entity := range entities {
+		result[entity.ResolvedEntity.EntityID] = entity.ResolvedEntity.EntityName
+	}
+	return result
+}
+
+func escapeMarkdownCell(value string) string {
+	return strings.ReplaceAll(value, "|", `\|`)
+}
+
+func howNarrative(step *szmodel.ResolutionStep, format Format) string {
+	inbound, other := &step.VirtualEntity2, &step.VirtualEntity1
+	if step.InboundVirtualEntityID == step.VirtualEntity1.VirtualEntityID {
+		inbound, other = other, inbound
+	}
+	resultVirtualEntityID := step.ResultVirtualEntityID
+	if format == FormatMarkdown {
+		resultVirtualEntityID = "**" + resultVirtualEntityID + "**"
+	}
+	return fmt.Sprintf("%s joined %s %s (rule %s), forming %s.",
+		describeVirtualEntity(inbound, format),
+		describeVirtualEntity(other, format),
+		matchPhrase(step.MatchInfo.Key(), "on", format),
+		code(step.MatchInfo.Rule(), format),
+		resultVirtualEntityID)
+}
+
+func joinWords(words []string) string {
+	switch len(words) {
+	case 0:
+		return ""
+	case 1:
+		return words[0]
+	default:
+		return strings.Join(words[:len(words)-1], ", ") + " and " + words[len(words)-1]
+	}
+}
+
+// Describe a match key as "<preposition> NAME+DOB; SSN conflicted".
+func matchPhrase(matchKey string, preposition string, format Format) string {
+	matched, conflicted := szmodel.ParseMatchKey(matchKey)
+	var result string
+	if len(matched) == 0 {
+		result = preposition + " no common features"
+	} else {
+		result = preposition + " " + code(strings.Join(matched, "+"), format)
+	}
+	if len(conflicted) > 0 {
+		quoted := make([]string, 0, len(conflicted))
+		for _, feature := range conflicted {
+			quoted = append(quoted, code(feature, format))
+		}
+		result += "; " + joinWords(quoted) + " conflicted"
+	}
+	return result
+}
+
+func subject(whyResult *szmodel.WhyResult, names map[int64]string) string {
+	switch {
+	case whyResult.EntityID2 == 0:
+		return fmt.Sprintf("%s in %s", describeFocusRecords(whyResult.FocusRecords), describeEntity(whyResult.EntityID, names))
+	case len(whyResult.FocusRecords) > 0 && len(whyResult.FocusRecords2) > 0:
+		return fmt.Sprintf("%s in %s and %s in %s",
+			describeFocusRecords(whyResult.FocusRecords), describeEntity(whyResult.EntityID, names),
+			describeFocusRecords(whyResult.FocusRecords2), describeEntity(whyResult.EntityID2, names))
+	default:
+		return describeEntity(whyResult.EntityID, names) + " and " + describeEntity(whyResult.EntityID2, names)
+	}
+}
+
+func whyNarrative(whyResult *szmodel.WhyResult, names map[int64]string, format Format) string {
+	matchInfo := &whyResult.MatchInfo
+	var details []string
+	if len(matchInfo.MatchLevelCode) > 0 {
+		details = append(details, code(matchInfo.MatchLevelCode, format))
+	}
+	if rule := matchInfo.Rule(); len(rule) > 0 {
+		details = append(details, "rule "+code(rule, format))
+	}
+	var suffix string
+	if len(details) > 0 {
+		suffix = " (" + strings.Join(details, ", ") + ")"
+	}
+	if whyResult.EntityID2 == 0 {
+		return fmt.Sprintf("%s is in %s because it matched %s%s.",
+			capitalize(describeFocusRecords(whyResult.FocusRecords)), describeEntity(whyResult.EntityID, names),
+			matchPhrase(matchInfo.Key(), "on", format), suffix)
+	}
+	return fmt.Sprintf("%s matched %s%s.", capitalize(subject(whyResult, names)), matchPhrase(matchInfo.Key(), "on", format), suffix)
+}
+
+func writeFeatureScores(builder *strings.Builder, featureScores map[string][]szmodel.FeatureScore, format Format) {
+	if len(featureScores) == 0 {
+		return
+	}
+	featureTypes := make([]string, 0, len(featureScores))
+	for featureType := range featureScores {
+		featureTypes = append(featureTypes, featureType)
+	}
+	sort.Strings(featureTypes)
+	if format == FormatMarkdown {
+		builder.WriteString("\n| Feature | Inbound | Candidate | Score | Bucket |\n")
+		builder.WriteString("| --- | --- | --- | ---: | --- |\n")
+	}
+	for _, featureType := range featureTypes {
+		for i := range featureScores[featureType] {
+			featureScore := &featureScores[featureType][i]
+			if format == FormatMarkdown {
+				fmt.Fprintf(builder, "| %s | %s | %s | %d | %s |\n",
+					featureType, escapeMarkdownCell(featureScore.InboundFeat), escapeMarkdownCell(featureScore.CandidateFeat),
+					featureScore.Score(), featureScore.ScoreBucket)
+				continue
+			}
+			fmt.Fprintf(builder, "  %s: %s vs %s, score %d", featureType, featureScore.InboundFeat, featureScore.CandidateFeat, featureScore.Score())
+			if len(featureScore.ScoreBucket) > 0 {
+				fmt.Fprintf(builder, " (%s)", featureScore.ScoreBucket)
+			}
+			builder.WriteString("\n")
+		}
+	}
+}
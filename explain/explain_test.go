@@ -0,0 +1,155 @@
+package explain
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	printResults      = false
+	responseDirectory = "../testdata/responses"
+)
+
+// Type failingWriter fails every write.
+type failingWriter struct{}
+
+func (writer *failingWriter) Write(buffer []byte) (int, error) {
+	_ = buffer
+	return 0, errors.New("write failed")
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestExplain_RenderWhy_whyEntities(test *testing.T) {
+	why := readWhy(test, "why-entities.json")
+	var buffer bytes.Buffer
+	require.NoError(test, RenderWhy(&buffer, why, FormatText))
+	printActual(test, buffer.String())
+	expected := `Entity 1 (JOHNSON) and entity 2 (OCEANGUY) matched on PHONE+ACCT_NUM; SSN conflicted (POSSIBLY_RELATED, rule SF1).
+  ACCT_NUM: 5534202208773608 vs 5534202208773608, score 100 (SAME)
+  ADDRESS: 772 Armstrong RD Delhi LA 71232 vs 772 Armstrong RD Delhi WI 53543, score 81 (LIKELY)
+  DOB: 4/8/1985 vs 6/9/1983, score 79 (NO_CHANCE)
+  DOB: 4/8/1983 vs 6/9/1983, score 86 (PLAUSIBLE)
+  GENDER: F vs F, score 100 (SAME)
+  LOGIN_ID: flavorh vs flavorh2, score 0 (NO_CHANCE)
+  NAME: JOHNSON vs OCEANGUY, score 33 (NO_CHANCE)
+  PHONE: 225-671-0796 vs 225-671-0796, score 100 (SAME)
+  SSN: 053-39-3251 vs 153-33-5185, score 0 (NO_CHANCE)
+`
+	assert.Equal(test, expected, buffer.String())
+}
+
+func TestExplain_RenderWhy_whyRecordsMarkdown(test *testing.T) {
+	why := readWhy(test, "why-records.json")
+	var buffer bytes.Buffer
+	require.NoError(test, RenderWhy(&buffer, why, FormatMarkdown))
+	printActual(test, buffer.String())
+	actual := buffer.String()
+	assert.Contains(test, actual, "## Record TEST:111 in entity 1 (JOHNSON) and record TEST:222 in entity 2 (OCEANGUY)\n\n")
+	assert.Contains(test, actual, "matched on `PHONE+ACCT_NUM`; `DOB` and `SSN` conflicted (`POSSIBLY_RELATED`, rule `SF1`).\n")
+	assert.Contains(test, actual, "| Feature | Inbound | Candidate | Score | Bucket |\n| --- | --- | --- | ---: | --- |\n")
+	assert.Contains(test, actual, "| NAME | JOHNSON | OCEANGUY | 33 | NO_CHANCE |\n")
+}
+
+func TestExplain_RenderWhy_whyRecordInEntity(test *testing.T) {
+	why := readWhy(test, "why-record-in-entity.json")
+	var buffer bytes.Buffer
+	require.NoError(test, RenderWhy(&buffer, why, FormatText))
+	assert.Equal(test, "Record CUSTOMERS:1001 is in entity 100001 because it matched on NAME+DOB+PHONE (RESOLVED, rule CNAME_CFF_CEXCL).\n", buffer.String())
+}
+
+func TestExplain_RenderWhy_multipleResults(test *testing.T) {
+	why := &szmodel.WhyResponse{
+		WhyResults: []szmodel.WhyResult{
+			{
+				EntityID:     7,
+				FocusRecords: []szmodel.RecordKey{{DataSource: "A", RecordID: "1"}, {DataSource: "A", RecordID: "2"}, {DataSource: "B", RecordID: "3"}, {DataSource: "B", RecordID: "4"}},
+				MatchInfo:    szmodel.MatchInfo{WhyKey: "-SSN"},
+			},
+			{
+				EntityID:  7,
+				EntityID2: 8,
+				MatchInfo: szmodel.MatchInfo{WhyKey: "+NAME+ADDRESS(PARENT:CHILD)-DOB-SSN"},
+			},
+		},
+	}
+	var buffer bytes.Buffer
+	require.NoError(test, RenderWhy(&buffer, why, FormatText))
+	assert.Equal(test, "Records A:1, A:2, B:3, and 1 more is in entity 7 because it matched on no common features; SSN conflicted.\n\n"+
+		"Entity 7 and entity 8 matched on NAME+ADDRESS(PARENT:CHILD); DOB and SSN conflicted.\n", buffer.String())
+}
+
+func TestExplain_RenderWhy_writeError(test *testing.T) {
+	why := readWhy(test, "why-entities.json")
+	require.Error(test, RenderWhy(&failingWriter{}, why, FormatText))
+}
+
+func TestExplain_RenderHow(test *testing.T) {
+	how := readHow(test)
+	var buffer bytes.Buffer
+	require.NoError(test, RenderHow(&buffer, how, FormatText))
+	printActual(test, buffer.String())
+	expected := `How the entity was resolved:
+Step 1: V100002 (CUSTOMERS:1002) joined V100001 (CUSTOMERS:1001) on NAME+DOB+PHONE (rule CNAME_CFF_CEXCL), forming V100001-S1.
+Step 2: V100003 (CUSTOMERS:1003) joined V100001-S1 (CUSTOMERS:1001, CUSTOMERS:1002) on NAME+DOB+EMAIL (rule SF1_PNAME_CSTAB), forming V100001-S2.
+Final state: V100001-S2 (CUSTOMERS:1001, CUSTOMERS:1002, CUSTOMERS:1003).
+`
+	assert.Equal(test, expected, buffer.String())
+}
+
+func TestExplain_RenderHow_markdown(test *testing.T) {
+	how := readHow(test)
+	how.HowResults.FinalState.NeedReevaluation = 1
+	var buffer bytes.Buffer
+	require.NoError(test, RenderHow(&buffer, how, FormatMarkdown))
+	printActual(test, buffer.String())
+	expected := "## How the entity was resolved\n\n" +
+		"1. **V100002** (CUSTOMERS:1002) joined **V100001** (CUSTOMERS:1001) on `NAME+DOB+PHONE` (rule `CNAME_CFF_CEXCL`), forming **V100001-S1**.\n" +
+		"2. **V100003** (CUSTOMERS:1003) joined **V100001-S1** (CUSTOMERS:1001, CUSTOMERS:1002) on `NAME+DOB+EMAIL` (rule `SF1_PNAME_CSTAB`), forming **V100001-S2**.\n" +
+		"\n**Final state:** **V100001-S2** (CUSTOMERS:1001, CUSTOMERS:1002, CUSTOMERS:1003).\n" +
+		"The entity needs reevaluation.\n"
+	assert.Equal(test, expected, buffer.String())
+}
+
+func TestExplain_RenderHow_singleRecord(test *testing.T) {
+	how, err := szmodel.DecodeHow(`{"HOW_RESULTS":{"RESOLUTION_STEPS":[],"FINAL_STATE":{"NEED_REEVALUATION":0,"VIRTUAL_ENTITIES":[{"VIRTUAL_ENTITY_ID":"V1","MEMBER_RECORDS":[{"INTERNAL_ID":1,"RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}]}]}]}}}`)
+	require.NoError(test, err)
+	var buffer bytes.Buffer
+	require.NoError(test, RenderHow(&buffer, how, FormatText))
+	assert.Equal(test, "How the entity was resolved:\nNo resolution steps; the entity has a single record.\nFinal state: V1 (CUSTOMERS:1001).\n", buffer.String())
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %v", actual)
+	}
+}
+
+func readHow(test *testing.T) *szmodel.HowResponse {
+	response, err := os.ReadFile(filepath.Join(responseDirectory, "how-entity-by-entity-id.json"))
+	require.NoError(test, err)
+	result, err := szmodel.DecodeHow(string(response))
+	require.NoError(test, err)
+	return result
+}
+
+func readWhy(test *testing.T, filename string) *szmodel.WhyResponse {
+	response, err := os.ReadFile(filepath.Join(responseDirectory, filename))
+	require.NoError(test, err)
+	result, err := szmodel.DecodeWhy(string(response))
+	require.NoError(test, err)
+	return result
+}
@@ -0,0 +1,28 @@
+package explain
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Type Format selects the output of a renderer.
+type Format int
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+Formats.
+  - FormatText: Plain text with indented feature scores.
+  - FormatMarkdown: Markdown with headings, lists and feature score tables.
+*/
+const (
+	FormatText Format = iota
+	FormatMarkdown
+)
+
+/*
+MaxListedRecords is the number of records listed for a virtual entity
+before the rest are summarized as "and n more".
+*/
+const MaxListedRecords = 3
@@ -0,0 +1,52 @@
+package helper
+
+import "sync"
+
+// The active configuration ID is a state of the Senzing C library, shared by every Sz object of the process.
+var activeConfigID struct {
+	configID   int64
+	generation uint64
+	lock       sync.Mutex
+}
+
+/*
+The GetActiveConfigID function returns the active configuration ID of the Senzing C library,
+calling resolve on first use and caching its result until ResetActiveConfigID.
+
+Input
+  - resolve: Asks the Senzing C library for the active configuration ID.
+
+Output
+  - The active configuration ID. 0 if resolve fails, in which case nothing is cached.
+*/
+func GetActiveConfigID(resolve func() (int64, error)) int64 {
+	activeConfigID.lock.Lock()
+	configID := activeConfigID.configID
+	generation := activeConfigID.generation
+	activeConfigID.lock.Unlock()
+	if configID > 0 {
+		return configID
+	}
+	configID, err := resolve()
+	if err != nil || configID <= 0 {
+		return 0
+	}
+	activeConfigID.lock.Lock()
+	defer activeConfigID.lock.Unlock()
+	if activeConfigID.generation == generation {
+		activeConfigID.configID = configID
+	}
+	return configID
+}
+
+/*
+The ResetActiveConfigID function forgets the cached active configuration ID,
+such as after the Senzing C library is initialized, reinitialized or destroyed.
+An ID being resolved while ResetActiveConfigID runs is not cached.
+*/
+func ResetActiveConfigID() {
+	activeConfigID.lock.Lock()
+	defer activeConfigID.lock.Unlock()
+	activeConfigID.configID = 0
+	activeConfigID.generation++
+}
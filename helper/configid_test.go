@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Interface methods - test
+// ----------------------------------------------------------------------------
+
+func TestHelpers_GetActiveConfigID(test *testing.T) {
+	ResetActiveConfigID()
+	defer ResetActiveConfigID()
+	calls := 0
+	resolve := func() (int64, error) {
+		calls++
+		return int64(1000 + calls), nil
+	}
+	assert.Equal(test, int64(1001), GetActiveConfigID(resolve))
+	assert.Equal(test, int64(1001), GetActiveConfigID(resolve))
+	assert.Equal(test, 1, calls)
+	ResetActiveConfigID()
+	assert.Equal(test, int64(1002), GetActiveConfigID(resolve))
+}
+
+func TestHelpers_GetActiveConfigID_error(test *testing.T) {
+	ResetActiveConfigID()
+	defer ResetActiveConfigID()
+	assert.Equal(test, int64(0), GetActiveConfigID(func() (int64, error) { return 1001, errors.New("not initialized") }))
+	assert.Equal(test, int64(1002), GetActiveConfigID(func() (int64, error) { return 1002, nil }))
+}
+
+func TestHelpers_ResetActiveConfigID_whileResolving(test *testing.T) {
+	ResetActiveConfigID()
+	defer ResetActiveConfigID()
+	resolve := func() (int64, error) {
+		ResetActiveConfigID()
+		return 1001, nil
+	}
+	assert.Equal(test, int64(1001), GetActiveConfigID(resolve))
+	assert.Equal(test, int64(1002), GetActiveConfigID(func() (int64, error) { return 1002, nil }))
+}
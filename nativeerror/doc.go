@@ -0,0 +1,24 @@
+/*
+Package nativeerror describes failures of the Senzing C library as inspectable Go errors.
+
+When a call into the Senzing C library fails, the [szconfig], [szconfigmanager], [szdiagnostic],
+[szengine] and [szproduct] packages return an [*Error].
+Its Error text is the same JSON message as before, but its fields can be read with errors.As
+instead of parsing the message:
+
+	var nativeError *nativeerror.Error
+	if errors.As(err, &nativeError) {
+		alert(nativeError.Method, nativeError.Code(), nativeError.Reason)
+	}
+
+An Error still matches the [szerror] classifications, such as szerror.ErrSzNotFound, with errors.Is.
+Its Parameters may contain record data; use [Error.Redacted] before sending an error outside the process.
+
+[szconfig]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfig
+[szconfigmanager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfigmanager
+[szdiagnostic]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szdiagnostic
+[szengine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine
+[szproduct]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szproduct
+[szerror]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+package nativeerror
@@ -0,0 +1,29 @@
+package nativeerror
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Error is a failure of a call into the Senzing C library.
+*/
+type Error struct {
+	ComponentID   int      // Component of the SDK, such as 6004 for szengine.
+	MessageNumber int      // Number of the failure message of the component, such as 4013.
+	Method        string   // The failing C function, such as "szengine.Sz_findNetworkByEntityID_V2".
+	ExceptionCode int      // The Senzing exception code, such as 33 for SENZ0033.
+	Reason        string   // The text of the Senzing exception, without its code.
+	Parameters    []string // The arguments of the failing call, formatted with %v.
+	message       string
+	wrapped       error
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// ExceptionCodeTemplate formats a Senzing exception code, as in SENZ0033.
+const ExceptionCodeTemplate = "SENZ%04d"
+
+// RedactedParameter replaces each parameter of a redacted Error.
+const RedactedParameter = "<redacted>"
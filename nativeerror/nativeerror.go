@@ -0,0 +1,123 @@
+package nativeerror
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Constructor
+// ----------------------------------------------------------------------------
+
+/*
+The New function creates an Error for a failed call into the Senzing C library.
+
+Input
+  - componentID: The 4-digit identifier of the component.
+  - messageNumber: The number of the failure message in idMessages.
+  - idMessages: The messages of the component, used to name the failing C function.
+  - exceptionCode: The Senzing exception code.
+  - reason: The text of the Senzing exception, such as "0033E|Unknown record: dsrc[CUSTOMERS], record[1001]".
+  - message: The text returned by Error, usually the JSON message of the component's messenger.
+  - parameters: The arguments of the failing call.
+
+Output
+  - An *Error matching the szerror classifications of exceptionCode.
+    Its Reason is the text after the "|" of reason.
+*/
+func New(componentID int, messageNumber int, idMessages map[int]string, exceptionCode int, reason string, message string, parameters ...interface{}) error {
+	result := &Error{
+		ComponentID:   componentID,
+		MessageNumber: messageNumber,
+		Method:        methodName(idMessages[messageNumber]),
+		ExceptionCode: exceptionCode,
+		Reason:        reason,
+		Parameters:    make([]string, len(parameters)),
+		message:       message,
+		wrapped:       szerror.New(exceptionCode, message),
+	}
+	if text := szerror.Message(reason); len(text) > 0 {
+		result.Reason = text
+	}
+	for index, parameter := range parameters {
+		result.Parameters[index] = fmt.Sprintf("%v", parameter)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Error methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Code returns the Senzing exception code in its usual form.
+
+Output
+  - The code, such as "SENZ0033".
+*/
+func (nativeError *Error) Code() string {
+	return fmt.Sprintf(ExceptionCodeTemplate, nativeError.ExceptionCode)
+}
+
+/*
+Method Error returns the message of the error.
+
+Output
+  - The JSON message created by the component, or for a redacted error a summary without parameters.
+*/
+func (nativeError *Error) Error() string {
+	return nativeError.message
+}
+
+/*
+Method MessageID returns the identifier of the failure message.
+
+Output
+  - The identifier, such as "SZSDK60044013".
+*/
+func (nativeError *Error) MessageID() string {
+	return fmt.Sprintf("%s%04d%04d", helper.MessageIDPrefix, nativeError.ComponentID, nativeError.MessageNumber)
+}
+
+/*
+Method Redacted returns a copy of the error without the arguments of the failing call.
+The copy matches the same szerror classifications.
+
+Output
+  - The copy, whose Parameters are RedactedParameter and whose Error text omits the parameters.
+*/
+func (nativeError *Error) Redacted() *Error {
+	result := *nativeError
+	result.Parameters = make([]string, len(nativeError.Parameters))
+	for index := range result.Parameters {
+		result.Parameters[index] = RedactedParameter
+	}
+	result.message = fmt.Sprintf("%s: %s failed: %s|%s", nativeError.MessageID(), nativeError.Method, nativeError.Code(), nativeError.Reason)
+	result.wrapped = szerror.New(nativeError.ExceptionCode, result.message)
+	return &result
+}
+
+/*
+Method Unwrap returns the szerror classification of the error.
+
+Output
+  - The error created by szerror.New, so that errors.Is matches errors such as szerror.ErrSzNotFound.
+*/
+func (nativeError *Error) Unwrap() error {
+	return nativeError.wrapped
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Name the C function of a message template such as "szengine.Sz_addRecord(%s, %s, %s) failed. Return code: %d".
+func methodName(template string) string {
+	if index := strings.Index(template, "("); index >= 0 {
+		return template[:index]
+	}
+	return strings.TrimSpace(template)
+}
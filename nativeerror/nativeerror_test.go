@@ -0,0 +1,86 @@
+package nativeerror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/szengine"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	componentID   = 6004
+	message       = `{"id":"SZSDK60044035","reason":"0033E|Unknown record: dsrc[CUSTOMERS], record[1001]"}`
+	printResults  = false
+	reason        = "0033E|Unknown record: dsrc[CUSTOMERS], record[1001]"
+	exceptionCode = 33
+	messageNumber = 4035
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNativeerror_New(test *testing.T) {
+	err := New(componentID, messageNumber, szengine.IDMessages, exceptionCode, reason, message, "CUSTOMERS", "1001", int64(0))
+	printActual(test, err)
+	var nativeError *Error
+	require.ErrorAs(test, err, &nativeError)
+	assert.Equal(test, componentID, nativeError.ComponentID)
+	assert.Equal(test, messageNumber, nativeError.MessageNumber)
+	assert.Equal(test, "szengine.Sz_getRecord_V2", nativeError.Method)
+	assert.Equal(test, exceptionCode, nativeError.ExceptionCode)
+	assert.Equal(test, "SENZ0033", nativeError.Code())
+	assert.Equal(test, "Unknown record: dsrc[CUSTOMERS], record[1001]", nativeError.Reason)
+	assert.Equal(test, []string{"CUSTOMERS", "1001", "0"}, nativeError.Parameters)
+	assert.Equal(test, "SZSDK60044035", nativeError.MessageID())
+	assert.Equal(test, message, err.Error())
+}
+
+func TestNativeerror_New_classification(test *testing.T) {
+	err := New(componentID, messageNumber, szengine.IDMessages, exceptionCode, reason, message)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.NotErrorIs(test, err, szerror.ErrSzRetryable)
+	wrapped := fmt.Errorf("lookup failed: %w", err)
+	require.ErrorIs(test, wrapped, szerror.ErrSzNotFound)
+	var nativeError *Error
+	require.ErrorAs(test, wrapped, &nativeError)
+	assert.Equal(test, "SENZ0033", nativeError.Code())
+}
+
+func TestNativeerror_New_unknownMessage(test *testing.T) {
+	err := New(componentID, 9999, szengine.IDMessages, 0, "no pipe", message)
+	var nativeError *Error
+	require.ErrorAs(test, err, &nativeError)
+	assert.Empty(test, nativeError.Method)
+	assert.Equal(test, "no pipe", nativeError.Reason)
+	assert.Empty(test, nativeError.Parameters)
+}
+
+func TestNativeerror_Redacted(test *testing.T) {
+	err := New(componentID, messageNumber, szengine.IDMessages, exceptionCode, reason, message, "CUSTOMERS", "1001", int64(0))
+	var nativeError *Error
+	require.ErrorAs(test, err, &nativeError)
+	actual := nativeError.Redacted()
+	printActual(test, actual)
+	assert.Equal(test, []string{RedactedParameter, RedactedParameter, RedactedParameter}, actual.Parameters)
+	assert.Equal(test, "SZSDK60044035: szengine.Sz_getRecord_V2 failed: SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]", actual.Error())
+	assert.NotContains(test, actual.Error(), `"1001"`)
+	require.ErrorIs(test, actual, szerror.ErrSzNotFound)
+	assert.Equal(test, []string{"CUSTOMERS", "1001", "0"}, nativeError.Parameters, "original is unchanged")
+	assert.False(test, errors.Is(actual, nativeError.Unwrap()))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %+v", actual)
+	}
+}
@@ -0,0 +1,25 @@
+/*
+Package nativelog captures the output of the Senzing C library into Go logs.
+
+With verboseLogging enabled, libSz writes to the standard output of the process.
+Start redirects the standard output, standard error, or both, to pipes,
+parses each line into a [Line] with a level and timestamp, and passes it to a [Handler],
+such as one made by LoggerHandler:
+
+	capture, err := nativelog.Start(ctx, nativelog.Stdout, nativelog.LoggerHandler(logger))
+	...
+	defer capture.Stop()
+
+While capturing, os.Stdout and os.Stderr, and the output of the log package if it was os.Stderr,
+write to the original destinations, so output of Go code is not captured.
+Files opened on the standard streams before Start, such as an os.Stdout held by an slog handler created earlier,
+still write to the pipes; a handler must not write through them, or it would read its own output.
+
+Only one capture may be active in a process. Capturing is not supported on Windows.
+The Sz objects created by an [szabstractfactory.Szabstractfactory] with a NativeOutput handler are captured
+from their creation until Destroy. The factory captures only the standard output unless its NativeStreams says otherwise,
+as capturing the standard error also captures that of the rest of the process.
+
+[szabstractfactory.Szabstractfactory]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szabstractfactory#Szabstractfactory
+*/
+package nativelog
@@ -0,0 +1,109 @@
+package nativelog
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szslog"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Capture struct is an active capture of native output, ended by Stop.
+*/
+type Capture struct {
+	readers   sync.WaitGroup
+	redirects []*redirect
+}
+
+// Type Handler receives each line of captured output, in order per stream.
+type Handler func(ctx context.Context, line Line)
+
+/*
+Type Line struct is a line of captured output.
+*/
+type Line struct {
+	Level   slog.Level // The level in the line, or the default level of the stream.
+	Message string     // The line without its timestamp and level.
+	Stream  Stream     // The stream the line was written to.
+	Time    time.Time  // The timestamp in the line, or when the line was read.
+}
+
+// Type Stream is a set of standard streams.
+type Stream int
+
+// A standard stream redirected to a pipe.
+type redirect struct {
+	fd          int       // The redirected file descriptor, 1 or 2.
+	goFile      **os.File // os.Stdout or os.Stderr.
+	isLogOutput bool      // True if the output of the log package was changed.
+	original    *os.File  // The value of goFile before Start.
+	reader      *os.File  // The end of the pipe read by the capture.
+	saved       *os.File  // A duplicate of the original file descriptor, used by Go code while capturing.
+	stream      Stream
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Standard streams.
+const (
+	Stdout Stream = 1 << iota // The standard output.
+	Stderr                    // The standard error.
+)
+
+// StreamKey is the attribute of the stream in records logged by LoggerHandler.
+const StreamKey = "stream"
+
+// Length of the longest line passed to a handler. Longer lines are passed in pieces of this length.
+const maxLineLength = 1024 * 1024
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Errors returned by Start.
+var (
+	ErrCapturing   = errors.New("native output is already captured")
+	ErrUnsupported = errors.New("capturing native output is not supported on this platform")
+)
+
+// Guards active.
+var activeLock sync.Mutex
+
+// The active capture, if any.
+var active *Capture
+
+// A line: an optional timestamp, an optional level, and the message.
+var linePattern = regexp.MustCompile(`^(?:(\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?(?:Z|[+-]\d{2}:?\d{2})?)[\s,|]+)?(?:\[?(TRACE|DEBUG|INFO|WARN|WARNING|ERROR|FATAL|PANIC)\]?(?:[\s,|:-]+|$))?(.*)$`)
+
+// Levels of the level names in lines.
+var lineLevels = map[string]slog.Level{
+	"TRACE":   szslog.LevelTrace,
+	"DEBUG":   slog.LevelDebug,
+	"INFO":    slog.LevelInfo,
+	"WARN":    slog.LevelWarn,
+	"WARNING": slog.LevelWarn,
+	"ERROR":   slog.LevelError,
+	"FATAL":   szslog.LevelFatal,
+	"PANIC":   szslog.LevelPanic,
+}
+
+// Layouts of the timestamps in lines.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999",
+}
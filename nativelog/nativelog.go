@@ -0,0 +1,220 @@
+package nativelog
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Interface functions
+// ----------------------------------------------------------------------------
+
+/*
+The LoggerHandler function returns a handler logging each line to a logger,
+with the level and time of the line and a "stream" attribute.
+
+Input
+  - logger: The logger. It must not write to a captured stream through a file opened before Start.
+
+Output
+  - The handler.
+*/
+func LoggerHandler(logger *slog.Logger) Handler {
+	return func(ctx context.Context, line Line) {
+		handler := logger.Handler()
+		if !handler.Enabled(ctx, line.Level) {
+			return
+		}
+		record := slog.NewRecord(line.Time, line.Level, line.Message, 0)
+		record.AddAttrs(slog.String(StreamKey, line.Stream.String()))
+		_ = handler.Handle(ctx, record)
+	}
+}
+
+/*
+The Parse function parses a line of native output.
+A leading timestamp, such as "2025-02-03 14:05:06.789", and a level, such as "INFO" or "[ERROR]:",
+are removed from the message.
+
+Input
+  - stream: The stream the line was written to.
+  - text: The line, without its newline.
+  - received: When the line was read. Used if the line has no timestamp.
+
+Output
+  - The parsed line. Its level is INFO for the standard output and WARN for the standard error
+    if the line has none.
+*/
+func Parse(stream Stream, text string, received time.Time) Line {
+	result := Line{
+		Level:   slog.LevelInfo,
+		Message: text,
+		Stream:  stream,
+		Time:    received,
+	}
+	if stream == Stderr {
+		result.Level = slog.LevelWarn
+	}
+	match := linePattern.FindStringSubmatch(text)
+	if match == nil {
+		return result
+	}
+	timestamp, levelName, message := match[1], match[2], match[3]
+	if len(timestamp) > 0 {
+		parsed, isParsed := parseTime(timestamp)
+		if !isParsed {
+			return result
+		}
+		result.Time = parsed
+	}
+	if len(levelName) > 0 {
+		result.Level = lineLevels[levelName]
+	}
+	result.Message = message
+	return result
+}
+
+/*
+The Start function redirects standard streams to pipes and passes each line written to them to a handler.
+
+Input
+  - ctx: A context passed to the handler.
+  - streams: The streams captured, such as Stdout|Stderr.
+  - handler: Receives the lines. It is called from one goroutine per stream.
+
+Output
+  - The capture, to be ended by Stop.
+*/
+func Start(ctx context.Context, streams Stream, handler Handler) (*Capture, error) {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+	if active != nil {
+		return nil, ErrCapturing
+	}
+	result := &Capture{}
+	flushNative()
+	for _, stream := range []Stream{Stdout, Stderr} {
+		if streams&stream == 0 {
+			continue
+		}
+		redirected, err := redirectStream(stream)
+		if err != nil {
+			return nil, errors.Join(err, result.restore(), result.close())
+		}
+		result.redirects = append(result.redirects, redirected)
+	}
+	for _, redirected := range result.redirects {
+		result.readers.Add(1)
+		go func() {
+			defer result.readers.Done()
+			read(ctx, redirected, handler)
+		}()
+	}
+	active = result
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Capture methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Stop restores the standard streams, then waits until every line captured has been passed to the handler.
+Output buffered by the C library is captured first. Stop may be called more than once.
+
+Output
+  - An error if a stream could not be restored.
+*/
+func (capture *Capture) Stop() error {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+	if active != capture {
+		return nil
+	}
+	active = nil
+	flushNative()
+	err := capture.restore()
+	capture.readers.Wait()
+	return errors.Join(err, capture.close())
+}
+
+// ----------------------------------------------------------------------------
+// Stream methods
+// ----------------------------------------------------------------------------
+
+/*
+Method String returns the name of a stream, "stdout" or "stderr".
+
+Output
+  - The name. Empty for a set of streams.
+*/
+func (stream Stream) String() string {
+	switch stream {
+	case Stdout:
+		return "stdout"
+	case Stderr:
+		return "stderr"
+	default:
+		return ""
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Close the pipes and the duplicates of the original streams.
+func (capture *Capture) close() error {
+	var err error
+	for _, redirected := range capture.redirects {
+		err = errors.Join(err, redirected.reader.Close(), redirected.saved.Close())
+	}
+	return err
+}
+
+// Restore the redirected streams, so the readers reach the end of the pipes.
+func (capture *Capture) restore() error {
+	var err error
+	for _, redirected := range capture.redirects {
+		err = errors.Join(err, redirected.restore())
+	}
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Pass each line read from a redirected stream to the handler, until the pipe is closed.
+// Lines longer than maxLineLength are passed in pieces. The pipe is drained after any other error,
+// so that native writes never block on a full pipe.
+func read(ctx context.Context, redirected *redirect, handler Handler) {
+	reader := bufio.NewReaderSize(redirected.reader, maxLineLength)
+	for {
+		line, err := reader.ReadSlice('\n')
+		text := strings.TrimRight(string(line), "\r\n")
+		if len(text) > 0 {
+			handler(ctx, Parse(redirected.stream, text, time.Now()))
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, os.ErrClosed) {
+			return
+		}
+	}
+}
+
+func parseTime(timestamp string) (time.Time, bool) {
+	timestamp = strings.Replace(timestamp, ",", ".", 1)
+	for _, layout := range timeLayouts {
+		parsed, err := time.ParseInLocation(layout, timestamp, time.Local)
+		if err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
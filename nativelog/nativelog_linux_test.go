@@ -0,0 +1,102 @@
+//go:build linux
+
+package nativelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNativelog_Start(test *testing.T) {
+	ctx := context.TODO()
+	originalStdout := os.Stdout
+	recorder := &lineRecorder{}
+	capture, err := Start(ctx, Stdout|Stderr, recorder.handle)
+	require.NoError(test, err)
+	assert.NotSame(test, originalStdout, os.Stdout)
+	writeNative(test, unix.Stdout, "INFO first\n[ERROR] second\n")
+	writeNative(test, unix.Stderr, "third")
+	fmt.Fprintln(os.Stdout, "Go output is not captured")
+	log.Print("Go log output is not captured")
+	require.NoError(test, capture.Stop())
+	require.NoError(test, capture.Stop())
+	assert.Same(test, originalStdout, os.Stdout)
+	lines := recorder.received()
+	printActual(test, lines)
+	require.Len(test, lines, 3)
+	assert.Equal(test, "first", lines[0].Message)
+	assert.Equal(test, "second", lines[1].Message)
+	assert.Equal(test, Stdout, lines[1].Stream)
+	assert.Equal(test, "third", lines[2].Message)
+	assert.Equal(test, Stderr, lines[2].Stream)
+}
+
+func TestNativelog_Start_longLine(test *testing.T) {
+	ctx := context.TODO()
+	recorder := &lineRecorder{}
+	capture, err := Start(ctx, Stdout, recorder.handle)
+	require.NoError(test, err)
+	long := strings.Repeat("x", maxLineLength+100)
+	writeNative(test, unix.Stdout, long+"\nafter\n")
+	require.NoError(test, capture.Stop())
+	lines := recorder.received()
+	require.Len(test, lines, 3)
+	assert.Equal(test, long, lines[0].Message+lines[1].Message)
+	assert.Len(test, lines[0].Message, maxLineLength)
+	assert.Equal(test, "after", lines[2].Message)
+}
+
+func TestNativelog_Start_capturing(test *testing.T) {
+	ctx := context.TODO()
+	capture, err := Start(ctx, Stdout, (&lineRecorder{}).handle)
+	require.NoError(test, err)
+	defer func() { require.NoError(test, capture.Stop()) }()
+	_, err = Start(ctx, Stderr, (&lineRecorder{}).handle)
+	require.ErrorIs(test, err, ErrCapturing)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// A handler recording lines, sorted by stream.
+
+type lineRecorder struct {
+	lock  sync.Mutex
+	lines map[Stream][]Line
+}
+
+func (recorder *lineRecorder) handle(ctx context.Context, line Line) {
+	_ = ctx
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.lines == nil {
+		recorder.lines = map[Stream][]Line{}
+	}
+	recorder.lines[line.Stream] = append(recorder.lines[line.Stream], line)
+}
+
+func (recorder *lineRecorder) received() []Line {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	return append(append([]Line{}, recorder.lines[Stdout]...), recorder.lines[Stderr]...)
+}
+
+// Write to a file descriptor as the Senzing C library does, bypassing os.Stdout and os.Stderr.
+func writeNative(test *testing.T, fd int, text string) {
+	_, err := unix.Write(fd, []byte(text))
+	require.NoError(test, err)
+}
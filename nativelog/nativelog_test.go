@@ -0,0 +1,88 @@
+package nativelog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szslog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	printResults = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNativelog_Parse(test *testing.T) {
+	received := time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		stream   Stream
+		text     string
+		level    slog.Level
+		message  string
+		expected time.Time
+	}{
+		{name: "plain", stream: Stdout, text: "Senzing engine initialized", level: slog.LevelInfo, message: "Senzing engine initialized", expected: received},
+		{name: "plain stderr", stream: Stderr, text: "out of memory", level: slog.LevelWarn, message: "out of memory", expected: received},
+		{name: "level", stream: Stdout, text: "ERROR: cannot open database", level: slog.LevelError, message: "cannot open database", expected: received},
+		{name: "bracketed level", stream: Stdout, text: "[DEBUG] loaded 3 data sources", level: slog.LevelDebug, message: "loaded 3 data sources", expected: received},
+		{name: "level only", stream: Stdout, text: "FATAL", level: szslog.LevelFatal, message: "", expected: received},
+		{name: "not a level", stream: Stdout, text: "INFORMATION follows", level: slog.LevelInfo, message: "INFORMATION follows", expected: received},
+		{name: "lower case", stream: Stdout, text: "Error rate is low", level: slog.LevelInfo, message: "Error rate is low", expected: received},
+		{name: "timestamp", stream: Stdout, text: "2025-02-03T14:05:06.789Z WARNING slow query", level: slog.LevelWarn, message: "slow query", expected: time.Date(2025, 2, 3, 14, 5, 6, 789000000, time.UTC)},
+		{name: "local timestamp", stream: Stdout, text: "2025-02-03 14:05:06,250 | INFO | loading", level: slog.LevelInfo, message: "loading", expected: time.Date(2025, 2, 3, 14, 5, 6, 250000000, time.Local)},
+		{name: "bad timestamp", stream: Stdout, text: "2025-13-45 99:05:06 INFO x", level: slog.LevelInfo, message: "2025-13-45 99:05:06 INFO x", expected: received},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			actual := Parse(testCase.stream, testCase.text, received)
+			printActual(test, actual)
+			assert.Equal(test, testCase.level, actual.Level)
+			assert.Equal(test, testCase.message, actual.Message)
+			assert.Equal(test, testCase.stream, actual.Stream)
+			assert.True(test, testCase.expected.Equal(actual.Time), actual.Time)
+		})
+	}
+}
+
+func TestNativelog_LoggerHandler(test *testing.T) {
+	ctx := context.TODO()
+	var buffer bytes.Buffer
+	handler := LoggerHandler(slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelInfo})))
+	timestamp := time.Date(2025, 2, 3, 14, 5, 6, 0, time.UTC)
+	handler(ctx, Line{Level: slog.LevelDebug, Message: "hidden", Stream: Stdout, Time: timestamp})
+	assert.Empty(test, buffer.String())
+	handler(ctx, Line{Level: slog.LevelError, Message: "cannot open database", Stream: Stderr, Time: timestamp})
+	record := map[string]interface{}{}
+	require.NoError(test, json.Unmarshal(buffer.Bytes(), &record))
+	printActual(test, record)
+	assert.Equal(test, "ERROR", record["level"])
+	assert.Equal(test, "cannot open database", record["msg"])
+	assert.Equal(test, "stderr", record[StreamKey])
+	assert.Equal(test, "2025-02-03T14:05:06Z", record["time"])
+}
+
+func TestNativelog_Stream_String(test *testing.T) {
+	assert.Equal(test, "stdout", Stdout.String())
+	assert.Equal(test, "stderr", Stderr.String())
+	assert.Empty(test, (Stdout | Stderr).String())
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %v", actual)
+	}
+}
@@ -0,0 +1,74 @@
+//go:build linux || darwin
+
+package nativelog
+
+/*
+#include <stdio.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"log"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Point the standard stream back to its original destination, and Go code back to the standard stream.
+func (redirected *redirect) restore() error {
+	err := unix.Dup2(int(redirected.saved.Fd()), redirected.fd)
+	*redirected.goFile = redirected.original
+	if redirected.isLogOutput {
+		log.SetOutput(redirected.original)
+	}
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Write the output buffered by the C library.
+func flushNative() {
+	C.fflush(nil)
+}
+
+// Point a standard stream to a new pipe, and Go code to the original destination of the stream.
+func redirectStream(stream Stream) (*redirect, error) {
+	result := &redirect{
+		fd:     unix.Stdout,
+		goFile: &os.Stdout,
+		stream: stream,
+	}
+	if stream == Stderr {
+		result.fd = unix.Stderr
+		result.goFile = &os.Stderr
+	}
+	result.original = *result.goFile
+	savedFd, err := unix.FcntlInt(uintptr(result.fd), unix.F_DUPFD_CLOEXEC, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.saved = os.NewFile(uintptr(savedFd), result.original.Name())
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return nil, errors.Join(err, result.saved.Close())
+	}
+	result.reader = reader
+	err = unix.Dup2(int(writer.Fd()), result.fd)
+	err = errors.Join(err, writer.Close())
+	if err != nil {
+		return nil, errors.Join(err, reader.Close(), result.saved.Close())
+	}
+	*result.goFile = result.saved
+	if log.Writer() == result.original {
+		log.SetOutput(result.saved)
+		result.isLogOutput = true
+	}
+	return result, nil
+}
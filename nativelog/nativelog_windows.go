@@ -0,0 +1,24 @@
+//go:build windows
+
+package nativelog
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (redirected *redirect) restore() error {
+	_ = redirected
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func flushNative() {}
+
+// Standard streams are not redirected on Windows.
+func redirectStream(stream Stream) (*redirect, error) {
+	_ = stream
+	return nil, ErrUnsupported
+}
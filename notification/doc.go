@@ -0,0 +1,45 @@
+/*
+Package notification adds optional details to the notifications sent to observers.
+
+By default, a notification carries the details listed for its message number in [Schemas],
+in addition to the keys every notification has: "subjectId", "messageId", "messageTime",
+"origin" (if set) and "error" (if the call failed).
+Observers written against those details keep working when more are enabled,
+because optional details are only ever added as new keys.
+
+Optional details are chosen per component with the SetNotificationDetails method of
+[szconfig.Szconfig], [szconfigmanager.Szconfigmanager], [szdiagnostic.Szdiagnostic],
+[szengine.Szengine] or [szproduct.Szproduct]:
+
+	szEngine.SetNotificationDetails(ctx, notification.Duration|notification.RequestID)
+	ctx = notification.WithRequestID(ctx, "request-42")
+	szEngine.AddRecord(ctx, "CUSTOMERS", "1001", record, senzing.SzNoFlags)
+
+The optional details are:
+
+	Key                  Option         Value
+	durationNanoseconds  Duration       Time spent in the call, in nanoseconds.
+	resultSize           ResultSize     Length in bytes of the string result. Omitted for other results.
+	exceptionCode        ExceptionCode  The Senzing exception code, such as "SENZ0033". Omitted unless a Senzing call failed.
+	activeConfigID       ConfigID       The active configuration ID. Omitted when not known, as by szconfig.
+	requestID            RequestID      The request ID set by WithRequestID. Omitted if not set.
+	traceID              RequestID      The OpenTelemetry trace ID of the context. Omitted if there is none.
+
+An observer registered with RegisterObserverWithFilter receives only the notifications its [Filter] accepts.
+Filters see the component, message number and error of a call before any details are gathered.
+They are built with MessageIDs, Components and Errors, combined with All and Any,
+or written as any function of an [Event]:
+
+	szConfigManager.RegisterObserverWithFilter(ctx, observer, notification.Any(notification.MessageIDs(8007, 8008), notification.Errors))
+
+The Subscriptions of an [szabstractfactory.Szabstractfactory] register observers, with their filters,
+on every Sz object it creates.
+
+[szabstractfactory.Szabstractfactory]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szabstractfactory#Szabstractfactory
+[szconfig.Szconfig]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfig#Szconfig
+[szconfigmanager.Szconfigmanager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfigmanager#Szconfigmanager
+[szdiagnostic.Szdiagnostic]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szdiagnostic#Szdiagnostic
+[szengine.Szengine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine#Szengine
+[szproduct.Szproduct]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szproduct#Szproduct
+*/
+package notification
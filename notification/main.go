@@ -0,0 +1,182 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/senzing-garage/go-observing/observer"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Type Details is a set of optional details added to notifications.
+type Details uint
+
+/*
+Type Call struct describes a completed call of an Sz method, from which Add takes the optional details.
+*/
+type Call struct {
+	ConfigID   int64     // The active configuration ID. Zero if not known.
+	EntryTime  time.Time // When the call started.
+	Err        error     // The error returned by the call, if any.
+	ResultSize int       // Length in bytes of the string result. NoResult if the method has none.
+}
+
+/*
+Type Event struct describes a notification, before its details are gathered, for a Filter to accept or reject.
+*/
+type Event struct {
+	ComponentID int   // The component sending the notification, such as 6004 for szengine.
+	MessageID   int   // The message number, such as 8001.
+	Err         error // The error returned by the call, if any.
+}
+
+// Type Filter returns true if an observer is to receive the notification of an event.
+type Filter func(event Event) bool
+
+/*
+Type Schema struct describes the notifications of one message number of a component.
+*/
+type Schema struct {
+	Method  string   // The method sending the notification, such as "AddRecord".
+	Details []string // Keys always present in the details, besides the keys of every notification.
+}
+
+/*
+Type Subscription struct pairs an observer with the filter of the notifications it receives.
+*/
+type Subscription struct {
+	Observer observer.Observer // The observer notified.
+	Filter   Filter            // Notifications sent to the observer. nil for all.
+}
+
+type contextKey struct{}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Optional details.
+const (
+	Duration      Details = 1 << iota // Add DurationKey.
+	ResultSize                        // Add ResultSizeKey.
+	ExceptionCode                     // Add ExceptionCodeKey.
+	ConfigID                          // Add ConfigIDKey.
+	RequestID                         // Add RequestIDKey and TraceIDKey.
+)
+
+// AllDetails adds every optional detail. NoDetails, the default, adds none.
+const (
+	AllDetails Details = Duration | ResultSize | ExceptionCode | ConfigID | RequestID
+	NoDetails  Details = 0
+)
+
+// Keys of the optional details.
+const (
+	ConfigIDKey      = "activeConfigID"
+	DurationKey      = "durationNanoseconds"
+	ExceptionCodeKey = "exceptionCode"
+	RequestIDKey     = "requestID"
+	ResultSizeKey    = "resultSize"
+	TraceIDKey       = "traceID"
+)
+
+// NoResult is the ResultSize of a call without a string result.
+const NoResult = -1
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Schemas maps a component ID, such as 6004 for szengine, and a message number to its Schema.
+var Schemas = map[int]map[int]Schema{
+	6001: { // szconfig
+		8001: {Method: "AddDataSource", Details: []string{"dataSourceCode", "return"}},
+		8002: {Method: "CloseConfig"},
+		8003: {Method: "CreateConfig"},
+		8004: {Method: "DeleteDataSource", Details: []string{"dataSourceCode"}},
+		8005: {Method: "Destroy"},
+		8006: {Method: "ExportConfig"},
+		8007: {Method: "Initialize", Details: []string{"instanceName", "settings", "verboseLogging"}},
+		8008: {Method: "GetDataSources"},
+		8009: {Method: "ImportConfig"},
+		8702: {Method: "RegisterObserver", Details: []string{"observerID"}},
+		8703: {Method: "SetLogLevel", Details: []string{"logLevelName"}},
+		8704: {Method: "UnregisterObserver", Details: []string{"observerID"}},
+	},
+	6002: { // szconfigmanager
+		8001: {Method: "AddConfig", Details: []string{"configComment"}},
+		8002: {Method: "Destroy"},
+		8003: {Method: "GetConfig"},
+		8004: {Method: "GetConfigs"},
+		8005: {Method: "GetDefaultConfigID"},
+		8006: {Method: "Initialize", Details: []string{"instanceName", "settings", "verboseLogging"}},
+		8007: {Method: "ReplaceDefaultConfigID", Details: []string{"newDefaultConfigID"}},
+		8008: {Method: "SetDefaultConfigID", Details: []string{"configID"}},
+		8702: {Method: "RegisterObserver", Details: []string{"observerID"}},
+		8703: {Method: "SetLogLevel", Details: []string{"logLevelName"}},
+		8704: {Method: "UnregisterObserver", Details: []string{"observerID"}},
+	},
+	6003: { // szdiagnostic
+		8001: {Method: "CheckDatastorePerformance"},
+		8002: {Method: "Destroy"},
+		8003: {Method: "GetDatastoreInfo"},
+		8004: {Method: "GetFeature", Details: []string{"featureID"}},
+		8005: {Method: "Initialize", Details: []string{"configID", "instanceName", "settings", "verboseLogging"}},
+		8007: {Method: "PurgeRepository"},
+		8008: {Method: "Reinitialize", Details: []string{"configID"}},
+		8702: {Method: "RegisterObserver", Details: []string{"observerID"}},
+		8703: {Method: "SetLogLevel", Details: []string{"logLevelName"}},
+		8704: {Method: "UnregisterObserver", Details: []string{"observerID"}},
+	},
+	6004: { // szengine
+		8001: {Method: "AddRecord", Details: []string{"dataSourceCode", "recordID"}},
+		8002: {Method: "CloseExport"},
+		8003: {Method: "CountRedoRecords"},
+		8004: {Method: "DeleteRecord", Details: []string{"dataSourceCode", "recordID"}},
+		8005: {Method: "Destroy"},
+		8006: {Method: "ExportCsvEntityReport"},
+		8007: {Method: "ExportCsvEntityReportIterator"},
+		8008: {Method: "ExportJSONEntityReport"},
+		8009: {Method: "ExportJSONEntityReportIterator"},
+		8010: {Method: "FetchNext"},
+		8011: {Method: "FindInterestingEntitiesByEntityID", Details: []string{"entityID"}},
+		8012: {Method: "FindInterestingEntitiesByRecordID", Details: []string{"dataSourceCode", "recordID"}},
+		8013: {Method: "FindNetworkByEntityID", Details: []string{"entityIDs"}},
+		8014: {Method: "FindNetworkByRecordID", Details: []string{"recordKeys"}},
+		8015: {Method: "FindPathByEntityID", Details: []string{"startEntityID", "endEntityID", "avoidEntityIDs", "requiredDataSources"}},
+		8016: {Method: "FindPathByRecordID", Details: []string{"startDataSourceCode", "startRecordID", "endDataSourceCode", "endRecordID", "avoidRecordKeys", "requiredDataSources"}},
+		8017: {Method: "GetActiveConfigID"},
+		8018: {Method: "GetEntityByEntityID", Details: []string{"entityID"}},
+		8019: {Method: "GetEntityByRecordID", Details: []string{"dataSourceCode", "recordID"}},
+		8020: {Method: "GetRecord", Details: []string{"dataSourceCode", "recordID"}},
+		8021: {Method: "GetRedoRecord"},
+		8022: {Method: "GetStats"},
+		8023: {Method: "GetVirtualEntityByRecordID", Details: []string{"recordKeys"}},
+		8024: {Method: "HowEntityByEntityID", Details: []string{"entityID"}},
+		8025: {Method: "Initialize", Details: []string{"configID", "instanceName", "settings", "verboseLogging"}},
+		8026: {Method: "PrimeEngine"},
+		8027: {Method: "ProcessRedoRecord"},
+		8028: {Method: "ReevaluateEntity", Details: []string{"entityID"}},
+		8029: {Method: "ReevaluateRecord", Details: []string{"dataSourceCode", "recordID"}},
+		8030: {Method: "Reinitialize", Details: []string{"configID"}},
+		8031: {Method: "SearchByAttributes", Details: []string{"attributes", "searchProfile"}},
+		8032: {Method: "WhyEntities", Details: []string{"entityID1", "entityID2"}},
+		8033: {Method: "WhyRecordInEntity", Details: []string{"dataSourceCode", "recordID"}},
+		8034: {Method: "WhyRecords", Details: []string{"dataSourceCode1", "recordID1", "dataSourceCode2", "recordID2"}},
+		8035: {Method: "PreprocessRecord"},
+		8702: {Method: "RegisterObserver", Details: []string{"observerID"}},
+		8703: {Method: "SetLogLevel", Details: []string{"logLevelName"}},
+		8704: {Method: "UnregisterObserver", Details: []string{"observerID"}},
+	},
+	6006: { // szproduct
+		8001: {Method: "Destroy"},
+		8002: {Method: "Initialize", Details: []string{"instanceName", "settings", "verboseLogging"}},
+		8003: {Method: "GetLicense"},
+		8004: {Method: "GetVersion"},
+		8702: {Method: "RegisterObserver", Details: []string{"observerID"}},
+		8703: {Method: "SetLogLevel", Details: []string{"logLevelName"}},
+		8704: {Method: "UnregisterObserver", Details: []string{"observerID"}},
+	},
+}
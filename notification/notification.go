@@ -0,0 +1,189 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ----------------------------------------------------------------------------
+// Interface functions
+// ----------------------------------------------------------------------------
+
+/*
+The Add function adds the chosen optional details of a call to the details of its notification.
+Existing keys are never replaced.
+
+Input
+  - ctx: The context of the call, holding the request ID and span, if any.
+  - details: The details of the notification. Modified in place.
+  - options: The optional details to add.
+  - call: The completed call.
+*/
+func Add(ctx context.Context, details map[string]string, options Details, call Call) {
+	if options&Duration != 0 {
+		addDetail(details, DurationKey, strconv.FormatInt(time.Since(call.EntryTime).Nanoseconds(), 10))
+	}
+	if options&ResultSize != 0 && call.ResultSize >= 0 {
+		addDetail(details, ResultSizeKey, strconv.Itoa(call.ResultSize))
+	}
+	if options&ExceptionCode != 0 {
+		var nativeError *nativeerror.Error
+		if errors.As(call.Err, &nativeError) {
+			addDetail(details, ExceptionCodeKey, nativeError.Code())
+		}
+	}
+	if options&ConfigID != 0 && call.ConfigID != 0 {
+		addDetail(details, ConfigIDKey, strconv.FormatInt(call.ConfigID, 10))
+	}
+	if options&RequestID != 0 {
+		if requestID := RequestIDFromContext(ctx); len(requestID) > 0 {
+			addDetail(details, RequestIDKey, requestID)
+		}
+		if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+			addDetail(details, TraceIDKey, spanContext.TraceID().String())
+		}
+	}
+}
+
+/*
+The All function returns a filter accepting the events accepted by every one of its filters.
+A nil filter accepts every event.
+
+Input
+  - filters: The filters to combine.
+
+Output
+  - The combined filter.
+*/
+func All(filters ...Filter) Filter {
+	return func(event Event) bool {
+		for _, filter := range filters {
+			if filter != nil && !filter(event) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+/*
+The Any function returns a filter accepting the events accepted by at least one of its filters.
+A nil filter accepts every event.
+
+Input
+  - filters: The filters to combine.
+
+Output
+  - The combined filter.
+*/
+func Any(filters ...Filter) Filter {
+	return func(event Event) bool {
+		for _, filter := range filters {
+			if filter == nil || filter(event) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+/*
+The Components function returns a filter accepting the events of some components,
+for observers registered on several Sz objects, as by the Subscriptions of szabstractfactory.
+
+Input
+  - componentIDs: The accepted components, such as 6002 for szconfigmanager.
+
+Output
+  - The filter.
+*/
+func Components(componentIDs ...int) Filter {
+	accepted := toSet(componentIDs)
+	return func(event Event) bool {
+		_, isAccepted := accepted[event.ComponentID]
+		return isAccepted
+	}
+}
+
+/*
+The Errors function is a filter accepting the events of failed calls.
+
+Input
+  - event: The event of a notification.
+
+Output
+  - True if the call failed.
+*/
+func Errors(event Event) bool {
+	return event.Err != nil
+}
+
+/*
+The MessageIDs function returns a filter accepting the events of some message numbers.
+The message numbers of each component are listed in Schemas.
+
+Input
+  - messageIDs: The accepted message numbers, such as 8001.
+
+Output
+  - The filter.
+*/
+func MessageIDs(messageIDs ...int) Filter {
+	accepted := toSet(messageIDs)
+	return func(event Event) bool {
+		_, isAccepted := accepted[event.MessageID]
+		return isAccepted
+	}
+}
+
+/*
+The RequestIDFromContext function returns the request ID set by WithRequestID.
+
+Input
+  - ctx: A context.
+
+Output
+  - The request ID. Empty if not set.
+*/
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(contextKey{}).(string)
+	return requestID
+}
+
+/*
+The WithRequestID function returns a context holding a request ID,
+added to notifications of calls made with it when RequestID details are chosen.
+
+Input
+  - ctx: The parent context.
+  - requestID: An identifier correlating the notifications of one request.
+
+Output
+  - A child context holding the request ID.
+*/
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, requestID)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func addDetail(details map[string]string, key string, value string) {
+	if _, isSet := details[key]; !isSet {
+		details[key] = value
+	}
+}
+
+func toSet(values []int) map[int]struct{} {
+	result := make(map[int]struct{}, len(values))
+	for _, value := range values {
+		result[value] = struct{}{}
+	}
+	return result
+}
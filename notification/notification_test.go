@@ -0,0 +1,144 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"github.com/senzing-garage/sz-sdk-go/szengine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	printResults = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNotification_Add(test *testing.T) {
+	ctx := WithRequestID(context.TODO(), "request-1")
+	err := nativeerror.New(6004, 4035, szengine.IDMessages, 33, "0033E|Unknown record", "unknown record", "CUSTOMERS", "1001", int64(0))
+	details := map[string]string{"recordID": "1001"}
+	Add(ctx, details, AllDetails, Call{
+		ConfigID:   4019066234,
+		EntryTime:  time.Now().Add(-time.Second),
+		Err:        err,
+		ResultSize: 42,
+	})
+	printActual(test, details)
+	assert.Equal(test, "1001", details["recordID"])
+	duration, parseErr := strconv.ParseInt(details[DurationKey], 10, 64)
+	require.NoError(test, parseErr)
+	assert.GreaterOrEqual(test, duration, time.Second.Nanoseconds())
+	assert.Equal(test, "42", details[ResultSizeKey])
+	assert.Equal(test, "SENZ0033", details[ExceptionCodeKey])
+	assert.Equal(test, "4019066234", details[ConfigIDKey])
+	assert.Equal(test, "request-1", details[RequestIDKey])
+	assert.NotContains(test, details, TraceIDKey)
+}
+
+func TestNotification_Add_noDetails(test *testing.T) {
+	ctx := WithRequestID(context.TODO(), "request-1")
+	details := map[string]string{"recordID": "1001"}
+	Add(ctx, details, NoDetails, Call{EntryTime: time.Now(), ResultSize: 42, ConfigID: 1})
+	assert.Equal(test, map[string]string{"recordID": "1001"}, details)
+}
+
+func TestNotification_Add_omitted(test *testing.T) {
+	ctx := context.TODO()
+	details := map[string]string{}
+	Add(ctx, details, AllDetails, Call{EntryTime: time.Now(), Err: errors.New("malformed"), ResultSize: NoResult})
+	assert.Contains(test, details, DurationKey)
+	assert.NotContains(test, details, ResultSizeKey)
+	assert.NotContains(test, details, ExceptionCodeKey)
+	assert.NotContains(test, details, ConfigIDKey)
+	assert.NotContains(test, details, RequestIDKey)
+	assert.NotContains(test, details, TraceIDKey)
+}
+
+func TestNotification_Add_keepsExisting(test *testing.T) {
+	ctx := WithRequestID(context.TODO(), "request-1")
+	details := map[string]string{RequestIDKey: "set by caller"}
+	Add(ctx, details, RequestID, Call{EntryTime: time.Now()})
+	assert.Equal(test, "set by caller", details[RequestIDKey])
+}
+
+func TestNotification_Add_traceID(test *testing.T) {
+	ctx := context.TODO()
+	provider := sdktrace.NewTracerProvider()
+	spanCtx, span := provider.Tracer("test").Start(ctx, "request")
+	defer span.End()
+	details := map[string]string{}
+	Add(spanCtx, details, RequestID, Call{EntryTime: time.Now()})
+	assert.Equal(test, span.SpanContext().TraceID().String(), details[TraceIDKey])
+	assert.NotContains(test, details, RequestIDKey)
+}
+
+func TestNotification_All(test *testing.T) {
+	filter := All(Components(6004), MessageIDs(8001, 8004), nil)
+	assert.True(test, filter(Event{ComponentID: 6004, MessageID: 8004}))
+	assert.False(test, filter(Event{ComponentID: 6004, MessageID: 8002}))
+	assert.False(test, filter(Event{ComponentID: 6001, MessageID: 8001}))
+	assert.True(test, All()(Event{}))
+}
+
+func TestNotification_Any(test *testing.T) {
+	filter := Any(MessageIDs(8001), Errors)
+	assert.True(test, filter(Event{MessageID: 8001}))
+	assert.True(test, filter(Event{MessageID: 8002, Err: errors.New("failed")}))
+	assert.False(test, filter(Event{MessageID: 8002}))
+	assert.False(test, Any()(Event{}))
+	assert.True(test, Any(nil)(Event{}))
+}
+
+func TestNotification_Components(test *testing.T) {
+	filter := Components(6002, 6003)
+	assert.True(test, filter(Event{ComponentID: 6002}))
+	assert.False(test, filter(Event{ComponentID: 6004}))
+	assert.False(test, Components()(Event{ComponentID: 6004}))
+}
+
+func TestNotification_Errors(test *testing.T) {
+	var filter Filter = Errors
+	assert.True(test, filter(Event{Err: errors.New("failed")}))
+	assert.False(test, filter(Event{}))
+}
+
+func TestNotification_MessageIDs(test *testing.T) {
+	filter := MessageIDs(8007, 8008)
+	assert.True(test, filter(Event{ComponentID: 6002, MessageID: 8008}))
+	assert.False(test, filter(Event{ComponentID: 6002, MessageID: 8001}))
+}
+
+func TestNotification_RequestIDFromContext(test *testing.T) {
+	ctx := context.TODO()
+	assert.Empty(test, RequestIDFromContext(ctx))
+	assert.Equal(test, "request-1", RequestIDFromContext(WithRequestID(ctx, "request-1")))
+}
+
+func TestNotification_Schemas(test *testing.T) {
+	for componentID, schemas := range Schemas {
+		assert.NotEmpty(test, schemas, componentID)
+		for messageID, schema := range schemas {
+			assert.NotEmpty(test, schema.Method, messageID)
+		}
+	}
+	assert.Equal(test, Schema{Method: "AddRecord", Details: []string{"dataSourceCode", "recordID"}}, Schemas[6004][8001])
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %v", actual)
+	}
+}
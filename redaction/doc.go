@@ -0,0 +1,34 @@
+/*
+Package redaction masks personal data and secrets before they reach errors, logs and observers.
+
+Redaction is opt-in and configured per component. Pass a [Policy] to the SetRedaction method of
+[szconfig.Szconfig], [szconfigmanager.Szconfigmanager], [szdiagnostic.Szdiagnostic],
+[szengine.Szengine] or [szproduct.Szproduct], or set the Redaction of an
+[szabstractfactory.Szabstractfactory]:
+
+	szEngine.SetRedaction(ctx, &redaction.Policy{
+		Credentials: true,
+		Keys:        redaction.DefaultKeys,
+	})
+
+The policy then applies to the arguments in error messages, the arguments and results in trace logs,
+the Senzing exception texts, and the details of observer notifications.
+Values are judged by their content, not by the parameter they were passed as:
+
+  - Credentials: the password of a URL, such as the database connection in the settings, becomes "****".
+  - Documents: a JSON document, such as a record definition, an entity result or the settings,
+    becomes a digest like "<redacted length=118 sha256=4e1243bd22c6>".
+  - Keys: the values of matching JSON attributes become digests, leaving the rest of the document readable.
+    A document that cannot be parsed, such as a malformed record definition, becomes a digest.
+
+Digests keep the length and a hash of the value, so equal values can still be correlated.
+Set HashKey to make the hashes of short values, such as social security numbers, impossible to guess.
+
+[szabstractfactory.Szabstractfactory]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szabstractfactory#Szabstractfactory
+[szconfig.Szconfig]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfig#Szconfig
+[szconfigmanager.Szconfigmanager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfigmanager#Szconfigmanager
+[szdiagnostic.Szdiagnostic]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szdiagnostic#Szdiagnostic
+[szengine.Szengine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine#Szengine
+[szproduct.Szproduct]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szproduct#Szproduct
+*/
+package redaction
@@ -0,0 +1,49 @@
+package redaction
+
+import "regexp"
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Policy struct chooses what is redacted. A nil *Policy redacts nothing.
+A Policy must not be changed after it is set on an Sz object.
+*/
+type Policy struct {
+	Credentials bool     // Mask the passwords of URLs.
+	Documents   bool     // Replace JSON documents by digests.
+	HashKey     []byte   // If not empty, digests use HMAC-SHA256 with this key instead of SHA-256.
+	Keys        []string // Replace the values of JSON attributes with these names, or ending in "_" and one of these names, by digests. Case-insensitive.
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// MaskedPassword replaces the password of a URL.
+const MaskedPassword = "****"
+
+// Number of hexadecimal digits of the hash in a digest.
+const hashLength = 12
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// DefaultKeys names the identifying attributes of Senzing records.
+var DefaultKeys = []string{
+	"ACCOUNT_NUMBER",
+	"CC_ACCOUNT_NUMBER",
+	"DATE_OF_BIRTH",
+	"DRIVERS_LICENSE_NUMBER",
+	"NATIONAL_ID_NUMBER",
+	"PASSPORT_NUMBER",
+	"PASSWORD",
+	"SSN_LAST4",
+	"SSN_NUMBER",
+	"TAX_ID_NUMBER",
+}
+
+// The user information of a URL, up to its password: "scheme://user:".
+var credentialPattern = regexp.MustCompile(`(?i)\b([a-z][a-z0-9+.\-]*://[^:/?#@\s"']*:)[^@/\s"']*@`)
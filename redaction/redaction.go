@@ -0,0 +1,199 @@
+package redaction
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Policy methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Details redacts the values of the details of a notification.
+
+Input
+  - details: The details. Modified in place.
+*/
+func (policy *Policy) Details(details map[string]string) {
+	if policy == nil {
+		return
+	}
+	for key, value := range details {
+		details[key] = policy.Redact(value)
+	}
+}
+
+/*
+Method Digest returns the replacement of a redacted value, with its length and hash,
+such as "<redacted length=11 sha256=0ffe1abd1a08>".
+
+Input
+  - value: The value replaced.
+
+Output
+  - The digest. Its hash is "hmac-sha256" if the policy has a HashKey.
+*/
+func (policy *Policy) Digest(value string) string {
+	algorithm := "sha256"
+	var sum []byte
+	if policy != nil && len(policy.HashKey) > 0 {
+		algorithm = "hmac-sha256"
+		mac := hmac.New(sha256.New, policy.HashKey)
+		mac.Write([]byte(value))
+		sum = mac.Sum(nil)
+	} else {
+		hash := sha256.Sum256([]byte(value))
+		sum = hash[:]
+	}
+	return fmt.Sprintf("<redacted length=%d %s=%s>", len(value), algorithm, hex.EncodeToString(sum)[:hashLength])
+}
+
+/*
+Method Redact redacts a value according to the policy.
+
+Input
+  - value: A value, such as an argument, a result or a notification detail.
+
+Output
+  - The value, or its redacted form. Unchanged if the policy is nil.
+*/
+func (policy *Policy) Redact(value string) string {
+	if policy == nil {
+		return value
+	}
+	if isJSON(value) {
+		if policy.Documents {
+			return policy.Digest(value)
+		}
+		if len(policy.Keys) > 0 {
+			value = policy.redactKeys(value)
+		}
+	}
+	if policy.Credentials {
+		value = credentialPattern.ReplaceAllString(value, "${1}"+MaskedPassword+"@")
+	}
+	return value
+}
+
+/*
+Method Values redacts the strings of a list of values, such as the arguments of a trace log.
+
+Input
+  - values: The values. Strings, byte slices and the values of map[string]string are redacted; others are kept.
+
+Output
+  - The redacted values. The input itself if the policy is nil.
+*/
+func (policy *Policy) Values(values []interface{}) []interface{} {
+	if policy == nil || len(values) == 0 {
+		return values
+	}
+	result := make([]interface{}, len(values))
+	for index, value := range values {
+		switch typedValue := value.(type) {
+		case string:
+			result[index] = policy.Redact(typedValue)
+		case []byte:
+			result[index] = []byte(policy.Redact(string(typedValue)))
+		case map[string]string:
+			details := make(map[string]string, len(typedValue))
+			for key, detail := range typedValue {
+				details[key] = detail
+			}
+			policy.Details(details)
+			result[index] = details
+		default:
+			result[index] = value
+		}
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Report whether an attribute name is one of the Keys, or ends in "_" and one of the Keys.
+func (policy *Policy) isKey(name string) bool {
+	for _, key := range policy.Keys {
+		if strings.EqualFold(name, key) {
+			return true
+		}
+		if len(name) > len(key) && name[len(name)-len(key)-1] == '_' && strings.EqualFold(name[len(name)-len(key):], key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Replace the values of the Keys in a JSON document. The document is kept as is if nothing is replaced,
+// and replaced by a digest if it cannot be parsed.
+func (policy *Policy) redactKeys(document string) string {
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+	var parsed interface{}
+	if decoder.Decode(&parsed) != nil || !errors.Is(decoder.Decode(&struct{}{}), io.EOF) {
+		return policy.Digest(document)
+	}
+	if !policy.redactValue(parsed) {
+		return document
+	}
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if encoder.Encode(parsed) != nil {
+		return policy.Digest(document)
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// Replace the values of the Keys in a parsed JSON value, reporting whether any was replaced.
+func (policy *Policy) redactValue(parsed interface{}) bool {
+	isRedacted := false
+	switch typedValue := parsed.(type) {
+	case map[string]interface{}:
+		for name, value := range typedValue {
+			if policy.isKey(name) {
+				typedValue[name] = policy.Digest(text(value))
+				isRedacted = true
+				continue
+			}
+			isRedacted = policy.redactValue(value) || isRedacted
+		}
+	case []interface{}:
+		for _, value := range typedValue {
+			isRedacted = policy.redactValue(value) || isRedacted
+		}
+	}
+	return isRedacted
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Report whether a value looks like a JSON object or array.
+func isJSON(value string) bool {
+	trimmed := strings.TrimSpace(value)
+	return strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")
+}
+
+// The text of a parsed JSON value: a string as is, anything else as JSON.
+func text(value interface{}) string {
+	if stringValue, isString := value.(string); isString {
+		return stringValue
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
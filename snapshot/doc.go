@@ -0,0 +1,16 @@
+/*
+Package snapshot is used to compute repository statistics from the JSON entity export of [senzing.SzEngine].
+
+A snapshot is computed in a single streaming pass over the export and reports:
+  - Total entities and records.
+  - A histogram of entity sizes (records per entity).
+  - Records and entities per data source.
+  - Cross-source matches: the number of entities containing records from each pair of data sources.
+  - Relationship counts by match level.
+
+The [Report] is deterministic: all lists are sorted, so two reports of the same
+repository are byte-for-byte identical and can be diffed between runs.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+package snapshot
@@ -0,0 +1,74 @@
+package snapshot
+
+import (
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Type CrossSourceCount is the number of entities containing records from both data sources.
+type CrossSourceCount struct {
+	DataSource1 string `json:"DATA_SOURCE_1"`
+	DataSource2 string `json:"DATA_SOURCE_2"`
+	EntityCount int64  `json:"ENTITY_COUNT"`
+}
+
+// Type DataSourceCount is the number of records from a data source and the number of entities containing them.
+type DataSourceCount struct {
+	DataSource  string `json:"DATA_SOURCE"`
+	RecordCount int64  `json:"RECORD_COUNT"`
+	EntityCount int64  `json:"ENTITY_COUNT"`
+}
+
+// Type EntitySizeCount is the number of entities having a given number of records.
+type EntitySizeCount struct {
+	RecordCount int64 `json:"RECORD_COUNT"`
+	EntityCount int64 `json:"ENTITY_COUNT"`
+}
+
+// Type RelationshipCount is the number of distinct entity relationships at a given match level.
+type RelationshipCount struct {
+	MatchLevel     int64  `json:"MATCH_LEVEL"`
+	MatchLevelCode string `json:"MATCH_LEVEL_CODE"`
+	Count          int64  `json:"COUNT"`
+}
+
+// Type Report is the result of a snapshot.
+type Report struct {
+	TotalEntities       int64               `json:"TOTAL_ENTITIES"`
+	TotalRecords        int64               `json:"TOTAL_RECORDS"`
+	TotalRelationships  int64               `json:"TOTAL_RELATIONSHIPS"`
+	EntitySizes         []EntitySizeCount   `json:"ENTITY_SIZES"`
+	RecordsByDataSource []DataSourceCount   `json:"RECORDS_BY_DATA_SOURCE"`
+	CrossSourceMatches  []CrossSourceCount  `json:"CROSS_SOURCE_MATCHES"`
+	Relationships       []RelationshipCount `json:"RELATIONSHIPS"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+ExportFlags are the minimal flags needed by ExportJSONEntityReport() to compute a snapshot.
+*/
+const ExportFlags = senzing.SzExportIncludeAllEntities |
+	senzing.SzEntityIncludeAllRelations |
+	senzing.SzEntityIncludeRecordData |
+	senzing.SzEntityIncludeRecordSummary |
+	senzing.SzEntityIncludeRelatedMatchingInfo
+
+/*
+CSV column names and categories used by [Report.WriteCSV].
+*/
+const (
+	CsvCategoryCrossSource        = "CROSS_SOURCE_ENTITIES"
+	CsvCategoryDataSourceEntities = "DATA_SOURCE_ENTITIES"
+	CsvCategoryDataSourceRecords  = "DATA_SOURCE_RECORDS"
+	CsvCategoryEntitySize         = "ENTITY_SIZE"
+	CsvCategoryRelationship       = "RELATIONSHIPS"
+	CsvCategoryTotal              = "TOTAL"
+)
+
+var csvHeader = []string{"CATEGORY", "KEY", "COUNT"}
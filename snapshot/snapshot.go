@@ -0,0 +1,284 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/senzing-garage/sz-sdk-go-core/entityexport"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// Type Snapshot struct accumulates statistics one entity at a time.
+type Snapshot struct {
+	crossSources       map[[2]string]int64
+	entitiesBySource   map[string]int64
+	entitySizes        map[int64]int64
+	matchLevelCodes    map[int64]string
+	recordsBySource    map[string]int64
+	relationships      map[int64]int64
+	totalEntities      int64
+	totalRecords       int64
+	totalRelationships int64
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Compute function computes a snapshot from a JSON-lines entity export.
+
+Input
+  - reader: The output of ExportJSONEntityReport(), e.g. an [entityexport.Reader] or an exported file.
+
+Output
+  - The snapshot report.
+*/
+func Compute(reader io.Reader) (*Report, error) {
+	snapshot := New()
+	_, err := entityexport.ForEach(reader, func(entity *entityexport.Entity) error {
+		snapshot.Add(entity)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.Report(), nil
+}
+
+/*
+The ComputeFromEngine function exports all entities using [ExportFlags] and computes a snapshot.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine used to export entities.
+
+Output
+  - The snapshot report.
+*/
+func ComputeFromEngine(ctx context.Context, szEngine senzing.SzEngine) (*Report, error) {
+	reader, err := entityexport.NewJSONReader(ctx, szEngine, ExportFlags)
+	if err != nil {
+		return nil, err
+	}
+	result, err := Compute(reader)
+	closeErr := reader.Close()
+	if err != nil {
+		return nil, err
+	}
+	if closeErr != nil {
+		return nil, closeErr
+	}
+	return result, nil
+}
+
+/*
+The New function returns an empty Snapshot.
+*/
+func New() *Snapshot {
+	return &Snapshot{
+		crossSources:     map[[2]string]int64{},
+		entitiesBySource: map[string]int64{},
+		entitySizes:      map[int64]int64{},
+		matchLevelCodes:  map[int64]string{},
+		recordsBySource:  map[string]int64{},
+		relationships:    map[int64]int64{},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Snapshot methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Add adds an entity to the snapshot.
+
+A relationship is reported by both of the related entities,
+so it is only counted from the entity having the lower entity ID.
+
+Input
+  - entity: An entity from the JSON entity export.
+*/
+func (snapshot *Snapshot) Add(entity *entityexport.Entity) {
+	snapshot.totalEntities++
+	recordCount := entity.RecordCount()
+	snapshot.totalRecords += recordCount
+	snapshot.entitySizes[recordCount]++
+
+	for dataSource, count := range entity.RecordCountByDataSource() {
+		snapshot.recordsBySource[dataSource] += count
+		snapshot.entitiesBySource[dataSource]++
+	}
+
+	dataSources := entity.DataSources()
+	for i := 0; i < len(dataSources); i++ {
+		for j := i + 1; j < len(dataSources); j++ {
+			snapshot.crossSources[[2]string{dataSources[i], dataSources[j]}]++
+		}
+	}
+
+	entityID := entity.ResolvedEntity.EntityID
+	for _, related := range entity.RelatedEntities {
+		if related.EntityID <= entityID {
+			continue
+		}
+		snapshot.totalRelationships++
+		snapshot.relationships[related.MatchLevel]++
+		if len(related.MatchLevelCode) > 0 {
+			snapshot.matchLevelCodes[related.MatchLevel] = related.MatchLevelCode
+		}
+	}
+}
+
+/*
+Method Report returns the statistics accumulated so far.
+All lists in the report are sorted so that reports can be compared.
+
+Output
+  - The snapshot report.
+*/
+func (snapshot *Snapshot) Report() *Report {
+	result := &Report{
+		CrossSourceMatches:  []CrossSourceCount{},
+		EntitySizes:         []EntitySizeCount{},
+		RecordsByDataSource: []DataSourceCount{},
+		Relationships:       []RelationshipCount{},
+		TotalEntities:       snapshot.totalEntities,
+		TotalRecords:        snapshot.totalRecords,
+		TotalRelationships:  snapshot.totalRelationships,
+	}
+
+	for recordCount, entityCount := range snapshot.entitySizes {
+		result.EntitySizes = append(result.EntitySizes, EntitySizeCount{
+			EntityCount: entityCount,
+			RecordCount: recordCount,
+		})
+	}
+	sort.Slice(result.EntitySizes, func(i, j int) bool {
+		return result.EntitySizes[i].RecordCount < result.EntitySizes[j].RecordCount
+	})
+
+	for dataSource, recordCount := range snapshot.recordsBySource {
+		result.RecordsByDataSource = append(result.RecordsByDataSource, DataSourceCount{
+			DataSource:  dataSource,
+			EntityCount: snapshot.entitiesBySource[dataSource],
+			RecordCount: recordCount,
+		})
+	}
+	sort.Slice(result.RecordsByDataSource, func(i, j int) bool {
+		return result.RecordsByDataSource[i].DataSource < result.RecordsByDataSource[j].DataSource
+	})
+
+	for dataSources, entityCount := range snapshot.crossSources {
+		result.CrossSourceMatches = append(result.CrossSourceMatches, CrossSourceCount{
+			DataSource1: dataSources[0],
+			DataSource2: dataSources[1],
+			EntityCount: entityCount,
+		})
+	}
+	sort.Slice(result.CrossSourceMatches, func(i, j int) bool {
+		a, b := result.CrossSourceMatches[i], result.CrossSourceMatches[j]
+		if a.DataSource1 != b.DataSource1 {
+			return a.DataSource1 < b.DataSource1
+		}
+		return a.DataSource2 < b.DataSource2
+	})
+
+	for matchLevel, count := range snapshot.relationships {
+		result.Relationships = append(result.Relationships, RelationshipCount{
+			Count:          count,
+			MatchLevel:     matchLevel,
+			MatchLevelCode: snapshot.matchLevelCodes[matchLevel],
+		})
+	}
+	sort.Slice(result.Relationships, func(i, j int) bool {
+		return result.Relationships[i].MatchLevel < result.Relationships[j].MatchLevel
+	})
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Report methods
+// ----------------------------------------------------------------------------
+
+/*
+Method CrossSourceCount returns the number of entities containing records from both data sources.
+The order of the data sources does not matter.
+*/
+func (report *Report) CrossSourceCount(dataSource1 string, dataSource2 string) int64 {
+	if dataSource2 < dataSource1 {
+		dataSource1, dataSource2 = dataSource2, dataSource1
+	}
+	for _, crossSource := range report.CrossSourceMatches {
+		if crossSource.DataSource1 == dataSource1 && crossSource.DataSource2 == dataSource2 {
+			return crossSource.EntityCount
+		}
+	}
+	return 0
+}
+
+/*
+Method WriteCSV writes the report as CSV having the columns CATEGORY, KEY and COUNT.
+Cross-source keys are written as "DATA_SOURCE_1|DATA_SOURCE_2".
+Relationship keys are the MATCH_LEVEL_CODE, or the MATCH_LEVEL if the code is unknown.
+
+Input
+  - writer: Where the CSV is written.
+*/
+func (report *Report) WriteCSV(writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	rows := [][]string{
+		csvHeader,
+		{CsvCategoryTotal, "ENTITIES", formatInt(report.TotalEntities)},
+		{CsvCategoryTotal, "RECORDS", formatInt(report.TotalRecords)},
+		{CsvCategoryTotal, "RELATIONSHIPS", formatInt(report.TotalRelationships)},
+	}
+	for _, entitySize := range report.EntitySizes {
+		rows = append(rows, []string{CsvCategoryEntitySize, formatInt(entitySize.RecordCount), formatInt(entitySize.EntityCount)})
+	}
+	for _, dataSource := range report.RecordsByDataSource {
+		rows = append(rows, []string{CsvCategoryDataSourceRecords, dataSource.DataSource, formatInt(dataSource.RecordCount)})
+	}
+	for _, dataSource := range report.RecordsByDataSource {
+		rows = append(rows, []string{CsvCategoryDataSourceEntities, dataSource.DataSource, formatInt(dataSource.EntityCount)})
+	}
+	for _, crossSource := range report.CrossSourceMatches {
+		rows = append(rows, []string{CsvCategoryCrossSource, crossSource.DataSource1 + "|" + crossSource.DataSource2, formatInt(crossSource.EntityCount)})
+	}
+	for _, relationship := range report.Relationships {
+		key := relationship.MatchLevelCode
+		if len(key) == 0 {
+			key = formatInt(relationship.MatchLevel)
+		}
+		rows = append(rows, []string{CsvCategoryRelationship, key, formatInt(relationship.Count)})
+	}
+	if err := csvWriter.WriteAll(rows); err != nil {
+		return err
+	}
+	return csvWriter.Error()
+}
+
+/*
+Method WriteJSON writes the report as indented JSON.
+
+Input
+  - writer: Where the JSON is written.
+*/
+func (report *Report) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func formatInt(value int64) string {
+	return strconv.FormatInt(value, 10)
+}
@@ -0,0 +1,192 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	exportFilename = "../testdata/export/export-json-entity-report.jsonl"
+	printResults   = false
+)
+
+var expectedCsv = `CATEGORY,KEY,COUNT
+TOTAL,ENTITIES,6
+TOTAL,RECORDS,12
+TOTAL,RELATIONSHIPS,4
+ENTITY_SIZE,1,3
+ENTITY_SIZE,2,1
+ENTITY_SIZE,3,1
+ENTITY_SIZE,4,1
+DATA_SOURCE_RECORDS,CUSTOMERS,7
+DATA_SOURCE_RECORDS,REFERENCE,2
+DATA_SOURCE_RECORDS,WATCHLIST,3
+DATA_SOURCE_ENTITIES,CUSTOMERS,4
+DATA_SOURCE_ENTITIES,REFERENCE,2
+DATA_SOURCE_ENTITIES,WATCHLIST,3
+CROSS_SOURCE_ENTITIES,CUSTOMERS|REFERENCE,1
+CROSS_SOURCE_ENTITIES,CUSTOMERS|WATCHLIST,2
+CROSS_SOURCE_ENTITIES,REFERENCE|WATCHLIST,1
+RELATIONSHIPS,POSSIBLY_SAME,1
+RELATIONSHIPS,POSSIBLY_RELATED,1
+RELATIONSHIPS,NAME_ONLY,1
+RELATIONSHIPS,DISCLOSED,1
+`
+
+// Type mockSzEngine serves a fixed export.
+type mockSzEngine struct {
+	senzing.SzEngine
+	closeErr  error
+	exportErr error
+	remaining string
+}
+
+func (szEngine *mockSzEngine) CloseExport(ctx context.Context, exportHandle uintptr) error {
+	_ = ctx
+	_ = exportHandle
+	return szEngine.closeErr
+}
+
+func (szEngine *mockSzEngine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	_ = ctx
+	_ = flags
+	return 1, szEngine.exportErr
+}
+
+func (szEngine *mockSzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	_ = ctx
+	_ = exportHandle
+	size := min(1000, len(szEngine.remaining))
+	result := szEngine.remaining[:size]
+	szEngine.remaining = szEngine.remaining[size:]
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSnapshot_Compute(test *testing.T) {
+	actual, err := Compute(strings.NewReader(readExportFile(test)))
+	require.NoError(test, err)
+	printActual(test, actual)
+	assert.Equal(test, int64(6), actual.TotalEntities)
+	assert.Equal(test, int64(12), actual.TotalRecords)
+	assert.Equal(test, int64(4), actual.TotalRelationships)
+	assert.Equal(test, []EntitySizeCount{
+		{RecordCount: 1, EntityCount: 3},
+		{RecordCount: 2, EntityCount: 1},
+		{RecordCount: 3, EntityCount: 1},
+		{RecordCount: 4, EntityCount: 1},
+	}, actual.EntitySizes)
+	assert.Equal(test, []DataSourceCount{
+		{DataSource: "CUSTOMERS", RecordCount: 7, EntityCount: 4},
+		{DataSource: "REFERENCE", RecordCount: 2, EntityCount: 2},
+		{DataSource: "WATCHLIST", RecordCount: 3, EntityCount: 3},
+	}, actual.RecordsByDataSource)
+	assert.Equal(test, int64(2), actual.CrossSourceCount("CUSTOMERS", "WATCHLIST"))
+	assert.Equal(test, int64(2), actual.CrossSourceCount("WATCHLIST", "CUSTOMERS"))
+	assert.Equal(test, int64(0), actual.CrossSourceCount("CUSTOMERS", "NO_SUCH_DATASOURCE"))
+	assert.Equal(test, []RelationshipCount{
+		{MatchLevel: 2, MatchLevelCode: "POSSIBLY_SAME", Count: 1},
+		{MatchLevel: 3, MatchLevelCode: "POSSIBLY_RELATED", Count: 1},
+		{MatchLevel: 4, MatchLevelCode: "NAME_ONLY", Count: 1},
+		{MatchLevel: 11, MatchLevelCode: "DISCLOSED", Count: 1},
+	}, actual.Relationships)
+}
+
+func TestSnapshot_Compute_badJSON(test *testing.T) {
+	_, err := Compute(strings.NewReader(`{"RESOLVED_ENTITY":`))
+	require.Error(test, err)
+}
+
+func TestSnapshot_Compute_empty(test *testing.T) {
+	actual, err := Compute(strings.NewReader(""))
+	require.NoError(test, err)
+	var buffer bytes.Buffer
+	require.NoError(test, actual.WriteJSON(&buffer))
+	assert.Contains(test, buffer.String(), `"ENTITY_SIZES": []`)
+}
+
+func TestSnapshot_ComputeFromEngine(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := &mockSzEngine{remaining: readExportFile(test)}
+	actual, err := ComputeFromEngine(ctx, szEngine)
+	require.NoError(test, err)
+	assert.Equal(test, int64(6), actual.TotalEntities)
+}
+
+func TestSnapshot_ComputeFromEngine_closeError(test *testing.T) {
+	ctx := context.TODO()
+	expected := errors.New("close failed")
+	szEngine := &mockSzEngine{closeErr: expected, remaining: readExportFile(test)}
+	_, err := ComputeFromEngine(ctx, szEngine)
+	require.ErrorIs(test, err, expected)
+}
+
+func TestSnapshot_ComputeFromEngine_exportError(test *testing.T) {
+	ctx := context.TODO()
+	expected := errors.New("export failed")
+	szEngine := &mockSzEngine{exportErr: expected}
+	_, err := ComputeFromEngine(ctx, szEngine)
+	require.ErrorIs(test, err, expected)
+}
+
+func TestSnapshot_WriteCSV(test *testing.T) {
+	report, err := Compute(strings.NewReader(readExportFile(test)))
+	require.NoError(test, err)
+	var buffer bytes.Buffer
+	require.NoError(test, report.WriteCSV(&buffer))
+	assert.Equal(test, expectedCsv, buffer.String())
+}
+
+func TestSnapshot_WriteJSON(test *testing.T) {
+	report, err := Compute(strings.NewReader(readExportFile(test)))
+	require.NoError(test, err)
+	var buffer bytes.Buffer
+	require.NoError(test, report.WriteJSON(&buffer))
+	actual := &Report{}
+	require.NoError(test, json.Unmarshal(buffer.Bytes(), actual))
+	assert.Equal(test, report, actual)
+}
+
+func TestSnapshot_WriteJSON_deterministic(test *testing.T) {
+	export := readExportFile(test)
+	var first bytes.Buffer
+	for i := 0; i < 10; i++ {
+		report, err := Compute(strings.NewReader(export))
+		require.NoError(test, err)
+		var buffer bytes.Buffer
+		require.NoError(test, report.WriteJSON(&buffer))
+		if i == 0 {
+			first = buffer
+			continue
+		}
+		assert.Equal(test, first.String(), buffer.String())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("%+v", actual)
+	}
+}
+
+func readExportFile(test *testing.T) string {
+	result, err := os.ReadFile(exportFilename)
+	require.NoError(test, err)
+	return string(result)
+}
@@ -0,0 +1,15 @@
+/*
+Package statscollector periodically calls GetStats of [senzing.SzEngine]
+and keeps running totals of the workload counters.
+
+GetStats resets its counters on each call, so every response is the workload since the previous call.
+A [Collector] calls GetStats on an interval, decodes the response with [szmodel.DecodeStats]
+and accumulates it into running totals.
+The latest [Sample] and a bounded history are available to callers,
+and each sample is sent to registered observers, so loaders can report
+records per second, retries and cache behavior without parsing JSON.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+[szmodel.DecodeStats]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szmodel#DecodeStats
+*/
+package statscollector
@@ -0,0 +1,39 @@
+package statscollector
+
+import (
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Type Sample is the result of one call of GetStats.
+type Sample struct {
+	Time     time.Time        `json:"TIME"`
+	Interval time.Duration    `json:"INTERVAL"`
+	Workload szmodel.Workload `json:"WORKLOAD"`
+	Totals   szmodel.Workload `json:"TOTALS"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+ComponentID is the identifier of the statscollector package used as "subjectId" in observer notifications.
+Notifications sent by [Collector] have these "messageId" values:
+  - 8001: A sample has been collected.
+  - 8002: GetStats failed.
+
+DefaultInterval is the time between calls of GetStats when no interval is given.
+
+DefaultMaxHistory is the number of samples kept when no limit is given.
+*/
+const (
+	ComponentID       = 6011
+	DefaultInterval   = time.Minute
+	DefaultMaxHistory = 60
+)
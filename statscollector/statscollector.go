@@ -0,0 +1,289 @@
+package statscollector
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-observing/notifier"
+	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+/*
+Type Collector struct calls GetStats on an interval and accumulates the workload into running totals.
+Its methods may be called concurrently.
+*/
+type Collector struct {
+	Interval       time.Duration // Time between calls of GetStats by Run. If not positive, DefaultInterval is used.
+	MaxHistory     int           // Number of samples kept. If not positive, DefaultMaxHistory is used.
+	history        []Sample
+	lock           sync.RWMutex
+	now            func() time.Time
+	observerOrigin string
+	observers      subject.Subject
+	previous       time.Time
+	szEngine       senzing.SzEngine
+	totals         szmodel.Workload
+}
+
+// ----------------------------------------------------------------------------
+// Constructor
+// ----------------------------------------------------------------------------
+
+/*
+The New function returns a collector using an engine.
+The interval of the first sample starts when the collector is created.
+
+Input
+  - szEngine: The engine whose statistics are collected.
+
+Output
+  - The collector.
+*/
+func New(szEngine senzing.SzEngine) *Collector {
+	result := &Collector{
+		now:      time.Now,
+		szEngine: szEngine,
+	}
+	result.previous = result.now()
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Collector methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Collect calls GetStats once, adds the workload to the totals and records a sample.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The sample.
+*/
+func (collector *Collector) Collect(ctx context.Context) (*Sample, error) {
+	response, err := collector.szEngine.GetStats(ctx)
+	if err == nil {
+		var stats *szmodel.Stats
+		stats, err = szmodel.DecodeStats(response)
+		if err == nil {
+			sample := collector.add(&stats.Workload)
+			collector.notify(ctx, 8001, nil, map[string]string{
+				"addedRecords":       strconv.FormatInt(sample.Workload.AddedRecords, 10),
+				"loadedRecords":      strconv.FormatInt(sample.Workload.LoadedRecords, 10),
+				"recordsPerSecond":   strconv.FormatFloat(sample.RecordsPerSecond(), 'f', 2, 64),
+				"retries":            strconv.FormatInt(sample.Workload.Retries, 10),
+				"totalLoadedRecords": strconv.FormatInt(sample.Totals.LoadedRecords, 10),
+				"totalRetries":       strconv.FormatInt(sample.Totals.Retries, 10),
+			})
+			return sample, nil
+		}
+	}
+	collector.notify(ctx, 8002, err, map[string]string{})
+	return nil, err
+}
+
+/*
+Method History returns the kept samples, oldest first.
+
+Output
+  - A copy of the kept samples.
+*/
+func (collector *Collector) History() []Sample {
+	collector.lock.RLock()
+	defer collector.lock.RUnlock()
+	result := make([]Sample, len(collector.history))
+	copy(result, collector.history)
+	return result
+}
+
+/*
+Method Latest returns the most recent sample.
+
+Output
+  - The sample, or nil if none has been collected.
+*/
+func (collector *Collector) Latest() *Sample {
+	collector.lock.RLock()
+	defer collector.lock.RUnlock()
+	if len(collector.history) == 0 {
+		return nil
+	}
+	result := collector.history[len(collector.history)-1]
+	return &result
+}
+
+/*
+Method Run calls Collect on the interval until the context is done.
+Failures of GetStats are sent to observers and do not stop the collector.
+
+Input
+  - ctx: A context to control lifecycle. Cancel it to stop the collector.
+*/
+func (collector *Collector) Run(ctx context.Context) {
+	interval := collector.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, _ = collector.Collect(ctx)
+		}
+	}
+}
+
+/*
+Method Totals returns the workload accumulated over all samples.
+
+Output
+  - A copy of the running totals.
+*/
+func (collector *Collector) Totals() szmodel.Workload {
+	collector.lock.RLock()
+	defer collector.lock.RUnlock()
+	return cloneWorkload(&collector.totals)
+}
+
+// ----------------------------------------------------------------------------
+// Observer methods
+// ----------------------------------------------------------------------------
+
+/*
+Method RegisterObserver adds the observer to the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+*/
+func (collector *Collector) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+	if collector.observers == nil {
+		collector.observers = &subject.SimpleSubject{}
+	}
+	return collector.observers.RegisterObserver(ctx, observer)
+}
+
+/*
+Method SetObserverOrigin sets the "origin" value in future Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - origin: The value sent in the Observer's "origin" key/value pair.
+*/
+func (collector *Collector) SetObserverOrigin(ctx context.Context, origin string) {
+	_ = ctx
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+	collector.observerOrigin = origin
+}
+
+/*
+Method UnregisterObserver removes the observer from the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+*/
+func (collector *Collector) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+	var err error
+	if collector.observers != nil {
+		err = collector.observers.UnregisterObserver(ctx, observer)
+		if !collector.observers.HasObservers(ctx) {
+			collector.observers = nil
+		}
+	}
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Sample methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Rate returns a count of the sample per second of its interval.
+
+Input
+  - count: A counter of the sample, such as sample.Workload.Retries.
+
+Output
+  - The count per second, or 0 if the interval is not positive.
+*/
+func (sample *Sample) Rate(count int64) float64 {
+	if sample.Interval <= 0 {
+		return 0
+	}
+	return float64(count) / sample.Interval.Seconds()
+}
+
+/*
+Method RecordsPerSecond returns the records loaded per second during the interval of the sample.
+
+Output
+  - The rate of loadedRecords.
+*/
+func (sample *Sample) RecordsPerSecond() float64 {
+	return sample.Rate(sample.Workload.LoadedRecords)
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (collector *Collector) add(workload *szmodel.Workload) *Sample {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+	now := collector.now()
+	collector.totals.Add(workload)
+	sample := Sample{
+		Time:     now,
+		Interval: now.Sub(collector.previous),
+		Workload: *workload,
+		Totals:   cloneWorkload(&collector.totals),
+	}
+	collector.previous = now
+	maxHistory := collector.MaxHistory
+	if maxHistory <= 0 {
+		maxHistory = DefaultMaxHistory
+	}
+	collector.history = append(collector.history, sample)
+	if len(collector.history) > maxHistory {
+		collector.history = append([]Sample{}, collector.history[len(collector.history)-maxHistory:]...)
+	}
+	return &sample
+}
+
+func (collector *Collector) notify(ctx context.Context, messageID int, err error, details map[string]string) {
+	collector.lock.RLock()
+	observers := collector.observers
+	origin := collector.observerOrigin
+	collector.lock.RUnlock()
+	if observers != nil {
+		go func() {
+			notifier.Notify(ctx, observers, origin, ComponentID, messageID, err, details)
+		}()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Copy a workload so that its counts and lists are not shared.
+func cloneWorkload(workload *szmodel.Workload) szmodel.Workload {
+	result := szmodel.Workload{}
+	result.Add(workload)
+	return result
+}
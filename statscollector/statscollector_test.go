@@ -0,0 +1,204 @@
+package statscollector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const printResults = false
+
+var startTime = time.Date(2025, 2, 1, 12, 0, 0, 0, time.UTC)
+
+// Type mockSzEngine returns queued GetStats responses.
+type mockSzEngine struct {
+	senzing.SzEngine
+	lock      sync.Mutex
+	calls     int
+	responses []string
+}
+
+func (szEngine *mockSzEngine) GetStats(ctx context.Context) (string, error) {
+	_ = ctx
+	szEngine.lock.Lock()
+	defer szEngine.lock.Unlock()
+	szEngine.calls++
+	if len(szEngine.responses) == 0 {
+		return "", errors.New("no statistics")
+	}
+	result := szEngine.responses[0]
+	szEngine.responses = szEngine.responses[1:]
+	return result, nil
+}
+
+// Type channelObserver sends each notification's messageId to a channel.
+type channelObserver struct {
+	messages chan string
+}
+
+func (observer *channelObserver) GetObserverID(ctx context.Context) string {
+	_ = ctx
+	return "channelObserver"
+}
+
+func (observer *channelObserver) UpdateObserver(ctx context.Context, message string) {
+	_ = ctx
+	details := map[string]string{}
+	if err := json.Unmarshal([]byte(message), &details); err == nil {
+		observer.messages <- details["messageId"]
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestCollector_Collect(test *testing.T) {
+	ctx := context.TODO()
+	collector := newTestCollector(test, readStats(test), `{"workload":{"loadedRecords":10,"retries":1,"scoredPairs":[{"DOB":5}],"threadState":{"active":2}}}`)
+	assert.Nil(test, collector.Latest())
+
+	first, err := collector.Collect(ctx)
+	require.NoError(test, err)
+	printActual(test, first)
+	assert.Equal(test, startTime.Add(10*time.Second), first.Time)
+	assert.Equal(test, 10*time.Second, first.Interval)
+	assert.Equal(test, int64(5), first.Workload.LoadedRecords)
+	assert.InDelta(test, 0.5, first.RecordsPerSecond(), 0.0001)
+
+	second, err := collector.Collect(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, 10*time.Second, second.Interval)
+	assert.Equal(test, int64(10), second.Workload.LoadedRecords)
+	assert.Equal(test, int64(15), second.Totals.LoadedRecords)
+	assert.Equal(test, int64(30), second.Totals.ScoredPairs["DOB"])
+	assert.InDelta(test, 0.1, second.Rate(second.Workload.Retries), 0.0001)
+	assert.Equal(test, int64(25), first.Totals.ScoredPairs["DOB"], "earlier samples must not change")
+
+	totals := collector.Totals()
+	assert.Equal(test, int64(15), totals.LoadedRecords)
+	assert.Equal(test, int64(2), totals.ThreadState.Active)
+	totals.ScoredPairs["DOB"] = 0
+	assert.Equal(test, int64(30), collector.Totals().ScoredPairs["DOB"], "totals must be a copy")
+
+	assert.Equal(test, second, collector.Latest())
+	assert.Len(test, collector.History(), 2)
+}
+
+func TestCollector_Collect_error(test *testing.T) {
+	ctx := context.TODO()
+	collector := newTestCollector(test, `{"workload":`)
+	_, err := collector.Collect(ctx)
+	require.Error(test, err)
+	_, err = collector.Collect(ctx)
+	require.Error(test, err)
+	assert.Empty(test, collector.History())
+	assert.Zero(test, collector.Totals().LoadedRecords)
+}
+
+func TestCollector_History_maxHistory(test *testing.T) {
+	ctx := context.TODO()
+	responses := []string{}
+	for i := 1; i <= 5; i++ {
+		responses = append(responses, `{"workload":{"loadedRecords":`+strconv.Itoa(i)+`}}`)
+	}
+	collector := newTestCollector(test, responses...)
+	collector.MaxHistory = 3
+	for range responses {
+		_, err := collector.Collect(ctx)
+		require.NoError(test, err)
+	}
+	history := collector.History()
+	require.Len(test, history, 3)
+	assert.Equal(test, int64(3), history[0].Workload.LoadedRecords)
+	assert.Equal(test, int64(5), history[2].Workload.LoadedRecords)
+	assert.Equal(test, int64(15), history[2].Totals.LoadedRecords)
+}
+
+func TestCollector_RegisterObserver(test *testing.T) {
+	ctx := context.TODO()
+	observer := &channelObserver{messages: make(chan string, 10)}
+	collector := newTestCollector(test, readStats(test))
+	require.NoError(test, collector.RegisterObserver(ctx, observer))
+	collector.SetObserverOrigin(ctx, "Test")
+	_, err := collector.Collect(ctx)
+	require.NoError(test, err)
+	_, err = collector.Collect(ctx)
+	require.Error(test, err)
+	actual := map[string]int{}
+	for i := 0; i < 2; i++ {
+		select {
+		case messageID := <-observer.messages:
+			actual[messageID]++
+		case <-time.After(5 * time.Second):
+			require.FailNow(test, "timed out waiting for notification")
+		}
+	}
+	assert.Equal(test, map[string]int{"8001": 1, "8002": 1}, actual)
+	require.NoError(test, collector.UnregisterObserver(ctx, observer))
+}
+
+func TestCollector_Run(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	szEngine := &mockSzEngine{responses: []string{readStats(test), readStats(test)}}
+	collector := New(szEngine)
+	collector.Interval = time.Millisecond
+	done := make(chan struct{})
+	go func() {
+		collector.Run(ctx)
+		close(done)
+	}()
+	require.Eventually(test, func() bool {
+		return len(collector.History()) == 2
+	}, 5*time.Second, time.Millisecond)
+	require.Eventually(test, func() bool {
+		szEngine.lock.Lock()
+		defer szEngine.lock.Unlock()
+		return szEngine.calls > 2
+	}, 5*time.Second, time.Millisecond, "failures must not stop the collector")
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.FailNow(test, "Run did not return after cancel")
+	}
+	assert.Equal(test, int64(10), collector.Totals().LoadedRecords)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Create a collector whose clock advances 10 seconds on each reading after creation.
+func newTestCollector(test *testing.T, responses ...string) *Collector {
+	_ = test
+	collector := New(&mockSzEngine{responses: responses})
+	now := startTime
+	collector.previous = now
+	collector.now = func() time.Time {
+		now = now.Add(10 * time.Second)
+		return now
+	}
+	return collector
+}
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %+v", actual)
+	}
+}
+
+func readStats(test *testing.T) string {
+	result, err := os.ReadFile("../testdata/responses/get-stats.json")
+	require.NoError(test, err)
+	return string(result)
+}
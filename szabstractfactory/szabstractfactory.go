@@ -2,7 +2,13 @@ package szabstractfactory
 
 import (
 	"context"
+	"log/slog"
 
+	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/sz-sdk-go-core/audit"
+	"github.com/senzing-garage/sz-sdk-go-core/nativelog"
+	"github.com/senzing-garage/sz-sdk-go-core/notification"
+	"github.com/senzing-garage/sz-sdk-go-core/redaction"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
 	"github.com/senzing-garage/sz-sdk-go-core/szdiagnostic"
@@ -17,15 +23,34 @@ Szabstractfactory is an implementation of the [senzing.SzAbstractFactory] interf
 [senzing.SzAbstractFactory]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzAbstractFactory
 */
 type Szabstractfactory struct {
+	AuditLog                     *audit.Log // If not nil, the SzEngine and SzConfigManager objects created record their mutating calls in AuditLog. See package audit.
 	ConfigID                     int64
 	InstanceName                 string
+	Logger                       *slog.Logger      // If not nil, the Sz objects created log to Logger. See package szslog.
+	NativeOutput                 nativelog.Handler // If not nil, receives the output of the Senzing C library until Destroy. See package nativelog.
+	NativeStreams                nativelog.Stream  // Streams captured for NativeOutput. Zero captures nativelog.Stdout only, leaving the standard error of the process alone.
+	Redaction                    *redaction.Policy // If not nil, the Sz objects created redact their errors, logs and notifications. See package redaction.
 	Settings                     string
+	Subscriptions                []notification.Subscription // Observers registered, with their filters, on the Sz objects created. See package notification.
 	VerboseLogging               int64
 	isSzconfigInitialized        bool
 	isSzconfigmanagerInitialized bool
 	isSzdiagnosticInitialized    bool
 	isSzengineInitialized        bool
 	isSzproductInitialized       bool
+	nativeCapture                *nativelog.Capture
+}
+
+// Type configurable is implemented by the Sz objects.
+type configurable interface {
+	RegisterObserverWithFilter(ctx context.Context, observer observer.Observer, filter notification.Filter) error
+	SetLogger(ctx context.Context, logger *slog.Logger)
+	SetRedaction(ctx context.Context, policy *redaction.Policy)
+}
+
+// Type auditable is implemented by the Sz objects making mutating calls.
+type auditable interface {
+	SetAuditLog(ctx context.Context, auditLog *audit.Log)
 }
 
 // ----------------------------------------------------------------------------
@@ -45,6 +70,10 @@ Output
 func (factory *Szabstractfactory) CreateConfig(ctx context.Context) (senzing.SzConfig, error) {
 	var err error
 	result := &szconfig.Szconfig{}
+	err = factory.configure(ctx, result)
+	if err != nil {
+		return result, err
+	}
 	if !factory.isSzconfigInitialized {
 		err = result.Initialize(ctx, factory.InstanceName, factory.Settings, factory.VerboseLogging)
 		if err == nil {
@@ -67,6 +96,10 @@ Output
 func (factory *Szabstractfactory) CreateConfigManager(ctx context.Context) (senzing.SzConfigManager, error) {
 	var err error
 	result := &szconfigmanager.Szconfigmanager{}
+	err = factory.configure(ctx, result)
+	if err != nil {
+		return result, err
+	}
 	if !factory.isSzconfigmanagerInitialized {
 		err = result.Initialize(ctx, factory.InstanceName, factory.Settings, factory.VerboseLogging)
 		if err == nil {
@@ -89,6 +122,10 @@ Output
 func (factory *Szabstractfactory) CreateDiagnostic(ctx context.Context) (senzing.SzDiagnostic, error) {
 	var err error
 	result := &szdiagnostic.Szdiagnostic{}
+	err = factory.configure(ctx, result)
+	if err != nil {
+		return result, err
+	}
 	if !factory.isSzdiagnosticInitialized {
 		err = result.Initialize(ctx, factory.InstanceName, factory.Settings, factory.ConfigID, factory.VerboseLogging)
 		if err == nil {
@@ -111,6 +148,10 @@ Output
 func (factory *Szabstractfactory) CreateEngine(ctx context.Context) (senzing.SzEngine, error) {
 	var err error
 	result := &szengine.Szengine{}
+	err = factory.configure(ctx, result)
+	if err != nil {
+		return result, err
+	}
 	if !factory.isSzengineInitialized {
 		err := result.Initialize(ctx, factory.InstanceName, factory.Settings, factory.ConfigID, factory.VerboseLogging)
 		if err == nil {
@@ -133,6 +174,10 @@ Output
 func (factory *Szabstractfactory) CreateProduct(ctx context.Context) (senzing.SzProduct, error) {
 	var err error
 	result := &szproduct.Szproduct{}
+	err = factory.configure(ctx, result)
+	if err != nil {
+		return result, err
+	}
 	if !factory.isSzproductInitialized {
 		err = result.Initialize(ctx, factory.InstanceName, factory.Settings, factory.VerboseLogging)
 		if err == nil {
@@ -149,10 +194,19 @@ It should be called after all other calls are complete.
 Input
   - ctx: A context to control lifecycle.
 */
-func (factory *Szabstractfactory) Destroy(ctx context.Context) error {
-	var err error
+func (factory *Szabstractfactory) Destroy(ctx context.Context) (err error) {
+	defer func() {
+		if factory.nativeCapture != nil {
+			stopErr := factory.nativeCapture.Stop()
+			factory.nativeCapture = nil
+			if err == nil {
+				err = stopErr
+			}
+		}
+	}()
 	if factory.isSzconfigInitialized {
 		szConfig := &szconfig.Szconfig{}
+		factory.configureLogging(ctx, szConfig)
 		err = szConfig.Destroy(ctx)
 		if err != nil {
 			return err
@@ -161,6 +215,7 @@ func (factory *Szabstractfactory) Destroy(ctx context.Context) error {
 	}
 	if factory.isSzconfigmanagerInitialized {
 		szConfigmanager := &szconfigmanager.Szconfigmanager{}
+		factory.configureLogging(ctx, szConfigmanager)
 		err = szConfigmanager.Destroy(ctx)
 		if err != nil {
 			return err
@@ -169,6 +224,7 @@ func (factory *Szabstractfactory) Destroy(ctx context.Context) error {
 	}
 	if factory.isSzdiagnosticInitialized {
 		szDiagnostic := &szdiagnostic.Szdiagnostic{}
+		factory.configureLogging(ctx, szDiagnostic)
 		err = szDiagnostic.Destroy(ctx)
 		if err != nil {
 			return err
@@ -177,6 +233,7 @@ func (factory *Szabstractfactory) Destroy(ctx context.Context) error {
 	}
 	if factory.isSzengineInitialized {
 		szEngine := &szengine.Szengine{}
+		factory.configureLogging(ctx, szEngine)
 		err = szEngine.Destroy(ctx)
 		if err != nil {
 			return err
@@ -185,6 +242,7 @@ func (factory *Szabstractfactory) Destroy(ctx context.Context) error {
 	}
 	if factory.isSzproductInitialized {
 		szProduct := &szproduct.Szproduct{}
+		factory.configureLogging(ctx, szProduct)
 		err = szProduct.Destroy(ctx)
 		if err != nil {
 			return err
@@ -206,6 +264,7 @@ func (factory *Szabstractfactory) Reinitialize(ctx context.Context, configID int
 	factory.ConfigID = configID
 	if factory.isSzdiagnosticInitialized {
 		szDiagnostic := &szdiagnostic.Szdiagnostic{}
+		factory.configureLogging(ctx, szDiagnostic)
 		err = szDiagnostic.Reinitialize(ctx, configID)
 		if err != nil {
 			return err
@@ -213,6 +272,7 @@ func (factory *Szabstractfactory) Reinitialize(ctx context.Context, configID int
 	}
 	if factory.isSzengineInitialized {
 		szEngine := &szengine.Szengine{}
+		factory.configureLogging(ctx, szEngine)
 		err = szEngine.Reinitialize(ctx, configID)
 		if err != nil {
 			return err
@@ -220,3 +280,47 @@ func (factory *Szabstractfactory) Reinitialize(ctx context.Context, configID int
 	}
 	return err
 }
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Pass the factory's Logger, Redaction and AuditLog, if any, and Subscriptions to a created Sz object, and capture native output if requested.
+func (factory *Szabstractfactory) configure(ctx context.Context, object configurable) error {
+	if factory.NativeOutput != nil && factory.nativeCapture == nil {
+		capture, err := nativelog.Start(ctx, factory.nativeStreams(), factory.NativeOutput)
+		if err != nil {
+			return err
+		}
+		factory.nativeCapture = capture
+	}
+	factory.configureLogging(ctx, object)
+	if audited, isAuditable := object.(auditable); isAuditable && factory.AuditLog != nil {
+		audited.SetAuditLog(ctx, factory.AuditLog)
+	}
+	for _, subscription := range factory.Subscriptions {
+		err := object.RegisterObserverWithFilter(ctx, subscription.Observer, subscription.Filter)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pass the factory's Logger and Redaction, if any, to an Sz object used only to destroy or reinitialize the Senzing objects.
+func (factory *Szabstractfactory) configureLogging(ctx context.Context, object configurable) {
+	if factory.Logger != nil {
+		object.SetLogger(ctx, factory.Logger)
+	}
+	if factory.Redaction != nil {
+		object.SetRedaction(ctx, factory.Redaction)
+	}
+}
+
+// The streams captured for NativeOutput.
+func (factory *Szabstractfactory) nativeStreams() nativelog.Stream {
+	if factory.NativeStreams == 0 {
+		return nativelog.Stdout
+	}
+	return factory.NativeStreams
+}
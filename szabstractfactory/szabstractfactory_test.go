@@ -1,19 +1,32 @@
 package szabstractfactory
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	truncator "github.com/aquilax/truncate"
 	"github.com/senzing-garage/go-helpers/fileutil"
 	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/sz-sdk-go-core/audit"
+	"github.com/senzing-garage/sz-sdk-go-core/dispatcher"
+	"github.com/senzing-garage/sz-sdk-go-core/nativelog"
+	"github.com/senzing-garage/sz-sdk-go-core/notification"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-go-core/szengine"
+	"github.com/senzing-garage/sz-sdk-go-core/szproduct"
+	"github.com/senzing-garage/sz-sdk-go-core/szslog"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,6 +46,35 @@ var (
 // Interface methods - test
 // ----------------------------------------------------------------------------
 
+func TestSzAbstractFactory_AuditLog(test *testing.T) {
+	ctx := context.TODO()
+	path := filepath.Join(test.TempDir(), "audit.jsonl")
+	auditLog, err := audit.Open(path)
+	require.NoError(test, err)
+	settings, err := getSettings()
+	require.NoError(test, err)
+	szAbstractFactory := &Szabstractfactory{
+		AuditLog:       auditLog,
+		ConfigID:       senzing.SzInitializeWithDefaultConfiguration,
+		InstanceName:   instanceName,
+		Settings:       settings,
+		VerboseLogging: verboseLogging,
+	}
+	defer func() { handleError(szAbstractFactory.Destroy(ctx)) }()
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	require.NoError(test, err)
+	configID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	require.NoError(test, szConfigManager.SetDefaultConfigID(ctx, configID))
+	require.NoError(test, auditLog.Close())
+	content, err := os.ReadFile(path)
+	require.NoError(test, err)
+	printActual(test, string(content))
+	summary, err := audit.Verify(bytes.NewReader(content))
+	require.NoError(test, err)
+	assert.Equal(test, int64(1), summary.Entries)
+}
+
 func TestSzAbstractFactory_CreateConfig(test *testing.T) {
 	ctx := context.TODO()
 	szAbstractFactory := getTestObject(ctx, test)
@@ -96,6 +138,72 @@ func TestSzAbstractFactory_Destroy(test *testing.T) {
 	defer func() { handleError(szAbstractFactory.Destroy(ctx)) }()
 }
 
+func TestSzAbstractFactory_Logger(test *testing.T) {
+	ctx := context.TODO()
+	var buffer bytes.Buffer
+	szAbstractFactory := &Szabstractfactory{
+		ConfigID:       senzing.SzInitializeWithDefaultConfiguration,
+		InstanceName:   instanceName,
+		Logger:         slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{Level: szslog.LevelTrace})),
+		VerboseLogging: verboseLogging,
+	}
+	settings, err := getSettings()
+	require.NoError(test, err)
+	szAbstractFactory.Settings = settings
+	defer func() { handleError(szAbstractFactory.Destroy(ctx)) }()
+	product, err := szAbstractFactory.CreateProduct(ctx)
+	require.NoError(test, err)
+	szProduct, isSzproduct := product.(*szproduct.Szproduct)
+	require.True(test, isSzproduct)
+	require.NoError(test, szProduct.SetLogLevel(ctx, "TRACE"))
+	defer func() { require.NoError(test, szProduct.SetLogLevel(ctx, "INFO")) }()
+	_, err = szProduct.GetVersion(ctx)
+	require.NoError(test, err)
+	printActual(test, buffer.String())
+	assert.Contains(test, buffer.String(), `"id":"SZSDK60060011"`)
+	assert.Contains(test, buffer.String(), `"method":"GetVersion"`)
+}
+
+func TestSzAbstractFactory_NativeOutput(test *testing.T) {
+	ctx := context.TODO()
+	var lock sync.Mutex
+	lines := []nativelog.Line{}
+	szAbstractFactory := &Szabstractfactory{
+		ConfigID:     senzing.SzInitializeWithDefaultConfiguration,
+		InstanceName: instanceName,
+		NativeOutput: func(ctx context.Context, line nativelog.Line) {
+			_ = ctx
+			lock.Lock()
+			defer lock.Unlock()
+			lines = append(lines, line)
+		},
+		VerboseLogging: senzing.SzVerboseLogging,
+	}
+	settings, err := getSettings()
+	require.NoError(test, err)
+	szAbstractFactory.Settings = settings
+	_, err = szAbstractFactory.CreateEngine(ctx)
+	require.NoError(test, err)
+	require.NoError(test, szAbstractFactory.Destroy(ctx))
+	lock.Lock()
+	defer lock.Unlock()
+	printActual(test, lines)
+	assert.NotEmpty(test, lines)
+	for _, line := range lines {
+		assert.Equal(test, nativelog.Stdout, line.Stream)
+	}
+	capture, err := nativelog.Start(ctx, nativelog.Stdout, func(context.Context, nativelog.Line) {})
+	require.NoError(test, err)
+	require.NoError(test, capture.Stop())
+}
+
+func TestSzAbstractFactory_NativeOutput_streams(test *testing.T) {
+	szAbstractFactory := &Szabstractfactory{}
+	assert.Equal(test, nativelog.Stdout, szAbstractFactory.nativeStreams())
+	szAbstractFactory.NativeStreams = nativelog.Stdout | nativelog.Stderr
+	assert.Equal(test, nativelog.Stdout|nativelog.Stderr, szAbstractFactory.nativeStreams())
+}
+
 func TestSzAbstractFactory_Reinitialize(test *testing.T) {
 	ctx := context.TODO()
 	szAbstractFactory := getTestObject(ctx, test)
@@ -112,6 +220,98 @@ func TestSzAbstractFactory_Reinitialize(test *testing.T) {
 	require.NoError(test, err)
 }
 
+func TestSzAbstractFactory_Reinitialize_configID(test *testing.T) {
+	ctx := context.TODO()
+	szAbstractFactory := getTestObject(ctx, test)
+	defer func() { handleError(szAbstractFactory.Destroy(ctx)) }()
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	require.NoError(test, err)
+	szConfig, err := szAbstractFactory.CreateConfig(ctx)
+	require.NoError(test, err)
+	configID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	configDefinition, err := szConfigManager.GetConfig(ctx, configID)
+	require.NoError(test, err)
+	configHandle, err := szConfig.ImportConfig(ctx, configDefinition)
+	require.NoError(test, err)
+	_, err = szConfig.AddDataSource(ctx, configHandle, "REINITIALIZE")
+	require.NoError(test, err)
+	newConfigDefinition, err := szConfig.ExportConfig(ctx, configHandle)
+	require.NoError(test, err)
+	require.NoError(test, szConfig.CloseConfig(ctx, configHandle))
+	newConfigID, err := szConfigManager.AddConfig(ctx, newConfigDefinition, "Reinitialize test")
+	require.NoError(test, err)
+
+	// Two engines: the second one is not initialized again by the factory.
+
+	recorder := &messageRecorder{}
+	szEngines := []*szengine.Szengine{}
+	for range 2 {
+		engine, err := szAbstractFactory.CreateEngine(ctx)
+		require.NoError(test, err)
+		szEngine, isSzengine := engine.(*szengine.Szengine)
+		require.True(test, isSzengine)
+		require.NoError(test, szEngine.SetDispatcher(ctx, dispatcher.New(0, dispatcher.Block)))
+		szEngine.SetNotificationDetails(ctx, notification.ConfigID)
+		require.NoError(test, szEngine.RegisterObserver(ctx, recorder))
+		szEngines = append(szEngines, szEngine)
+	}
+	reportedConfigIDs := func() []string {
+		recorder.reset()
+		for _, szEngine := range szEngines {
+			_, err := szEngine.GetActiveConfigID(ctx)
+			require.NoError(test, err)
+			require.NoError(test, szEngine.SetDispatcher(ctx, dispatcher.New(0, dispatcher.Block)))
+		}
+		result := []string{}
+		for _, message := range recorder.decoded() {
+			result = append(result, message[notification.ConfigIDKey])
+		}
+		return result
+	}
+	expected := strconv.FormatInt(configID, 10)
+	assert.Equal(test, []string{expected, expected}, reportedConfigIDs())
+	require.NoError(test, szAbstractFactory.Reinitialize(ctx, newConfigID))
+	expected = strconv.FormatInt(newConfigID, 10)
+	assert.Equal(test, []string{expected, expected}, reportedConfigIDs())
+	require.NoError(test, szAbstractFactory.Reinitialize(ctx, configID))
+}
+
+func TestSzAbstractFactory_Subscriptions(test *testing.T) {
+	ctx := context.TODO()
+	recorder := &messageRecorder{}
+	szAbstractFactory := &Szabstractfactory{
+		ConfigID:     senzing.SzInitializeWithDefaultConfiguration,
+		InstanceName: instanceName,
+		Subscriptions: []notification.Subscription{
+			{Observer: recorder, Filter: notification.All(notification.Components(szproduct.ComponentID), notification.MessageIDs(8004))},
+		},
+		VerboseLogging: verboseLogging,
+	}
+	settings, err := getSettings()
+	require.NoError(test, err)
+	szAbstractFactory.Settings = settings
+	defer func() { handleError(szAbstractFactory.Destroy(ctx)) }()
+	_, err = szAbstractFactory.CreateEngine(ctx)
+	require.NoError(test, err)
+	product, err := szAbstractFactory.CreateProduct(ctx)
+	require.NoError(test, err)
+	szProduct, isSzproduct := product.(*szproduct.Szproduct)
+	require.True(test, isSzproduct)
+	notificationDispatcher := dispatcher.New(0, dispatcher.Block)
+	require.NoError(test, szProduct.SetDispatcher(ctx, notificationDispatcher))
+	_, err = szProduct.GetLicense(ctx)
+	require.NoError(test, err)
+	_, err = szProduct.GetVersion(ctx)
+	require.NoError(test, err)
+	require.NoError(test, notificationDispatcher.Flush(ctx))
+	messages := recorder.received()
+	printActual(test, messages)
+	require.Len(test, messages, 1)
+	assert.Contains(test, messages[0], `"subjectId":"6006"`)
+	assert.Contains(test, messages[0], `"messageId":"8004"`)
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
@@ -335,3 +535,45 @@ func setupSenzingConfiguration() error {
 func teardown() error {
 	return nil
 }
+
+// An observer recording the messages of notifications.
+
+type messageRecorder struct {
+	lock     sync.Mutex
+	messages []string
+}
+
+func (recorder *messageRecorder) GetObserverID(ctx context.Context) string {
+	_ = ctx
+	return "messageRecorder"
+}
+
+func (recorder *messageRecorder) UpdateObserver(ctx context.Context, message string) {
+	_ = ctx
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.messages = append(recorder.messages, message)
+}
+
+func (recorder *messageRecorder) decoded() []map[string]string {
+	result := []map[string]string{}
+	for _, message := range recorder.received() {
+		decoded := map[string]string{}
+		if err := json.Unmarshal([]byte(message), &decoded); err == nil {
+			result = append(result, decoded)
+		}
+	}
+	return result
+}
+
+func (recorder *messageRecorder) received() []string {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	return append([]string{}, recorder.messages...)
+}
+
+func (recorder *messageRecorder) reset() {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.messages = nil
+}
@@ -0,0 +1,24 @@
+/*
+Package szargs builds, parses and validates the JSON list arguments of [senzing.SzEngine] methods.
+
+Methods such as FindNetworkByEntityID, FindNetworkByRecordID, FindPathByEntityID,
+FindPathByRecordID and GetVirtualEntityByRecordID take lists as JSON documents:
+
+  - entity IDs: `{"ENTITIES": [{"ENTITY_ID": 1}, {"ENTITY_ID": 2}]}`
+  - record keys: `{"RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}]}`
+  - data sources: `{"DATA_SOURCES": ["CUSTOMERS", "WATCHLIST"]}`
+
+[EntityIDs], [RecordKeys] and [DataSources] build these documents from Go slices.
+[ParseEntityIDs], [ParseRecordKeys] and [ParseDataSources] read them back,
+returning an [ArgumentError] that locates the first problem, such as "ENTITIES[1].ENTITY_ID".
+[ValidateEntityIDs], [ValidateRecordKeys] and [ValidateDataSources] check an argument by name;
+the Szengine methods use them to reject malformed arguments before calling the Senzing C library.
+
+An empty string is a valid argument meaning "none",
+as with [senzing.SzNoAvoidance] and [senzing.SzNoRequiredDatasources].
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+[senzing.SzNoAvoidance]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzNoAvoidance
+[senzing.SzNoRequiredDatasources]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzNoRequiredDatasources
+*/
+package szargs
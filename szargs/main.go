@@ -0,0 +1,43 @@
+package szargs
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type ArgumentError describes a malformed list argument.
+It matches both ErrInvalidArgument and [szerror.ErrSzBadInput] with errors.Is,
+so callers handle it like the error the Senzing C library would have returned.
+
+[szerror.ErrSzBadInput]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror#ErrSzBadInput
+*/
+type ArgumentError struct {
+	Argument string // Name of the method parameter, such as "avoidEntityIDs". Empty when not known.
+	Path     string // Location of the problem in the document, such as "ENTITIES[1].ENTITY_ID". Empty for the whole document.
+	Reason   string // Description of the problem.
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// ErrInvalidArgument is matched by every ArgumentError.
+var ErrInvalidArgument = errors.New("szargs: invalid argument")
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Keys of the lists and their members in argument documents.
+const (
+	DataSourceKey  = "DATA_SOURCE"
+	DataSourcesKey = "DATA_SOURCES"
+	EntitiesKey    = "ENTITIES"
+	EntityIDKey    = "ENTITY_ID"
+	RecordIDKey    = "RECORD_ID"
+	RecordsKey     = "RECORDS"
+)
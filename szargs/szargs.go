@@ -0,0 +1,331 @@
+package szargs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Builders
+// ----------------------------------------------------------------------------
+
+/*
+The DataSources function builds a list of data sources,
+as used by the requiredDataSources parameter of FindPathByEntityID and FindPathByRecordID.
+
+Input
+  - dataSourceCodes: The data sources.
+
+Output
+  - A JSON document such as `{"DATA_SOURCES": ["CUSTOMERS"]}`,
+    or an empty string, meaning no data sources, if dataSourceCodes is empty.
+*/
+func DataSources(dataSourceCodes []string) string {
+	if len(dataSourceCodes) == 0 {
+		return ""
+	}
+	return marshal(map[string][]string{DataSourcesKey: dataSourceCodes})
+}
+
+/*
+The EntityIDs function builds a list of entities,
+as used by the entityIDs parameter of FindNetworkByEntityID
+and the avoidEntityIDs parameter of FindPathByEntityID.
+
+Input
+  - entityIDs: The unique identifiers of the entities.
+
+Output
+  - A JSON document such as `{"ENTITIES": [{"ENTITY_ID": 1}]}`,
+    or an empty string, meaning no entities, if entityIDs is empty.
+*/
+func EntityIDs(entityIDs []int64) string {
+	if len(entityIDs) == 0 {
+		return ""
+	}
+	entities := make([]map[string]int64, len(entityIDs))
+	for index, entityID := range entityIDs {
+		entities[index] = map[string]int64{EntityIDKey: entityID}
+	}
+	return marshal(map[string][]map[string]int64{EntitiesKey: entities})
+}
+
+/*
+The RecordKeys function builds a list of records,
+as used by the recordKeys parameter of FindNetworkByRecordID and GetVirtualEntityByRecordID
+and the avoidRecordKeys parameter of FindPathByRecordID.
+
+Input
+  - recordKeys: The data sources and record IDs of the records.
+
+Output
+  - A JSON document such as `{"RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}]}`,
+    or an empty string, meaning no records, if recordKeys is empty.
+*/
+func RecordKeys(recordKeys []szmodel.RecordKey) string {
+	if len(recordKeys) == 0 {
+		return ""
+	}
+	return marshal(map[string][]szmodel.RecordKey{RecordsKey: recordKeys})
+}
+
+// ----------------------------------------------------------------------------
+// Parsers
+// ----------------------------------------------------------------------------
+
+/*
+The ParseDataSources function reads a list of data sources built by DataSources or by hand.
+
+Input
+  - document: The JSON document. An empty string is an empty list.
+
+Output
+  - The data sources.
+  - An *ArgumentError if the document is malformed.
+*/
+func ParseDataSources(document string) ([]string, error) {
+	members, err := parseList(document, DataSourcesKey)
+	if err != nil || members == nil {
+		return []string{}, err
+	}
+	result := make([]string, len(members))
+	for index, member := range members {
+		path := fmt.Sprintf("%s[%d]", DataSourcesKey, index)
+		if result[index], err = parseString(member, path); err != nil {
+			return []string{}, err
+		}
+	}
+	return result, nil
+}
+
+/*
+The ParseEntityIDs function reads a list of entities built by EntityIDs or by hand.
+
+Input
+  - document: The JSON document. An empty string is an empty list.
+
+Output
+  - The unique identifiers of the entities.
+  - An *ArgumentError if the document is malformed.
+*/
+func ParseEntityIDs(document string) ([]int64, error) {
+	members, err := parseList(document, EntitiesKey)
+	if err != nil || members == nil {
+		return []int64{}, err
+	}
+	result := make([]int64, len(members))
+	for index, member := range members {
+		path := fmt.Sprintf("%s[%d]", EntitiesKey, index)
+		fields, err := parseObject(member, path)
+		if err != nil {
+			return []int64{}, err
+		}
+		path += "." + EntityIDKey
+		value, isFound := fields[EntityIDKey]
+		if !isFound {
+			return []int64{}, &ArgumentError{Path: path, Reason: "is missing"}
+		}
+		if err := json.Unmarshal(value, &result[index]); err != nil || isNull(value) {
+			return []int64{}, &ArgumentError{Path: path, Reason: "must be an integer"}
+		}
+	}
+	return result, nil
+}
+
+/*
+The ParseRecordKeys function reads a list of records built by RecordKeys or by hand.
+
+Input
+  - document: The JSON document. An empty string is an empty list.
+
+Output
+  - The data sources and record IDs of the records.
+  - An *ArgumentError if the document is malformed.
+*/
+func ParseRecordKeys(document string) ([]szmodel.RecordKey, error) {
+	members, err := parseList(document, RecordsKey)
+	if err != nil || members == nil {
+		return []szmodel.RecordKey{}, err
+	}
+	result := make([]szmodel.RecordKey, len(members))
+	for index, member := range members {
+		path := fmt.Sprintf("%s[%d]", RecordsKey, index)
+		fields, err := parseObject(member, path)
+		if err != nil {
+			return []szmodel.RecordKey{}, err
+		}
+		for _, field := range []struct {
+			key    string
+			target *string
+		}{
+			{DataSourceKey, &result[index].DataSource},
+			{RecordIDKey, &result[index].RecordID},
+		} {
+			value, isFound := fields[field.key]
+			if !isFound {
+				return []szmodel.RecordKey{}, &ArgumentError{Path: path + "." + field.key, Reason: "is missing"}
+			}
+			if *field.target, err = parseString(value, path+"."+field.key); err != nil {
+				return []szmodel.RecordKey{}, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Validators
+// ----------------------------------------------------------------------------
+
+/*
+The ValidateDataSources function checks a list of data sources passed as a method argument.
+
+Input
+  - argument: The name of the method parameter, such as "requiredDataSources".
+  - document: The JSON document. An empty string is valid.
+
+Output
+  - nil, or an *ArgumentError naming the argument.
+*/
+func ValidateDataSources(argument string, document string) error {
+	_, err := ParseDataSources(document)
+	return withArgument(argument, err)
+}
+
+/*
+The ValidateEntityIDs function checks a list of entities passed as a method argument.
+Only the form of the document is checked; entity IDs that do not exist are left to Senzing.
+
+Input
+  - argument: The name of the method parameter, such as "avoidEntityIDs".
+  - document: The JSON document. An empty string is valid.
+
+Output
+  - nil, or an *ArgumentError naming the argument.
+*/
+func ValidateEntityIDs(argument string, document string) error {
+	_, err := ParseEntityIDs(document)
+	return withArgument(argument, err)
+}
+
+/*
+The ValidateRecordKeys function checks a list of records passed as a method argument.
+Only the form of the document is checked; unknown data sources and records are left to Senzing.
+
+Input
+  - argument: The name of the method parameter, such as "avoidRecordKeys".
+  - document: The JSON document. An empty string is valid.
+
+Output
+  - nil, or an *ArgumentError naming the argument.
+*/
+func ValidateRecordKeys(argument string, document string) error {
+	_, err := ParseRecordKeys(document)
+	return withArgument(argument, err)
+}
+
+// ----------------------------------------------------------------------------
+// ArgumentError methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Error describes the problem, such as
+"szargs: invalid avoidEntityIDs: ENTITIES[1].ENTITY_ID must be an integer".
+
+Output
+  - The description.
+*/
+func (argumentError *ArgumentError) Error() string {
+	var builder strings.Builder
+	builder.WriteString("szargs: invalid ")
+	if len(argumentError.Argument) > 0 {
+		builder.WriteString(argumentError.Argument)
+	} else {
+		builder.WriteString("argument")
+	}
+	builder.WriteString(": ")
+	if len(argumentError.Path) > 0 {
+		builder.WriteString(argumentError.Path)
+		builder.WriteString(" ")
+	}
+	builder.WriteString(argumentError.Reason)
+	return builder.String()
+}
+
+/*
+Method Unwrap returns the errors matched by an ArgumentError.
+
+Output
+  - ErrInvalidArgument and szerror.ErrSzBadInput.
+*/
+func (argumentError *ArgumentError) Unwrap() []error {
+	return []error{ErrInvalidArgument, szerror.ErrSzBadInput}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func isNull(value json.RawMessage) bool {
+	return string(bytes.TrimSpace(value)) == "null"
+}
+
+func marshal(value interface{}) string {
+	result, err := json.Marshal(value)
+	if err != nil {
+		panic(err) // Maps of strings and integers always marshal.
+	}
+	return string(result)
+}
+
+// Return the members of the list under key, or nil for an empty document.
+func parseList(document string, key string) ([]json.RawMessage, error) {
+	if len(strings.TrimSpace(document)) == 0 {
+		return nil, nil
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(document), &fields); err != nil {
+		return nil, &ArgumentError{Reason: fmt.Sprintf("must be a JSON object with a %s list: %v", key, err)}
+	}
+	value, isFound := fields[key]
+	if !isFound {
+		return nil, &ArgumentError{Path: key, Reason: "is missing"}
+	}
+	result := []json.RawMessage{}
+	if err := json.Unmarshal(value, &result); err != nil || result == nil {
+		return nil, &ArgumentError{Path: key, Reason: "must be a list"}
+	}
+	return result, nil
+}
+
+func parseObject(value json.RawMessage, path string) (map[string]json.RawMessage, error) {
+	result := map[string]json.RawMessage{}
+	if err := json.Unmarshal(value, &result); err != nil || result == nil {
+		return nil, &ArgumentError{Path: path, Reason: "must be an object"}
+	}
+	return result, nil
+}
+
+func parseString(value json.RawMessage, path string) (string, error) {
+	var result string
+	if err := json.Unmarshal(value, &result); err != nil {
+		return "", &ArgumentError{Path: path, Reason: "must be a string"}
+	}
+	if len(result) == 0 {
+		return "", &ArgumentError{Path: path, Reason: "must not be empty"}
+	}
+	return result, nil
+}
+
+// Name the argument of an ArgumentError.
+func withArgument(argument string, err error) error {
+	if argumentError, ok := err.(*ArgumentError); ok {
+		argumentError.Argument = argument
+	}
+	return err
+}
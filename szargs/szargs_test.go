@@ -0,0 +1,164 @@
+package szargs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const printResults = false
+
+// Malformed arguments, as used by the szengine tests.
+const (
+	badAvoidEntityIDs      = "}{"
+	badAvoidRecordKeys     = "}{"
+	badRequiredDataSources = "}{"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzargs_DataSources(test *testing.T) {
+	actual := DataSources([]string{"CUSTOMERS", "WATCHLIST"})
+	printActual(test, actual)
+	assert.JSONEq(test, `{"DATA_SOURCES": ["CUSTOMERS", "WATCHLIST"]}`, actual)
+	assert.Equal(test, senzing.SzNoRequiredDatasources, DataSources(nil))
+}
+
+func TestSzargs_EntityIDs(test *testing.T) {
+	actual := EntityIDs([]int64{1, 2, 3})
+	printActual(test, actual)
+	assert.JSONEq(test, `{"ENTITIES": [{"ENTITY_ID": 1}, {"ENTITY_ID": 2}, {"ENTITY_ID": 3}]}`, actual)
+	assert.Equal(test, senzing.SzNoAvoidance, EntityIDs([]int64{}))
+}
+
+func TestSzargs_RecordKeys(test *testing.T) {
+	actual := RecordKeys([]szmodel.RecordKey{{DataSource: "CUSTOMERS", RecordID: "1001"}, {DataSource: "WATCHLIST", RecordID: `"quoted"`}})
+	printActual(test, actual)
+	assert.JSONEq(test, `{"RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}, {"DATA_SOURCE": "WATCHLIST", "RECORD_ID": "\"quoted\""}]}`, actual)
+	assert.Equal(test, senzing.SzNoAvoidance, RecordKeys(nil))
+}
+
+func TestSzargs_ParseDataSources(test *testing.T) {
+	expected := []string{"CUSTOMERS", "WATCHLIST"}
+	actual, err := ParseDataSources(DataSources(expected))
+	require.NoError(test, err)
+	assert.Equal(test, expected, actual)
+	actual, err = ParseDataSources(senzing.SzNoRequiredDatasources)
+	require.NoError(test, err)
+	assert.Empty(test, actual)
+}
+
+func TestSzargs_ParseEntityIDs(test *testing.T) {
+	expected := []int64{1, 20, 300}
+	actual, err := ParseEntityIDs(EntityIDs(expected))
+	require.NoError(test, err)
+	assert.Equal(test, expected, actual)
+	actual, err = ParseEntityIDs(`{"ENTITIES": [{"ENTITY_ID": 0, "NOTE": "unknown IDs are left to Senzing"}]}`)
+	require.NoError(test, err)
+	assert.Equal(test, []int64{0}, actual)
+}
+
+func TestSzargs_ParseRecordKeys(test *testing.T) {
+	expected := []szmodel.RecordKey{{DataSource: "CUSTOMERS", RecordID: "1001"}, {DataSource: "REFERENCE", RecordID: "2012"}}
+	actual, err := ParseRecordKeys(RecordKeys(expected))
+	require.NoError(test, err)
+	assert.Equal(test, expected, actual)
+}
+
+func TestSzargs_Parse_malformed(test *testing.T) {
+	testCases := []struct {
+		name     string
+		parse    func(string) error
+		document string
+		path     string
+		reason   string
+	}{
+		{name: "entities not JSON", parse: parseEntityIDs, document: badAvoidEntityIDs, reason: "must be a JSON object"},
+		{name: "entities list missing", parse: parseEntityIDs, document: `{"RECORDS": []}`, path: "ENTITIES", reason: "is missing"},
+		{name: "entities not a list", parse: parseEntityIDs, document: `{"ENTITIES": {"ENTITY_ID": 1}}`, path: "ENTITIES", reason: "must be a list"},
+		{name: "entities null", parse: parseEntityIDs, document: `{"ENTITIES": null}`, path: "ENTITIES", reason: "must be a list"},
+		{name: "entity not an object", parse: parseEntityIDs, document: `{"ENTITIES": [{"ENTITY_ID": 1}, 2]}`, path: "ENTITIES[1]", reason: "must be an object"},
+		{name: "entity ID missing", parse: parseEntityIDs, document: `{"ENTITIES": [{"ENTITYID": 1}]}`, path: "ENTITIES[0].ENTITY_ID", reason: "is missing"},
+		{name: "entity ID string", parse: parseEntityIDs, document: `{"ENTITIES": [{"ENTITY_ID": "1"}]}`, path: "ENTITIES[0].ENTITY_ID", reason: "must be an integer"},
+		{name: "entity ID fraction", parse: parseEntityIDs, document: `{"ENTITIES": [{"ENTITY_ID": 1.5}]}`, path: "ENTITIES[0].ENTITY_ID", reason: "must be an integer"},
+		{name: "entity ID null", parse: parseEntityIDs, document: `{"ENTITIES": [{"ENTITY_ID": null}]}`, path: "ENTITIES[0].ENTITY_ID", reason: "must be an integer"},
+		{name: "records not JSON", parse: parseRecordKeys, document: badAvoidRecordKeys, reason: "must be a JSON object"},
+		{name: "record data source missing", parse: parseRecordKeys, document: `{"RECORDS": [{"RECORD_ID": "1001"}]}`, path: "RECORDS[0].DATA_SOURCE", reason: "is missing"},
+		{name: "record ID number", parse: parseRecordKeys, document: `{"RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": 1001}]}`, path: "RECORDS[0].RECORD_ID", reason: "must be a string"},
+		{name: "record ID empty", parse: parseRecordKeys, document: `{"RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": ""}]}`, path: "RECORDS[0].RECORD_ID", reason: "must not be empty"},
+		{name: "data sources not JSON", parse: parseDataSources, document: badRequiredDataSources, reason: "must be a JSON object"},
+		{name: "data sources not a list", parse: parseDataSources, document: `{"DATA_SOURCES": "CUSTOMERS"}`, path: "DATA_SOURCES", reason: "must be a list"},
+		{name: "data source object", parse: parseDataSources, document: `{"DATA_SOURCES": ["CUSTOMERS", {"DSRC_CODE": "WATCHLIST"}]}`, path: "DATA_SOURCES[1]", reason: "must be a string"},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			err := testCase.parse(testCase.document)
+			printActual(test, err)
+			var argumentError *ArgumentError
+			require.ErrorAs(test, err, &argumentError)
+			assert.Equal(test, testCase.path, argumentError.Path)
+			assert.Contains(test, argumentError.Reason, testCase.reason)
+			assert.ErrorIs(test, err, ErrInvalidArgument)
+			assert.ErrorIs(test, err, szerror.ErrSzBadInput)
+		})
+	}
+}
+
+func TestSzargs_ValidateDataSources(test *testing.T) {
+	require.NoError(test, ValidateDataSources("requiredDataSources", senzing.SzNoRequiredDatasources))
+	require.NoError(test, ValidateDataSources("requiredDataSources", `{"DATA_SOURCES": ["CUSTOMERS"]}`))
+	err := ValidateDataSources("requiredDataSources", badRequiredDataSources)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.Contains(test, err.Error(), "szargs: invalid requiredDataSources: ")
+}
+
+func TestSzargs_ValidateEntityIDs(test *testing.T) {
+	require.NoError(test, ValidateEntityIDs("avoidEntityIDs", senzing.SzNoAvoidance))
+	err := ValidateEntityIDs("avoidEntityIDs", `{"ENTITIES": [{"ENTITY_ID": 1}, {"ENTITY_ID": "2"}]}`)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.Equal(test, "szargs: invalid avoidEntityIDs: ENTITIES[1].ENTITY_ID must be an integer", err.Error())
+}
+
+func TestSzargs_ValidateRecordKeys(test *testing.T) {
+	require.NoError(test, ValidateRecordKeys("recordKeys", `{"RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}]}`))
+	err := ValidateRecordKeys("avoidRecordKeys", `{"RECORDS": [{"DATA_SOURCE": "CUSTOMERS"}]}`)
+	require.ErrorIs(test, err, ErrInvalidArgument)
+	assert.Equal(test, "szargs: invalid avoidRecordKeys: RECORDS[0].RECORD_ID is missing", err.Error())
+}
+
+func TestSzargs_ArgumentError_Error(test *testing.T) {
+	assert.Equal(test, "szargs: invalid argument: is missing", (&ArgumentError{Reason: "is missing"}).Error())
+	assert.False(test, errors.Is(&ArgumentError{}, szerror.ErrSzNotFound))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func parseDataSources(document string) error {
+	_, err := ParseDataSources(document)
+	return err
+}
+
+func parseEntityIDs(document string) error {
+	_, err := ParseEntityIDs(document)
+	return err
+}
+
+func parseRecordKeys(document string) error {
+	_, err := ParseRecordKeys(document)
+	return err
+}
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %+v", actual)
+	}
+}
@@ -20,20 +20,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"runtime"
 	"strconv"
+	"sync"
 	"time"
 	"unsafe"
 
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-messaging/messenger"
-	"github.com/senzing-garage/go-observing/notifier"
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/sz-sdk-go-core/callstats"
+	"github.com/senzing-garage/sz-sdk-go-core/dispatcher"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"github.com/senzing-garage/sz-sdk-go-core/notification"
+	"github.com/senzing-garage/sz-sdk-go-core/redaction"
+	"github.com/senzing-garage/sz-sdk-go-core/szmetrics"
+	"github.com/senzing-garage/sz-sdk-go-core/szslog"
+	"github.com/senzing-garage/sz-sdk-go-core/sztracing"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szconfig"
-	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"go.opentelemetry.io/otel/trace"
 )
 
 /*
@@ -41,11 +50,20 @@ Type Szconfig struct implements the [senzing.SzConfig] interface
 for communicating with the Senzing C binaries.
 */
 type Szconfig struct {
-	isTrace        bool
-	logger         logging.Logging
-	messenger      messenger.Messenger
-	observerOrigin string
-	observers      subject.Subject
+	callStatistics      callstats.Statistics
+	dispatcher          *dispatcher.Dispatcher
+	dispatcherLock      sync.Mutex
+	filterLock          sync.RWMutex
+	filters             map[string]notification.Filter
+	isTrace             bool
+	logger              logging.Logging
+	messenger           messenger.Messenger
+	metrics             *szmetrics.Metrics
+	notificationDetails notification.Details
+	observerOrigin      string
+	observers           subject.Subject
+	redaction           *redaction.Policy
+	tracer              trace.Tracer
 }
 
 const (
@@ -74,22 +92,28 @@ Output
 func (client *Szconfig) AddDataSource(ctx context.Context, configHandle uintptr, dataSourceCode string) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(1, configHandle, dataSourceCode)
 		defer func() {
 			client.traceExit(2, configHandle, dataSourceCode, result, err, time.Since(entryTime))
 		}()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "AddDataSource", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("AddDataSource", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.AddDataSource", sztracing.DataSources(dataSourceCode))
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.addDataSource(ctx, configHandle, dataSourceCode)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"return":         result,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8001, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8001, err); len(recipients) > 0 {
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"return":         result,
+		}
+		client.notify(ctx, recipients, 8001, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -105,17 +129,23 @@ Input
 */
 func (client *Szconfig) CloseConfig(ctx context.Context, configHandle uintptr) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(5, configHandle)
 		defer func() { client.traceExit(6, configHandle, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "CloseConfig", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("CloseConfig", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.CloseConfig")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.close(ctx, configHandle)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8002, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8002, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8002, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -136,17 +166,23 @@ Output
 func (client *Szconfig) CreateConfig(ctx context.Context) (uintptr, error) {
 	var err error
 	var result uintptr
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(7)
 		defer func() { client.traceExit(8, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "CreateConfig", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("CreateConfig", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.CreateConfig")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.create(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8003, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8003, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8003, err, details, entryTime, notification.NoResult)
 	}
 	return result, err
 }
@@ -162,19 +198,25 @@ Input
 */
 func (client *Szconfig) DeleteDataSource(ctx context.Context, configHandle uintptr, dataSourceCode string) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(9, configHandle, dataSourceCode)
 		defer func() { client.traceExit(10, configHandle, dataSourceCode, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "DeleteDataSource", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("DeleteDataSource", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.DeleteDataSource", sztracing.DataSources(dataSourceCode))
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.deleteDataSource(ctx, configHandle, dataSourceCode)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8004, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8004, err); len(recipients) > 0 {
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+		}
+		client.notify(ctx, recipients, 8004, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -193,17 +235,23 @@ Output
 func (client *Szconfig) ExportConfig(ctx context.Context, configHandle uintptr) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(13, configHandle)
 		defer func() { client.traceExit(14, configHandle, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "ExportConfig", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("ExportConfig", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.ExportConfig")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.save(ctx, configHandle)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8006, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8006, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8006, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -222,17 +270,23 @@ Output
 func (client *Szconfig) GetDataSources(ctx context.Context, configHandle uintptr) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(15, configHandle)
 		defer func() { client.traceExit(16, configHandle, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetDataSources", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetDataSources", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.GetDataSources")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.listDataSources(ctx, configHandle)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8008, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8008, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8008, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -253,17 +307,23 @@ Output
 func (client *Szconfig) ImportConfig(ctx context.Context, configDefinition string) (uintptr, error) {
 	var err error
 	var result uintptr
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(21, configDefinition)
 		defer func() { client.traceExit(22, configDefinition, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "ImportConfig", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("ImportConfig", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.ImportConfig")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.load(ctx, configDefinition)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8009, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8009, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8009, err, details, entryTime, notification.NoResult)
 	}
 	return result, err
 }
@@ -281,21 +341,49 @@ Input
 */
 func (client *Szconfig) Destroy(ctx context.Context) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(11)
 		defer func() { client.traceExit(12, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Destroy", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Destroy", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.Destroy")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.destroy(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8005, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8005, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8005, err, details, entryTime, notification.NoResult)
+	}
+	flushErr := client.getDispatcher().Flush(ctx)
+	if err == nil {
+		err = flushErr
 	}
 	return err
 }
 
+/*
+Method GetCallStatistics returns the number of calls, failures and latencies of each method
+called since the Szconfig was created or ResetCallStatistics was called.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The statistics of the component, which callstats.Write prints as a table.
+*/
+func (client *Szconfig) GetCallStatistics(ctx context.Context) callstats.Report {
+	_ = ctx
+	return callstats.Report{
+		ComponentID: ComponentID,
+		Methods:     client.callStatistics.Snapshot(),
+	}
+}
+
 /*
 Method GetObserverOrigin returns the "origin" value of past Observer messages.
 
@@ -322,21 +410,27 @@ Input
 */
 func (client *Szconfig) Initialize(ctx context.Context, instanceName string, settings string, verboseLogging int64) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(23, instanceName, settings, verboseLogging)
 		defer func() { client.traceExit(24, instanceName, settings, verboseLogging, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Initialize", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Initialize", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.Initialize")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.init(ctx, instanceName, settings, verboseLogging)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"instanceName":   instanceName,
-				"settings":       settings,
-				"verboseLogging": strconv.FormatInt(verboseLogging, baseTen),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8007, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8007, err); len(recipients) > 0 {
+		details := map[string]string{
+			"instanceName":   instanceName,
+			"settings":       settings,
+			"verboseLogging": strconv.FormatInt(verboseLogging, baseTen),
+		}
+		client.notify(ctx, recipients, 8007, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -350,26 +444,79 @@ Input
 */
 func (client *Szconfig) RegisterObserver(ctx context.Context, observer observer.Observer) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(703, observer.GetObserverID(ctx))
 		defer func() { client.traceExit(704, observer.GetObserverID(ctx), err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "RegisterObserver", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("RegisterObserver", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.RegisterObserver")
+		defer sztracing.End(ctx, &err)
+	}
 	if client.observers == nil {
 		client.observers = &subject.SimpleSubject{}
 	}
 	err = client.observers.RegisterObserver(ctx, observer)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"observerID": observer.GetObserverID(ctx),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8702, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8702, err); len(recipients) > 0 {
+		details := map[string]string{
+			"observerID": observer.GetObserverID(ctx),
+		}
+		client.notify(ctx, recipients, 8702, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
 
+/*
+Method RegisterObserverWithFilter adds the observer to the list of observers notified,
+sending it only the notifications accepted by the filter.
+The filter is applied before the details of a notification are gathered,
+so rejected notifications cost the observer nothing.
+Registering an observer again replaces its filter.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+  - filter: The notifications sent to the observer, such as notification.MessageIDs(8001) or notification.Errors. nil for all.
+*/
+func (client *Szconfig) RegisterObserverWithFilter(ctx context.Context, observer observer.Observer, filter notification.Filter) error {
+	client.setFilter(ctx, observer, filter)
+	return client.RegisterObserver(ctx, observer)
+}
+
+/*
+Method ResetCallStatistics forgets the calls counted by GetCallStatistics.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szconfig) ResetCallStatistics(ctx context.Context) {
+	_ = ctx
+	client.callStatistics.Reset()
+}
+
+/*
+Method SetDispatcher sets the dispatcher delivering future notifications to observers,
+then waits until the notifications queued by the previous dispatcher are delivered.
+
+Input
+  - ctx: A context to control lifecycle.
+  - notificationDispatcher: The dispatcher. nil restores a default one, with a blocking queue of dispatcher.DefaultCapacity.
+*/
+func (client *Szconfig) SetDispatcher(ctx context.Context, notificationDispatcher *dispatcher.Dispatcher) error {
+	client.dispatcherLock.Lock()
+	previous := client.dispatcher
+	client.dispatcher = notificationDispatcher
+	client.dispatcherLock.Unlock()
+	if previous == nil {
+		return nil
+	}
+	return previous.Flush(ctx)
+}
+
 /*
 Method SetLogLevel sets the level of logging.
 
@@ -379,27 +526,77 @@ Input
 */
 func (client *Szconfig) SetLogLevel(ctx context.Context, logLevelName string) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(705, logLevelName)
 		defer func() { client.traceExit(706, logLevelName, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "SetLogLevel", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("SetLogLevel", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.SetLogLevel")
+		defer sztracing.End(ctx, &err)
+	}
 	if !logging.IsValidLogLevelName(logLevelName) {
-		return fmt.Errorf("invalid error level: %s", logLevelName)
+		err = fmt.Errorf("invalid error level: %s", logLevelName)
+		return err
 	}
 	err = client.getLogger().SetLogLevel(logLevelName)
 	client.isTrace = (logLevelName == logging.LevelTraceName)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"logLevelName": logLevelName,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8703, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8703, err); len(recipients) > 0 {
+		details := map[string]string{
+			"logLevelName": logLevelName,
+		}
+		client.notify(ctx, recipients, 8703, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
 
+/*
+Method SetLogger writes future log messages to an slog logger, keeping the current log level.
+
+Input
+  - ctx: A context to control lifecycle.
+  - logger: Where log messages are written, as described in package szslog. nil restores the default logging.
+*/
+func (client *Szconfig) SetLogger(ctx context.Context, logger *slog.Logger) {
+	_ = ctx
+	logLevelName := client.getLogger().GetLogLevel()
+	if logger == nil {
+		client.logger = helper.GetLogger(ComponentID, szconfig.IDMessages, baseCallerSkip)
+	} else {
+		client.logger = szslog.New(ComponentID, szconfig.IDMessages, logger)
+	}
+	client.panicOnError(client.logger.SetLogLevel(logLevelName))
+}
+
+/*
+Method SetMetrics records future method calls in metrics.
+
+Input
+  - ctx: A context to control lifecycle.
+  - metrics: Where calls are recorded. nil stops recording.
+*/
+func (client *Szconfig) SetMetrics(ctx context.Context, metrics *szmetrics.Metrics) {
+	_ = ctx
+	client.metrics = metrics
+}
+
+/*
+Method SetNotificationDetails chooses the optional details added to future Observer messages,
+as described in the notification package.
+
+Input
+  - ctx: A context to control lifecycle.
+  - details: The optional details, such as notification.Duration|notification.RequestID. notification.NoDetails, the default, adds none.
+*/
+func (client *Szconfig) SetNotificationDetails(ctx context.Context, details notification.Details) {
+	_ = ctx
+	client.notificationDetails = details
+}
+
 /*
 Method SetObserverOrigin sets the "origin" value in future Observer messages.
 
@@ -412,6 +609,31 @@ func (client *Szconfig) SetObserverOrigin(ctx context.Context, origin string) {
 	client.observerOrigin = origin
 }
 
+/*
+Method SetRedaction sets the policy masking personal data and secrets
+in future errors, trace logs and Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - policy: What is redacted, as described in the redaction package. nil redacts nothing.
+*/
+func (client *Szconfig) SetRedaction(ctx context.Context, policy *redaction.Policy) {
+	_ = ctx
+	client.redaction = policy
+}
+
+/*
+Method SetTracer starts a span around each future method call.
+
+Input
+  - ctx: A context to control lifecycle.
+  - tracer: The tracer starting the spans, such as sztracing.Tracer(otel.GetTracerProvider()). nil stops tracing.
+*/
+func (client *Szconfig) SetTracer(ctx context.Context, tracer trace.Tracer) {
+	_ = ctx
+	client.tracer = tracer
+}
+
 /*
 Method UnregisterObserver removes the observer to the list of observers notified.
 
@@ -421,21 +643,30 @@ Input
 */
 func (client *Szconfig) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(707, observer.GetObserverID(ctx))
 		defer func() { client.traceExit(708, observer.GetObserverID(ctx), err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "UnregisterObserver", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("UnregisterObserver", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfig.UnregisterObserver")
+		defer sztracing.End(ctx, &err)
+	}
 	if client.observers != nil {
-		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
-		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
-		details := map[string]string{
-			"observerID": observer.GetObserverID(ctx),
+		// The notification is queued for the observers registered before this one is removed,
+		// so the observer being removed is notified too.
+		if recipients := client.getRecipients(ctx, 8704, err); len(recipients) > 0 {
+			details := map[string]string{
+				"observerID": observer.GetObserverID(ctx),
+			}
+			client.notify(ctx, recipients, 8704, err, details, entryTime, notification.NoResult)
 		}
-		notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8704, err, details)
 		err = client.observers.UnregisterObserver(ctx, observer)
+		client.setFilter(ctx, observer, nil)
 		if !client.observers.HasObservers(ctx) {
 			client.observers = nil
 		}
@@ -575,6 +806,73 @@ func (client *Szconfig) init(ctx context.Context, instanceName string, settings
 // Internal methods
 // ----------------------------------------------------------------------------
 
+// --- Observing --------------------------------------------------------------
+
+// Get the notification dispatcher, creating a default one on first use.
+func (client *Szconfig) getDispatcher() *dispatcher.Dispatcher {
+	client.dispatcherLock.Lock()
+	defer client.dispatcherLock.Unlock()
+	if client.dispatcher == nil {
+		client.dispatcher = dispatcher.New(dispatcher.DefaultCapacity, dispatcher.Block)
+	}
+	return client.dispatcher
+}
+
+// Get the observers whose filters accept a notification. Empty if there are none.
+func (client *Szconfig) getRecipients(ctx context.Context, messageID int, err error) []observer.Observer {
+	if client.observers == nil {
+		return nil
+	}
+	observers := client.observers.GetObservers(ctx)
+	client.filterLock.RLock()
+	defer client.filterLock.RUnlock()
+	if len(client.filters) == 0 {
+		return observers
+	}
+	event := notification.Event{
+		ComponentID: ComponentID,
+		MessageID:   messageID,
+		Err:         err,
+	}
+	result := make([]observer.Observer, 0, len(observers))
+	for _, registered := range observers {
+		filter, isFiltered := client.filters[registered.GetObserverID(ctx)]
+		if !isFiltered || filter(event) {
+			result = append(result, registered)
+		}
+	}
+	return result
+}
+
+// Send a message to the recipients, with the optional details chosen by SetNotificationDetails.
+func (client *Szconfig) notify(ctx context.Context, recipients []observer.Observer, messageID int, err error, details map[string]string, entryTime time.Time, resultSize int) {
+	client.redaction.Details(details)
+	if client.notificationDetails != notification.NoDetails {
+		call := notification.Call{
+			EntryTime:  entryTime,
+			Err:        err,
+			ResultSize: resultSize,
+		}
+		notification.Add(ctx, details, client.notificationDetails, call)
+	}
+	client.getDispatcher().DispatchTo(ctx, recipients, client.observerOrigin, ComponentID, messageID, err, details)
+}
+
+// Set the filter of an observer. nil removes it.
+func (client *Szconfig) setFilter(ctx context.Context, registered observer.Observer, filter notification.Filter) {
+	observerID := registered.GetObserverID(ctx)
+	client.filterLock.Lock()
+	defer client.filterLock.Unlock()
+	if filter == nil {
+		delete(client.filters, observerID)
+		return
+	}
+	if client.filters == nil {
+		client.filters = map[string]notification.Filter{}
+	}
+	client.filters[observerID] = filter
+}
+
 // --- Logging ----------------------------------------------------------------
 
 // Get the Logger singleton.
@@ -595,12 +893,12 @@ func (client *Szconfig) getMessenger() messenger.Messenger {
 
 // Trace method entry.
 func (client *Szconfig) traceEntry(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+	client.getLogger().Log(errorNumber, client.redaction.Values(details)...)
 }
 
 // Trace method exit.
 func (client *Szconfig) traceExit(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+	client.getLogger().Log(errorNumber, client.redaction.Values(details)...)
 }
 
 // --- Errors -----------------------------------------------------------------
@@ -613,11 +911,14 @@ func (client *Szconfig) newError(ctx context.Context, errorNumber int, details .
 	if err != nil {
 		lastException = err.Error()
 	}
+	lastException = client.redaction.Redact(lastException)
+	details = client.redaction.Values(details)
+	parameters := details
 	details = append(details, messenger.MessageCode{Value: fmt.Sprintf(ExceptionCodeTemplate, lastExceptionCode)})
 	details = append(details, messenger.MessageReason{Value: lastException})
 	details = append(details, errors.New(lastException))
 	errorMessage := client.getMessenger().NewJSON(errorNumber, details...)
-	return szerror.New(lastExceptionCode, errorMessage)
+	return nativeerror.New(ComponentID, errorNumber, szconfig.IDMessages, lastExceptionCode, lastException, errorMessage, parameters...)
 }
 
 /*
@@ -643,8 +944,8 @@ Input
 func (client *Szconfig) clearLastException(ctx context.Context) error {
 	_ = ctx
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(3)
 		defer func() { client.traceExit(4, err, time.Since(entryTime)) }()
 	}
@@ -665,8 +966,8 @@ func (client *Szconfig) getLastException(ctx context.Context) (string, error) {
 	_ = ctx
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(17)
 		defer func() { client.traceExit(18, result, err, time.Since(entryTime)) }()
 	}
@@ -689,8 +990,8 @@ func (client *Szconfig) getLastExceptionCode(ctx context.Context) (int, error) {
 	_ = ctx
 	var err error
 	var result int
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(19)
 		defer func() { client.traceExit(20, result, err, time.Since(entryTime)) }()
 	}
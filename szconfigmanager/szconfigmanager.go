@@ -20,20 +20,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"runtime"
 	"strconv"
+	"sync"
 	"time"
 	"unsafe"
 
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-messaging/messenger"
-	"github.com/senzing-garage/go-observing/notifier"
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/sz-sdk-go-core/audit"
+	"github.com/senzing-garage/sz-sdk-go-core/callstats"
+	"github.com/senzing-garage/sz-sdk-go-core/dispatcher"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"github.com/senzing-garage/sz-sdk-go-core/notification"
+	"github.com/senzing-garage/sz-sdk-go-core/redaction"
+	"github.com/senzing-garage/sz-sdk-go-core/szmetrics"
+	"github.com/senzing-garage/sz-sdk-go-core/szslog"
+	"github.com/senzing-garage/sz-sdk-go-core/sztracing"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szconfigmanager"
-	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"go.opentelemetry.io/otel/trace"
 )
 
 /*
@@ -41,11 +51,21 @@ Type Szconfigmanager struct implements the [senzing.SzConfigManager] interface
 for communicating with the Senzing C binaries.
 */
 type Szconfigmanager struct {
-	isTrace        bool
-	logger         logging.Logging
-	messenger      messenger.Messenger
-	observerOrigin string
-	observers      subject.Subject
+	auditLog            *audit.Log
+	callStatistics      callstats.Statistics
+	dispatcher          *dispatcher.Dispatcher
+	dispatcherLock      sync.Mutex
+	filterLock          sync.RWMutex
+	filters             map[string]notification.Filter
+	isTrace             bool
+	logger              logging.Logging
+	messenger           messenger.Messenger
+	metrics             *szmetrics.Metrics
+	notificationDetails notification.Details
+	observerOrigin      string
+	observers           subject.Subject
+	redaction           *redaction.Policy
+	tracer              trace.Tracer
 }
 
 const (
@@ -73,21 +93,35 @@ Output
 func (client *Szconfigmanager) AddConfig(ctx context.Context, configDefinition string, configComment string) (int64, error) {
 	var err error
 	var result int64
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(1, configDefinition, configComment)
 		defer func() {
 			client.traceExit(2, configDefinition, configComment, result, err, time.Since(entryTime))
 		}()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "AddConfig", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("AddConfig", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfigmanager.AddConfig")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.addConfig(ctx, configDefinition, configComment)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"configComment": configComment,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8001, err, details)
-		}()
+	if client.auditLog != nil {
+		entry := audit.Entry{
+			ConfigID:  result,
+			Details:   map[string]string{"configComment": configComment},
+			Operation: "AddConfig",
+		}
+		client.appendAudit(ctx, entry, err)
+	}
+	if recipients := client.getRecipients(ctx, 8001, err); len(recipients) > 0 {
+		details := map[string]string{
+			"configComment": configComment,
+		}
+		client.notify(ctx, recipients, 8001, err, details, entryTime, notification.NoResult)
 	}
 	return result, err
 }
@@ -101,17 +135,27 @@ Input
 */
 func (client *Szconfigmanager) Destroy(ctx context.Context) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(5)
 		defer func() { client.traceExit(6, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Destroy", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Destroy", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfigmanager.Destroy")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.destroy(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8002, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8002, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8002, err, details, entryTime, notification.NoResult)
+	}
+	flushErr := client.getDispatcher().Flush(ctx)
+	if err == nil {
+		err = flushErr
 	}
 	return err
 }
@@ -129,17 +173,23 @@ Output
 func (client *Szconfigmanager) GetConfig(ctx context.Context, configID int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(7, configID)
 		defer func() { client.traceExit(8, configID, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetConfig", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetConfig", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfigmanager.GetConfig")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.getConfig(ctx, configID)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8003, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8003, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8003, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -156,17 +206,23 @@ Output
 func (client *Szconfigmanager) GetConfigs(ctx context.Context) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(9)
 		defer func() { client.traceExit(10, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetConfigs", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetConfigs", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfigmanager.GetConfigs")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.getConfigList(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8004, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8004, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8004, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -185,17 +241,23 @@ Output
 func (client *Szconfigmanager) GetDefaultConfigID(ctx context.Context) (int64, error) {
 	var err error
 	var result int64
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(11)
 		defer func() { client.traceExit(12, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetDefaultConfigID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetDefaultConfigID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfigmanager.GetDefaultConfigID")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.getDefaultConfigID(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8005, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8005, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8005, err, details, entryTime, notification.NoResult)
 	}
 	return result, err
 }
@@ -217,19 +279,33 @@ Input
 */
 func (client *Szconfigmanager) ReplaceDefaultConfigID(ctx context.Context, currentDefaultConfigID int64, newDefaultConfigID int64) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(19, currentDefaultConfigID, newDefaultConfigID)
 		defer func() { client.traceExit(20, currentDefaultConfigID, newDefaultConfigID, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "ReplaceDefaultConfigID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("ReplaceDefaultConfigID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfigmanager.ReplaceDefaultConfigID")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.replaceDefaultConfigID(ctx, currentDefaultConfigID, newDefaultConfigID)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"newDefaultConfigID": strconv.FormatInt(newDefaultConfigID, baseTen),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8007, err, details)
-		}()
+	if client.auditLog != nil {
+		entry := audit.Entry{
+			ConfigID:  newDefaultConfigID,
+			Details:   map[string]string{"currentDefaultConfigID": strconv.FormatInt(currentDefaultConfigID, baseTen)},
+			Operation: "ReplaceDefaultConfigID",
+		}
+		client.appendAudit(ctx, entry, err)
+	}
+	if recipients := client.getRecipients(ctx, 8007, err); len(recipients) > 0 {
+		details := map[string]string{
+			"newDefaultConfigID": strconv.FormatInt(newDefaultConfigID, baseTen),
+		}
+		client.notify(ctx, recipients, 8007, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -248,19 +324,29 @@ Input
 */
 func (client *Szconfigmanager) SetDefaultConfigID(ctx context.Context, configID int64) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(21, configID)
 		defer func() { client.traceExit(22, configID, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "SetDefaultConfigID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("SetDefaultConfigID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfigmanager.SetDefaultConfigID")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.setDefaultConfigID(ctx, configID)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"configID": strconv.FormatInt(configID, baseTen),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8008, err, details)
-		}()
+	if client.auditLog != nil {
+		entry := audit.Entry{ConfigID: configID, Operation: "SetDefaultConfigID"}
+		client.appendAudit(ctx, entry, err)
+	}
+	if recipients := client.getRecipients(ctx, 8008, err); len(recipients) > 0 {
+		details := map[string]string{
+			"configID": strconv.FormatInt(configID, baseTen),
+		}
+		client.notify(ctx, recipients, 8008, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -269,6 +355,24 @@ func (client *Szconfigmanager) SetDefaultConfigID(ctx context.Context, configID
 // Public non-interface methods
 // ----------------------------------------------------------------------------
 
+/*
+Method GetCallStatistics returns the number of calls, failures and latencies of each method
+called since the Szconfigmanager was created or ResetCallStatistics was called.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The statistics of the component, which callstats.Write prints as a table.
+*/
+func (client *Szconfigmanager) GetCallStatistics(ctx context.Context) callstats.Report {
+	_ = ctx
+	return callstats.Report{
+		ComponentID: ComponentID,
+		Methods:     client.callStatistics.Snapshot(),
+	}
+}
+
 /*
 Method GetObserverOrigin returns the "origin" value of past Observer messages.
 
@@ -295,21 +399,27 @@ Input
 */
 func (client *Szconfigmanager) Initialize(ctx context.Context, instanceName string, settings string, verboseLogging int64) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(17, instanceName, settings, verboseLogging)
 		defer func() { client.traceExit(18, instanceName, settings, verboseLogging, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Initialize", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Initialize", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfigmanager.Initialize")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.init(ctx, instanceName, settings, verboseLogging)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"instanceName":   instanceName,
-				"settings":       settings,
-				"verboseLogging": strconv.FormatInt(verboseLogging, baseTen),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8006, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8006, err); len(recipients) > 0 {
+		details := map[string]string{
+			"instanceName":   instanceName,
+			"settings":       settings,
+			"verboseLogging": strconv.FormatInt(verboseLogging, baseTen),
+		}
+		client.notify(ctx, recipients, 8006, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -323,26 +433,94 @@ Input
 */
 func (client *Szconfigmanager) RegisterObserver(ctx context.Context, observer observer.Observer) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(703, observer.GetObserverID(ctx))
 		defer func() { client.traceExit(704, observer.GetObserverID(ctx), err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "RegisterObserver", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("RegisterObserver", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfigmanager.RegisterObserver")
+		defer sztracing.End(ctx, &err)
+	}
 	if client.observers == nil {
 		client.observers = &subject.SimpleSubject{}
 	}
 	err = client.observers.RegisterObserver(ctx, observer)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"observerID": observer.GetObserverID(ctx),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8702, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8702, err); len(recipients) > 0 {
+		details := map[string]string{
+			"observerID": observer.GetObserverID(ctx),
+		}
+		client.notify(ctx, recipients, 8702, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
 
+/*
+Method RegisterObserverWithFilter adds the observer to the list of observers notified,
+sending it only the notifications accepted by the filter.
+The filter is applied before the details of a notification are gathered,
+so rejected notifications cost the observer nothing.
+Registering an observer again replaces its filter.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+  - filter: The notifications sent to the observer, such as notification.MessageIDs(8001) or notification.Errors. nil for all.
+*/
+func (client *Szconfigmanager) RegisterObserverWithFilter(ctx context.Context, observer observer.Observer, filter notification.Filter) error {
+	client.setFilter(ctx, observer, filter)
+	return client.RegisterObserver(ctx, observer)
+}
+
+/*
+Method ResetCallStatistics forgets the calls counted by GetCallStatistics.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szconfigmanager) ResetCallStatistics(ctx context.Context) {
+	_ = ctx
+	client.callStatistics.Reset()
+}
+
+/*
+Method SetAuditLog sets the audit log recording future calls of AddConfig, ReplaceDefaultConfigID
+and SetDefaultConfigID.
+The outcome of a call does not depend on the audit log: entries that cannot be appended
+are reported to the error handler of the log, as set by its SetErrorHandler method.
+
+Input
+  - ctx: A context to control lifecycle.
+  - auditLog: The audit log, as described in the audit package. nil records nothing.
+*/
+func (client *Szconfigmanager) SetAuditLog(ctx context.Context, auditLog *audit.Log) {
+	_ = ctx
+	client.auditLog = auditLog
+}
+
+/*
+Method SetDispatcher sets the dispatcher delivering future notifications to observers,
+then waits until the notifications queued by the previous dispatcher are delivered.
+
+Input
+  - ctx: A context to control lifecycle.
+  - notificationDispatcher: The dispatcher. nil restores a default one, with a blocking queue of dispatcher.DefaultCapacity.
+*/
+func (client *Szconfigmanager) SetDispatcher(ctx context.Context, notificationDispatcher *dispatcher.Dispatcher) error {
+	client.dispatcherLock.Lock()
+	previous := client.dispatcher
+	client.dispatcher = notificationDispatcher
+	client.dispatcherLock.Unlock()
+	if previous == nil {
+		return nil
+	}
+	return previous.Flush(ctx)
+}
+
 /*
 Method SetLogLevel sets the level of logging.
 
@@ -352,27 +530,77 @@ Input
 */
 func (client *Szconfigmanager) SetLogLevel(ctx context.Context, logLevelName string) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(705, logLevelName)
 		defer func() { client.traceExit(706, logLevelName, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "SetLogLevel", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("SetLogLevel", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfigmanager.SetLogLevel")
+		defer sztracing.End(ctx, &err)
+	}
 	if !logging.IsValidLogLevelName(logLevelName) {
-		return fmt.Errorf("invalid error level: %s", logLevelName)
+		err = fmt.Errorf("invalid error level: %s", logLevelName)
+		return err
 	}
 	err = client.getLogger().SetLogLevel(logLevelName)
 	client.isTrace = (logLevelName == logging.LevelTraceName)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"logLevelName": logLevelName,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8703, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8703, err); len(recipients) > 0 {
+		details := map[string]string{
+			"logLevelName": logLevelName,
+		}
+		client.notify(ctx, recipients, 8703, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
 
+/*
+Method SetLogger writes future log messages to an slog logger, keeping the current log level.
+
+Input
+  - ctx: A context to control lifecycle.
+  - logger: Where log messages are written, as described in package szslog. nil restores the default logging.
+*/
+func (client *Szconfigmanager) SetLogger(ctx context.Context, logger *slog.Logger) {
+	_ = ctx
+	logLevelName := client.getLogger().GetLogLevel()
+	if logger == nil {
+		client.logger = helper.GetLogger(ComponentID, szconfigmanager.IDMessages, baseCallerSkip)
+	} else {
+		client.logger = szslog.New(ComponentID, szconfigmanager.IDMessages, logger)
+	}
+	client.panicOnError(client.logger.SetLogLevel(logLevelName))
+}
+
+/*
+Method SetMetrics records future method calls in metrics.
+
+Input
+  - ctx: A context to control lifecycle.
+  - metrics: Where calls are recorded. nil stops recording.
+*/
+func (client *Szconfigmanager) SetMetrics(ctx context.Context, metrics *szmetrics.Metrics) {
+	_ = ctx
+	client.metrics = metrics
+}
+
+/*
+Method SetNotificationDetails chooses the optional details added to future Observer messages,
+as described in the notification package.
+
+Input
+  - ctx: A context to control lifecycle.
+  - details: The optional details, such as notification.Duration|notification.RequestID. notification.NoDetails, the default, adds none.
+*/
+func (client *Szconfigmanager) SetNotificationDetails(ctx context.Context, details notification.Details) {
+	_ = ctx
+	client.notificationDetails = details
+}
+
 /*
 Method SetObserverOrigin sets the "origin" value in future Observer messages.
 
@@ -385,6 +613,31 @@ func (client *Szconfigmanager) SetObserverOrigin(ctx context.Context, origin str
 	client.observerOrigin = origin
 }
 
+/*
+Method SetRedaction sets the policy masking personal data and secrets
+in future errors, trace logs and Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - policy: What is redacted, as described in the redaction package. nil redacts nothing.
+*/
+func (client *Szconfigmanager) SetRedaction(ctx context.Context, policy *redaction.Policy) {
+	_ = ctx
+	client.redaction = policy
+}
+
+/*
+Method SetTracer starts a span around each future method call.
+
+Input
+  - ctx: A context to control lifecycle.
+  - tracer: The tracer starting the spans, such as sztracing.Tracer(otel.GetTracerProvider()). nil stops tracing.
+*/
+func (client *Szconfigmanager) SetTracer(ctx context.Context, tracer trace.Tracer) {
+	_ = ctx
+	client.tracer = tracer
+}
+
 /*
 Method UnregisterObserver removes the observer to the list of observers notified.
 
@@ -394,21 +647,30 @@ Input
 */
 func (client *Szconfigmanager) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(707, observer.GetObserverID(ctx))
 		defer func() { client.traceExit(708, observer.GetObserverID(ctx), err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "UnregisterObserver", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("UnregisterObserver", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szconfigmanager.UnregisterObserver")
+		defer sztracing.End(ctx, &err)
+	}
 	if client.observers != nil {
-		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
-		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
-		details := map[string]string{
-			"observerID": observer.GetObserverID(ctx),
+		// The notification is queued for the observers registered before this one is removed,
+		// so the observer being removed is notified too.
+		if recipients := client.getRecipients(ctx, 8704, err); len(recipients) > 0 {
+			details := map[string]string{
+				"observerID": observer.GetObserverID(ctx),
+			}
+			client.notify(ctx, recipients, 8704, err, details, entryTime, notification.NoResult)
 		}
-		notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8704, err, details)
 		err = client.observers.UnregisterObserver(ctx, observer)
+		client.setFilter(ctx, observer, nil)
 		if !client.observers.HasObservers(ctx) {
 			client.observers = nil
 		}
@@ -530,6 +792,89 @@ func (client *Szconfigmanager) setDefaultConfigID(ctx context.Context, configID
 // Internal methods
 // ----------------------------------------------------------------------------
 
+// --- Auditing ---------------------------------------------------------------
+
+// Append the audit entry of a configuration change. Failures of the audit log go to its error handler, not to the caller.
+func (client *Szconfigmanager) appendAudit(ctx context.Context, entry audit.Entry, err error) {
+	client.redaction.Details(entry.Details)
+	entry.Actor = audit.ActorFromContext(ctx)
+	entry.ComponentID = ComponentID
+	entry.Outcome = audit.Success
+	entry.RequestID = notification.RequestIDFromContext(ctx)
+	if err != nil {
+		entry.Error = err.Error()
+		entry.Outcome = audit.Failure
+	}
+	client.auditLog.Record(entry)
+}
+
+// --- Observing --------------------------------------------------------------
+
+// Get the notification dispatcher, creating a default one on first use.
+func (client *Szconfigmanager) getDispatcher() *dispatcher.Dispatcher {
+	client.dispatcherLock.Lock()
+	defer client.dispatcherLock.Unlock()
+	if client.dispatcher == nil {
+		client.dispatcher = dispatcher.New(dispatcher.DefaultCapacity, dispatcher.Block)
+	}
+	return client.dispatcher
+}
+
+// Get the observers whose filters accept a notification. Empty if there are none.
+func (client *Szconfigmanager) getRecipients(ctx context.Context, messageID int, err error) []observer.Observer {
+	if client.observers == nil {
+		return nil
+	}
+	observers := client.observers.GetObservers(ctx)
+	client.filterLock.RLock()
+	defer client.filterLock.RUnlock()
+	if len(client.filters) == 0 {
+		return observers
+	}
+	event := notification.Event{
+		ComponentID: ComponentID,
+		MessageID:   messageID,
+		Err:         err,
+	}
+	result := make([]observer.Observer, 0, len(observers))
+	for _, registered := range observers {
+		filter, isFiltered := client.filters[registered.GetObserverID(ctx)]
+		if !isFiltered || filter(event) {
+			result = append(result, registered)
+		}
+	}
+	return result
+}
+
+// Send a message to the recipients, with the optional details chosen by SetNotificationDetails.
+func (client *Szconfigmanager) notify(ctx context.Context, recipients []observer.Observer, messageID int, err error, details map[string]string, entryTime time.Time, resultSize int) {
+	client.redaction.Details(details)
+	if client.notificationDetails != notification.NoDetails {
+		call := notification.Call{
+			EntryTime:  entryTime,
+			Err:        err,
+			ResultSize: resultSize,
+		}
+		notification.Add(ctx, details, client.notificationDetails, call)
+	}
+	client.getDispatcher().DispatchTo(ctx, recipients, client.observerOrigin, ComponentID, messageID, err, details)
+}
+
+// Set the filter of an observer. nil removes it.
+func (client *Szconfigmanager) setFilter(ctx context.Context, registered observer.Observer, filter notification.Filter) {
+	observerID := registered.GetObserverID(ctx)
+	client.filterLock.Lock()
+	defer client.filterLock.Unlock()
+	if filter == nil {
+		delete(client.filters, observerID)
+		return
+	}
+	if client.filters == nil {
+		client.filters = map[string]notification.Filter{}
+	}
+	client.filters[observerID] = filter
+}
+
 // --- Logging ----------------------------------------------------------------
 
 // Get the Logger singleton.
@@ -550,12 +895,12 @@ func (client *Szconfigmanager) getMessenger() messenger.Messenger {
 
 // Trace method entry.
 func (client *Szconfigmanager) traceEntry(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+	client.getLogger().Log(errorNumber, client.redaction.Values(details)...)
 }
 
 // Trace method exit.
 func (client *Szconfigmanager) traceExit(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+	client.getLogger().Log(errorNumber, client.redaction.Values(details)...)
 }
 
 // --- Errors -----------------------------------------------------------------
@@ -568,11 +913,14 @@ func (client *Szconfigmanager) newError(ctx context.Context, errorNumber int, de
 	if err != nil {
 		lastException = err.Error()
 	}
+	lastException = client.redaction.Redact(lastException)
+	details = client.redaction.Values(details)
+	parameters := details
 	details = append(details, messenger.MessageCode{Value: fmt.Sprintf(ExceptionCodeTemplate, lastExceptionCode)})
 	details = append(details, messenger.MessageReason{Value: lastException})
 	details = append(details, errors.New(lastException))
 	errorMessage := client.getMessenger().NewJSON(errorNumber, details...)
-	return szerror.New(lastExceptionCode, errorMessage)
+	return nativeerror.New(ComponentID, errorNumber, szconfigmanager.IDMessages, lastExceptionCode, lastException, errorMessage, parameters...)
 }
 
 /*
@@ -598,8 +946,8 @@ Input
 func (client *Szconfigmanager) clearLastException(ctx context.Context) error {
 	_ = ctx
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(3)
 		defer func() { client.traceExit(4, err, time.Since(entryTime)) }()
 	}
@@ -620,8 +968,8 @@ func (client *Szconfigmanager) getLastException(ctx context.Context) (string, er
 	_ = ctx
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(13)
 		defer func() { client.traceExit(14, result, err, time.Since(entryTime)) }()
 	}
@@ -644,8 +992,8 @@ func (client *Szconfigmanager) getLastExceptionCode(ctx context.Context) (int, e
 	_ = ctx
 	var err error
 	var result int
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(15)
 		defer func() { client.traceExit(16, result, err, time.Since(entryTime)) }()
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/senzing-garage/go-helpers/fileutil"
 	"github.com/senzing-garage/go-helpers/settings"
 	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/sz-sdk-go-core/audit"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
@@ -292,6 +293,40 @@ func TestSzconfigmanager_SetLogLevel_badLogLevelName(test *testing.T) {
 	_ = szConfigManager.SetLogLevel(ctx, badLogLevelName)
 }
 
+func TestSzconfigmanager_SetAuditLog(test *testing.T) {
+	ctx := audit.WithActor(context.TODO(), "jane.doe")
+	szConfigManager := getTestObject(ctx, test)
+	path := filepath.Join(test.TempDir(), "audit.jsonl")
+	auditLog, err := audit.Open(path)
+	require.NoError(test, err)
+	szConfigManager.SetAuditLog(ctx, auditLog)
+	defer szConfigManager.SetAuditLog(ctx, nil)
+	configID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	err = szConfigManager.SetDefaultConfigID(ctx, configID)
+	require.NoError(test, err)
+	err = szConfigManager.SetDefaultConfigID(ctx, badConfigID)
+	require.ErrorIs(test, err, szerror.ErrSzConfiguration)
+	require.NoError(test, auditLog.Close())
+	file, err := os.Open(path)
+	require.NoError(test, err)
+	defer func() { require.NoError(test, file.Close()) }()
+	summary, err := audit.Verify(file)
+	require.NoError(test, err)
+	assert.Equal(test, int64(2), summary.Entries)
+	content, err := os.ReadFile(path)
+	require.NoError(test, err)
+	printActual(test, string(content))
+	assert.Contains(test, string(content), `"actor":"jane.doe"`)
+	assert.Contains(test, string(content), `"operation":"SetDefaultConfigID"`)
+	assert.Contains(test, string(content), `"outcome":"failure"`)
+	var auditErr error
+	auditLog.SetErrorHandler(func(entry audit.Entry, err error) { auditErr = err })
+	err = szConfigManager.SetDefaultConfigID(ctx, configID)
+	require.NoError(test, err)
+	require.ErrorIs(test, auditErr, audit.ErrClosed)
+}
+
 func TestSzconfigmanager_SetObserverOrigin(test *testing.T) {
 	ctx := context.TODO()
 	szConfigManager := getTestObject(ctx, test)
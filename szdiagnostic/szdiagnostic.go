@@ -21,20 +21,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"runtime"
 	"strconv"
+	"sync"
 	"time"
 	"unsafe"
 
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-messaging/messenger"
-	"github.com/senzing-garage/go-observing/notifier"
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/sz-sdk-go-core/callstats"
+	"github.com/senzing-garage/sz-sdk-go-core/dispatcher"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"github.com/senzing-garage/sz-sdk-go-core/notification"
+	"github.com/senzing-garage/sz-sdk-go-core/redaction"
+	"github.com/senzing-garage/sz-sdk-go-core/szmetrics"
+	"github.com/senzing-garage/sz-sdk-go-core/szslog"
+	"github.com/senzing-garage/sz-sdk-go-core/sztracing"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szdiagnostic"
-	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"go.opentelemetry.io/otel/trace"
 )
 
 /*
@@ -42,11 +51,20 @@ Type Szdiagnostic struct implements the [senzing.SzDiagnostic] interface
 for communicating with the Senzing C binaries.
 */
 type Szdiagnostic struct {
-	isTrace        bool
-	logger         logging.Logging
-	messenger      messenger.Messenger
-	observerOrigin string
-	observers      subject.Subject
+	callStatistics      callstats.Statistics
+	dispatcher          *dispatcher.Dispatcher
+	dispatcherLock      sync.Mutex
+	filterLock          sync.RWMutex
+	filters             map[string]notification.Filter
+	isTrace             bool
+	logger              logging.Logging
+	messenger           messenger.Messenger
+	metrics             *szmetrics.Metrics
+	notificationDetails notification.Details
+	observerOrigin      string
+	observers           subject.Subject
+	redaction           *redaction.Policy
+	tracer              trace.Tracer
 }
 
 const (
@@ -56,6 +74,9 @@ const (
 	noError              = 0
 )
 
+// Returned by getActiveConfigID while no SzEngine is initialized.
+var errActiveConfigIDUnknown = errors.New("szdiagnostic: the active configuration ID is not known")
+
 // ----------------------------------------------------------------------------
 // sz-sdk-go.SzDiagnostic interface methods
 // ----------------------------------------------------------------------------
@@ -75,17 +96,23 @@ Output
 func (client *Szdiagnostic) CheckDatastorePerformance(ctx context.Context, secondsToRun int) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(1, secondsToRun)
 		defer func() { client.traceExit(2, secondsToRun, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "CheckDatastorePerformance", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("CheckDatastorePerformance", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szdiagnostic.CheckDatastorePerformance")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.checkDatastorePerformance(ctx, secondsToRun)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8001, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8001, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8001, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -99,17 +126,28 @@ Input
 */
 func (client *Szdiagnostic) Destroy(ctx context.Context) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(5)
 		defer func() { client.traceExit(6, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Destroy", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Destroy", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szdiagnostic.Destroy")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.destroy(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8002, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8002, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8002, err, details, entryTime, notification.NoResult)
+	}
+	helper.ResetActiveConfigID()
+	flushErr := client.getDispatcher().Flush(ctx)
+	if err == nil {
+		err = flushErr
 	}
 	return err
 }
@@ -127,17 +165,23 @@ Output
 func (client *Szdiagnostic) GetDatastoreInfo(ctx context.Context) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(7)
 		defer func() { client.traceExit(8, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetDatastoreInfo", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetDatastoreInfo", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szdiagnostic.GetDatastoreInfo")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.getDatastoreInfo(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8003, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8003, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8003, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -157,19 +201,25 @@ Output
 func (client *Szdiagnostic) GetFeature(ctx context.Context, featureID int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(9, featureID)
 		defer func() { client.traceExit(10, featureID, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetFeature", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetFeature", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szdiagnostic.GetFeature")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.getFeature(ctx, featureID)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"featureID": strconv.FormatInt(featureID, baseTen),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8004, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8004, err); len(recipients) > 0 {
+		details := map[string]string{
+			"featureID": strconv.FormatInt(featureID, baseTen),
+		}
+		client.notify(ctx, recipients, 8004, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -184,17 +234,23 @@ Input
 */
 func (client *Szdiagnostic) PurgeRepository(ctx context.Context) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(17)
 		defer func() { client.traceExit(18, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "PurgeRepository", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("PurgeRepository", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szdiagnostic.PurgeRepository")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.purgeRepository(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8007, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8007, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8007, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -208,19 +264,26 @@ Input
 */
 func (client *Szdiagnostic) Reinitialize(ctx context.Context, configID int64) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(19, configID)
 		defer func() { client.traceExit(20, configID, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Reinitialize", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Reinitialize", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szdiagnostic.Reinitialize")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.reinit(ctx, configID)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"configID": strconv.FormatInt(configID, baseTen),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8008, err, details)
-		}()
+	helper.ResetActiveConfigID()
+	if recipients := client.getRecipients(ctx, 8008, err); len(recipients) > 0 {
+		details := map[string]string{
+			"configID": strconv.FormatInt(configID, baseTen),
+		}
+		client.notify(ctx, recipients, 8008, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -229,6 +292,24 @@ func (client *Szdiagnostic) Reinitialize(ctx context.Context, configID int64) er
 // Public non-interface methods
 // ----------------------------------------------------------------------------
 
+/*
+Method GetCallStatistics returns the number of calls, failures and latencies of each method
+called since the Szdiagnostic was created or ResetCallStatistics was called.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The statistics of the component, which callstats.Write prints as a table.
+*/
+func (client *Szdiagnostic) GetCallStatistics(ctx context.Context) callstats.Report {
+	_ = ctx
+	return callstats.Report{
+		ComponentID: ComponentID,
+		Methods:     client.callStatistics.Snapshot(),
+	}
+}
+
 /*
 Method GetObserverOrigin returns the "origin" value of past Observer messages.
 
@@ -256,28 +337,35 @@ Input
 */
 func (client *Szdiagnostic) Initialize(ctx context.Context, instanceName string, settings string, configID int64, verboseLogging int64) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(15, instanceName, settings, configID, verboseLogging)
 		defer func() {
 			client.traceExit(16, instanceName, settings, configID, verboseLogging, err, time.Since(entryTime))
 		}()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Initialize", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Initialize", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szdiagnostic.Initialize")
+		defer sztracing.End(ctx, &err)
+	}
 	if configID == senzing.SzInitializeWithDefaultConfiguration {
 		err = client.init(ctx, instanceName, settings, verboseLogging)
 	} else {
 		err = client.initWithConfigID(ctx, instanceName, settings, configID, verboseLogging)
 	}
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"configID":       strconv.FormatInt(configID, baseTen),
-				"instanceName":   instanceName,
-				"settings":       settings,
-				"verboseLogging": strconv.FormatInt(verboseLogging, baseTen),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8005, err, details)
-		}()
+	helper.ResetActiveConfigID()
+	if recipients := client.getRecipients(ctx, 8005, err); len(recipients) > 0 {
+		details := map[string]string{
+			"configID":       strconv.FormatInt(configID, baseTen),
+			"instanceName":   instanceName,
+			"settings":       settings,
+			"verboseLogging": strconv.FormatInt(verboseLogging, baseTen),
+		}
+		client.notify(ctx, recipients, 8005, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -291,26 +379,79 @@ Input
 */
 func (client *Szdiagnostic) RegisterObserver(ctx context.Context, observer observer.Observer) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(703, observer.GetObserverID(ctx))
 		defer func() { client.traceExit(704, observer.GetObserverID(ctx), err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "RegisterObserver", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("RegisterObserver", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szdiagnostic.RegisterObserver")
+		defer sztracing.End(ctx, &err)
+	}
 	if client.observers == nil {
 		client.observers = &subject.SimpleSubject{}
 	}
 	err = client.observers.RegisterObserver(ctx, observer)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"observerID": observer.GetObserverID(ctx),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8702, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8702, err); len(recipients) > 0 {
+		details := map[string]string{
+			"observerID": observer.GetObserverID(ctx),
+		}
+		client.notify(ctx, recipients, 8702, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
 
+/*
+Method RegisterObserverWithFilter adds the observer to the list of observers notified,
+sending it only the notifications accepted by the filter.
+The filter is applied before the details of a notification are gathered,
+so rejected notifications cost the observer nothing.
+Registering an observer again replaces its filter.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+  - filter: The notifications sent to the observer, such as notification.MessageIDs(8001) or notification.Errors. nil for all.
+*/
+func (client *Szdiagnostic) RegisterObserverWithFilter(ctx context.Context, observer observer.Observer, filter notification.Filter) error {
+	client.setFilter(ctx, observer, filter)
+	return client.RegisterObserver(ctx, observer)
+}
+
+/*
+Method ResetCallStatistics forgets the calls counted by GetCallStatistics.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szdiagnostic) ResetCallStatistics(ctx context.Context) {
+	_ = ctx
+	client.callStatistics.Reset()
+}
+
+/*
+Method SetDispatcher sets the dispatcher delivering future notifications to observers,
+then waits until the notifications queued by the previous dispatcher are delivered.
+
+Input
+  - ctx: A context to control lifecycle.
+  - notificationDispatcher: The dispatcher. nil restores a default one, with a blocking queue of dispatcher.DefaultCapacity.
+*/
+func (client *Szdiagnostic) SetDispatcher(ctx context.Context, notificationDispatcher *dispatcher.Dispatcher) error {
+	client.dispatcherLock.Lock()
+	previous := client.dispatcher
+	client.dispatcher = notificationDispatcher
+	client.dispatcherLock.Unlock()
+	if previous == nil {
+		return nil
+	}
+	return previous.Flush(ctx)
+}
+
 /*
 Method SetLogLevel sets the level of logging.
 
@@ -320,27 +461,77 @@ Input
 */
 func (client *Szdiagnostic) SetLogLevel(ctx context.Context, logLevelName string) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(705, logLevelName)
 		defer func() { client.traceExit(706, logLevelName, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "SetLogLevel", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("SetLogLevel", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szdiagnostic.SetLogLevel")
+		defer sztracing.End(ctx, &err)
+	}
 	if !logging.IsValidLogLevelName(logLevelName) {
-		return fmt.Errorf("invalid error level: %s", logLevelName)
+		err = fmt.Errorf("invalid error level: %s", logLevelName)
+		return err
 	}
 	err = client.getLogger().SetLogLevel(logLevelName)
 	client.isTrace = (logLevelName == logging.LevelTraceName)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"logLevelName": logLevelName,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8703, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8703, err); len(recipients) > 0 {
+		details := map[string]string{
+			"logLevelName": logLevelName,
+		}
+		client.notify(ctx, recipients, 8703, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
 
+/*
+Method SetLogger writes future log messages to an slog logger, keeping the current log level.
+
+Input
+  - ctx: A context to control lifecycle.
+  - logger: Where log messages are written, as described in package szslog. nil restores the default logging.
+*/
+func (client *Szdiagnostic) SetLogger(ctx context.Context, logger *slog.Logger) {
+	_ = ctx
+	logLevelName := client.getLogger().GetLogLevel()
+	if logger == nil {
+		client.logger = helper.GetLogger(ComponentID, szdiagnostic.IDMessages, baseCallerSkip)
+	} else {
+		client.logger = szslog.New(ComponentID, szdiagnostic.IDMessages, logger)
+	}
+	client.panicOnError(client.logger.SetLogLevel(logLevelName))
+}
+
+/*
+Method SetMetrics records future method calls in metrics.
+
+Input
+  - ctx: A context to control lifecycle.
+  - metrics: Where calls are recorded. nil stops recording.
+*/
+func (client *Szdiagnostic) SetMetrics(ctx context.Context, metrics *szmetrics.Metrics) {
+	_ = ctx
+	client.metrics = metrics
+}
+
+/*
+Method SetNotificationDetails chooses the optional details added to future Observer messages,
+as described in the notification package.
+
+Input
+  - ctx: A context to control lifecycle.
+  - details: The optional details, such as notification.Duration|notification.RequestID. notification.NoDetails, the default, adds none.
+*/
+func (client *Szdiagnostic) SetNotificationDetails(ctx context.Context, details notification.Details) {
+	_ = ctx
+	client.notificationDetails = details
+}
+
 /*
 Method SetObserverOrigin sets the "origin" value in future Observer messages.
 
@@ -353,6 +544,31 @@ func (client *Szdiagnostic) SetObserverOrigin(ctx context.Context, origin string
 	client.observerOrigin = origin
 }
 
+/*
+Method SetRedaction sets the policy masking personal data and secrets
+in future errors, trace logs and Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - policy: What is redacted, as described in the redaction package. nil redacts nothing.
+*/
+func (client *Szdiagnostic) SetRedaction(ctx context.Context, policy *redaction.Policy) {
+	_ = ctx
+	client.redaction = policy
+}
+
+/*
+Method SetTracer starts a span around each future method call.
+
+Input
+  - ctx: A context to control lifecycle.
+  - tracer: The tracer starting the spans, such as sztracing.Tracer(otel.GetTracerProvider()). nil stops tracing.
+*/
+func (client *Szdiagnostic) SetTracer(ctx context.Context, tracer trace.Tracer) {
+	_ = ctx
+	client.tracer = tracer
+}
+
 /*
 Method UnregisterObserver removes the observer to the list of observers notified.
 
@@ -362,21 +578,30 @@ Input
 */
 func (client *Szdiagnostic) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(707, observer.GetObserverID(ctx))
 		defer func() { client.traceExit(708, observer.GetObserverID(ctx), err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "UnregisterObserver", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("UnregisterObserver", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szdiagnostic.UnregisterObserver")
+		defer sztracing.End(ctx, &err)
+	}
 	if client.observers != nil {
-		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
-		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
-		details := map[string]string{
-			"observerID": observer.GetObserverID(ctx),
+		// The notification is queued for the observers registered before this one is removed,
+		// so the observer being removed is notified too.
+		if recipients := client.getRecipients(ctx, 8704, err); len(recipients) > 0 {
+			details := map[string]string{
+				"observerID": observer.GetObserverID(ctx),
+			}
+			client.notify(ctx, recipients, 8704, err, details, entryTime, notification.NoResult)
 		}
-		notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8704, err, details)
 		err = client.observers.UnregisterObserver(ctx, observer)
+		client.setFilter(ctx, observer, nil)
 		if !client.observers.HasObservers(ctx) {
 			client.observers = nil
 		}
@@ -413,6 +638,19 @@ func (client *Szdiagnostic) destroy(ctx context.Context) error {
 	return err
 }
 
+// The active configuration ID, known to the Senzing C library once an SzEngine is initialized in the process.
+// A failure is not turned into an Sz error, as it is expected while no SzEngine is initialized.
+func (client *Szdiagnostic) getActiveConfigID(ctx context.Context) (int64, error) {
+	_ = ctx
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	result := C.Sz_getActiveConfigID_helper()
+	if result.returnCode != noError {
+		return 0, errActiveConfigIDUnknown
+	}
+	return int64(C.longlong(result.configID)), nil
+}
+
 func (client *Szdiagnostic) getDatastoreInfo(ctx context.Context) (string, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -518,6 +756,81 @@ func (client *Szdiagnostic) reinit(ctx context.Context, configID int64) error {
 // Internal methods
 // ----------------------------------------------------------------------------
 
+// --- Observing --------------------------------------------------------------
+
+// Get the notification dispatcher, creating a default one on first use.
+func (client *Szdiagnostic) getDispatcher() *dispatcher.Dispatcher {
+	client.dispatcherLock.Lock()
+	defer client.dispatcherLock.Unlock()
+	if client.dispatcher == nil {
+		client.dispatcher = dispatcher.New(dispatcher.DefaultCapacity, dispatcher.Block)
+	}
+	return client.dispatcher
+}
+
+// Get the active configuration ID for notifications, asking the Senzing C library only after it changed.
+func (client *Szdiagnostic) getNotifiedConfigID(ctx context.Context) int64 {
+	return helper.GetActiveConfigID(func() (int64, error) { return client.getActiveConfigID(ctx) })
+}
+
+// Get the observers whose filters accept a notification. Empty if there are none.
+func (client *Szdiagnostic) getRecipients(ctx context.Context, messageID int, err error) []observer.Observer {
+	if client.observers == nil {
+		return nil
+	}
+	observers := client.observers.GetObservers(ctx)
+	client.filterLock.RLock()
+	defer client.filterLock.RUnlock()
+	if len(client.filters) == 0 {
+		return observers
+	}
+	event := notification.Event{
+		ComponentID: ComponentID,
+		MessageID:   messageID,
+		Err:         err,
+	}
+	result := make([]observer.Observer, 0, len(observers))
+	for _, registered := range observers {
+		filter, isFiltered := client.filters[registered.GetObserverID(ctx)]
+		if !isFiltered || filter(event) {
+			result = append(result, registered)
+		}
+	}
+	return result
+}
+
+// Send a message to the recipients, with the optional details chosen by SetNotificationDetails.
+func (client *Szdiagnostic) notify(ctx context.Context, recipients []observer.Observer, messageID int, err error, details map[string]string, entryTime time.Time, resultSize int) {
+	client.redaction.Details(details)
+	if client.notificationDetails != notification.NoDetails {
+		call := notification.Call{
+			EntryTime:  entryTime,
+			Err:        err,
+			ResultSize: resultSize,
+		}
+		if client.notificationDetails&notification.ConfigID != 0 {
+			call.ConfigID = client.getNotifiedConfigID(ctx)
+		}
+		notification.Add(ctx, details, client.notificationDetails, call)
+	}
+	client.getDispatcher().DispatchTo(ctx, recipients, client.observerOrigin, ComponentID, messageID, err, details)
+}
+
+// Set the filter of an observer. nil removes it.
+func (client *Szdiagnostic) setFilter(ctx context.Context, registered observer.Observer, filter notification.Filter) {
+	observerID := registered.GetObserverID(ctx)
+	client.filterLock.Lock()
+	defer client.filterLock.Unlock()
+	if filter == nil {
+		delete(client.filters, observerID)
+		return
+	}
+	if client.filters == nil {
+		client.filters = map[string]notification.Filter{}
+	}
+	client.filters[observerID] = filter
+}
+
 // --- Logging ----------------------------------------------------------------
 
 // Get the Logger singleton.
@@ -538,12 +851,12 @@ func (client *Szdiagnostic) getMessenger() messenger.Messenger {
 
 // Trace method entry.
 func (client *Szdiagnostic) traceEntry(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+	client.getLogger().Log(errorNumber, client.redaction.Values(details)...)
 }
 
 // Trace method exit.
 func (client *Szdiagnostic) traceExit(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+	client.getLogger().Log(errorNumber, client.redaction.Values(details)...)
 }
 
 // --- Errors -----------------------------------------------------------------
@@ -556,11 +869,14 @@ func (client *Szdiagnostic) newError(ctx context.Context, errorNumber int, detai
 	if err != nil {
 		lastException = err.Error()
 	}
+	lastException = client.redaction.Redact(lastException)
+	details = client.redaction.Values(details)
+	parameters := details
 	details = append(details, messenger.MessageCode{Value: fmt.Sprintf(ExceptionCodeTemplate, lastExceptionCode)})
 	details = append(details, messenger.MessageReason{Value: lastException})
 	details = append(details, errors.New(lastException))
 	errorMessage := client.getMessenger().NewJSON(errorNumber, details...)
-	return szerror.New(lastExceptionCode, errorMessage)
+	return nativeerror.New(ComponentID, errorNumber, szdiagnostic.IDMessages, lastExceptionCode, lastException, errorMessage, parameters...)
 }
 
 /*
@@ -586,8 +902,8 @@ Input
 func (client *Szdiagnostic) clearLastException(ctx context.Context) error {
 	_ = ctx
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(3)
 		defer func() { client.traceExit(4, err, time.Since(entryTime)) }()
 	}
@@ -608,8 +924,8 @@ func (client *Szdiagnostic) getLastException(ctx context.Context) (string, error
 	_ = ctx
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(11)
 		defer func() { client.traceExit(12, result, err, time.Since(entryTime)) }()
 	}
@@ -632,8 +948,8 @@ func (client *Szdiagnostic) getLastExceptionCode(ctx context.Context) (int, erro
 	_ = ctx
 	var err error
 	var result int
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(13)
 		defer func() { client.traceExit(14, result, err, time.Since(entryTime)) }()
 	}
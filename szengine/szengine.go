@@ -6,6 +6,7 @@ package szengine
 
 /*
 #include <stdlib.h>
+#include <string.h>
 #include "libSz.h"
 #include "szhelpers/SzLang_helpers.h"
 #cgo CFLAGS: -g -I/opt/senzing/er/sdk/c
@@ -20,20 +21,34 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"runtime"
 	"strconv"
+	"sync"
 	"time"
 	"unsafe"
 
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-messaging/messenger"
-	"github.com/senzing-garage/go-observing/notifier"
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/sz-sdk-go-core/audit"
+	"github.com/senzing-garage/sz-sdk-go-core/callstats"
+	"github.com/senzing-garage/sz-sdk-go-core/dispatcher"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"github.com/senzing-garage/sz-sdk-go-core/notification"
+	"github.com/senzing-garage/sz-sdk-go-core/redaction"
+	"github.com/senzing-garage/sz-sdk-go-core/szargs"
+	"github.com/senzing-garage/sz-sdk-go-core/szflags"
+	"github.com/senzing-garage/sz-sdk-go-core/szmetrics"
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+	"github.com/senzing-garage/sz-sdk-go-core/szslog"
+	"github.com/senzing-garage/sz-sdk-go-core/sztracing"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szengine"
-	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"go.opentelemetry.io/otel/trace"
 )
 
 /*
@@ -41,12 +56,28 @@ Type Szengine struct implements the [senzing.SzEngine] interface
 for communicating with the Senzing C binaries.
 */
 type Szengine struct {
-	isTrace        bool
-	logger         logging.Logging
-	messenger      messenger.Messenger
-	observerOrigin string
-	observers      subject.Subject
-}
+	auditLog            *audit.Log
+	callStatistics      callstats.Statistics
+	dispatcher          *dispatcher.Dispatcher
+	dispatcherLock      sync.Mutex
+	exportHandleLock    sync.Mutex
+	filterLock          sync.RWMutex
+	filters             map[string]notification.Filter
+	isTrace             bool
+	isTraceFlagNames    bool
+	logger              logging.Logging
+	messenger           messenger.Messenger
+	metrics             *szmetrics.Metrics
+	notificationDetails notification.Details
+	observerOrigin      string
+	observers           subject.Subject
+	openExportHandles   map[uintptr]struct{}
+	redaction           *redaction.Policy
+	tracer              trace.Tracer
+}
+
+// Type responseHandler receives a response of the Senzing C library before the response is freed.
+type responseHandler func(response *C.char)
 
 const (
 	baseCallerSkip       = 4
@@ -56,6 +87,9 @@ const (
 	withoutInfo          = ""
 )
 
+// Name of the gauge of open export handles.
+const openExportHandlesGauge = "sz_open_export_handles"
+
 // ----------------------------------------------------------------------------
 // sz-sdk-go.SzEngine interface methods
 // ----------------------------------------------------------------------------
@@ -80,27 +114,37 @@ Output
 func (client *Szengine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(1, dataSourceCode, recordID, recordDefinition, flags)
+		client.traceEntryWithFlags(1, dataSourceCode, recordID, recordDefinition, flags)
 		defer func() {
 			client.traceExit(2, dataSourceCode, recordID, recordDefinition, flags, result, err, time.Since(entryTime))
 		}()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "AddRecord", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("AddRecord", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.AddRecord", sztracing.DataSources(dataSourceCode), sztracing.RecordIDs(recordID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	if (flags & senzing.SzWithInfo) == senzing.SzNoFlags {
 		result, err = client.addRecord(ctx, dataSourceCode, recordID, recordDefinition)
 	} else {
 		finalFlags := flags & ^senzing.SzWithInfo
 		result, err = client.addRecordWithInfo(ctx, dataSourceCode, recordID, recordDefinition, finalFlags)
 	}
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8001, err, details)
-		}()
+	if client.auditLog != nil {
+		entry := audit.Entry{DataSourceCode: dataSourceCode, Operation: "AddRecord", RecordID: recordID}
+		client.appendAudit(ctx, entry, result, err)
+	}
+	if recipients := client.getRecipients(ctx, 8001, err); len(recipients) > 0 {
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, recipients, 8001, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -116,17 +160,23 @@ Input
 */
 func (client *Szengine) CloseExport(ctx context.Context, exportHandle uintptr) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(5, exportHandle)
 		defer func() { client.traceExit(6, exportHandle, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "CloseExport", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("CloseExport", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.CloseExport")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.closeExport(ctx, exportHandle)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8002, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8002, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8002, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -144,17 +194,23 @@ Output
 func (client *Szengine) CountRedoRecords(ctx context.Context) (int64, error) {
 	var err error
 	var result int64
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(7)
 		defer func() { client.traceExit(8, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "CountRedoRecords", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("CountRedoRecords", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.CountRedoRecords")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.countRedoRecords(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8003, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8003, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8003, err, details, entryTime, notification.NoResult)
 	}
 	return result, err
 }
@@ -178,25 +234,35 @@ Output
 func (client *Szengine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(9, dataSourceCode, recordID, flags)
+		client.traceEntryWithFlags(9, dataSourceCode, recordID, flags)
 		defer func() { client.traceExit(10, dataSourceCode, recordID, flags, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "DeleteRecord", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("DeleteRecord", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.DeleteRecord", sztracing.DataSources(dataSourceCode), sztracing.RecordIDs(recordID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	if (flags & senzing.SzWithInfo) == senzing.SzNoFlags {
 		result, err = client.deleteRecord(ctx, dataSourceCode, recordID)
 	} else {
 		finalFlags := flags & ^senzing.SzWithInfo
 		result, err = client.deleteRecordWithInfo(ctx, dataSourceCode, recordID, finalFlags)
 	}
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8004, err, details)
-		}()
+	if client.auditLog != nil {
+		entry := audit.Entry{DataSourceCode: dataSourceCode, Operation: "DeleteRecord", RecordID: recordID}
+		client.appendAudit(ctx, entry, result, err)
+	}
+	if recipients := client.getRecipients(ctx, 8004, err); len(recipients) > 0 {
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, recipients, 8004, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -210,17 +276,28 @@ Input
 */
 func (client *Szengine) Destroy(ctx context.Context) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(11)
 		defer func() { client.traceExit(12, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Destroy", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Destroy", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.Destroy")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.destroy(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8005, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8005, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8005, err, details, entryTime, notification.NoResult)
+	}
+	helper.ResetActiveConfigID()
+	flushErr := client.getDispatcher().Flush(ctx)
+	if err == nil {
+		err = flushErr
 	}
 	return err
 }
@@ -242,17 +319,23 @@ Output
 func (client *Szengine) ExportCsvEntityReport(ctx context.Context, csvColumnList string, flags int64) (uintptr, error) {
 	var err error
 	var result uintptr
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(13, csvColumnList, flags)
+		client.traceEntryWithFlags(13, csvColumnList, flags)
 		defer func() { client.traceExit(14, csvColumnList, flags, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "ExportCsvEntityReport", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("ExportCsvEntityReport", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.ExportCsvEntityReport", sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.exportCsvEntityReport(ctx, csvColumnList, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8006, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8006, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8006, err, details, entryTime, notification.NoResult)
 	}
 	return result, err
 }
@@ -278,9 +361,9 @@ func (client *Szengine) ExportCsvEntityReportIterator(ctx context.Context, csvCo
 		defer runtime.UnlockOSThread()
 		defer close(stringFragmentChannel)
 		var err error
+		entryTime := time.Now()
 		if client.isTrace {
-			entryTime := time.Now()
-			client.traceEntry(15, csvColumnList, flags)
+			client.traceEntryWithFlags(15, csvColumnList, flags)
 			defer func() { client.traceExit(16, csvColumnList, flags, err, time.Since(entryTime)) }()
 		}
 		reportHandle, err := client.ExportCsvEntityReport(ctx, csvColumnList, flags)
@@ -321,11 +404,9 @@ func (client *Szengine) ExportCsvEntityReportIterator(ctx context.Context, csvCo
 				}
 			}
 		}
-		if client.observers != nil {
-			go func() {
-				details := map[string]string{}
-				notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8007, err, details)
-			}()
+		if recipients := client.getRecipients(ctx, 8007, err); len(recipients) > 0 {
+			details := map[string]string{}
+			client.notify(ctx, recipients, 8007, err, details, entryTime, notification.NoResult)
 		}
 	}()
 	return stringFragmentChannel
@@ -345,17 +426,23 @@ Output
 func (client *Szengine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
 	var err error
 	var result uintptr
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(17, flags)
+		client.traceEntryWithFlags(17, flags)
 		defer func() { client.traceExit(18, flags, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "ExportJSONEntityReport", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("ExportJSONEntityReport", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.ExportJSONEntityReport", sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.exportJSONEntityReport(ctx, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8008, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8008, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8008, err, details, entryTime, notification.NoResult)
 	}
 	return result, err
 }
@@ -380,9 +467,9 @@ func (client *Szengine) ExportJSONEntityReportIterator(ctx context.Context, flag
 		defer runtime.UnlockOSThread()
 		defer close(stringFragmentChannel)
 		var err error
+		entryTime := time.Now()
 		if client.isTrace {
-			entryTime := time.Now()
-			client.traceEntry(19, flags)
+			client.traceEntryWithFlags(19, flags)
 			defer func() { client.traceExit(20, flags, err, time.Since(entryTime)) }()
 		}
 		reportHandle, err := client.ExportJSONEntityReport(ctx, flags)
@@ -423,11 +510,9 @@ func (client *Szengine) ExportJSONEntityReportIterator(ctx context.Context, flag
 				}
 			}
 		}
-		if client.observers != nil {
-			go func() {
-				details := map[string]string{}
-				notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8009, err, details)
-			}()
+		if recipients := client.getRecipients(ctx, 8009, err); len(recipients) > 0 {
+			details := map[string]string{}
+			client.notify(ctx, recipients, 8009, err, details, entryTime, notification.NoResult)
 		}
 	}()
 	return stringFragmentChannel
@@ -448,17 +533,23 @@ Output
 func (client *Szengine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(21, exportHandle)
 		defer func() { client.traceExit(22, exportHandle, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "FetchNext", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("FetchNext", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.FetchNext")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.fetchNext(ctx, exportHandle)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8010, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8010, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8010, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -478,19 +569,25 @@ Output
 func (client *Szengine) FindInterestingEntitiesByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(23, entityID, flags)
+		client.traceEntryWithFlags(23, entityID, flags)
 		defer func() { client.traceExit(24, entityID, flags, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "FindInterestingEntitiesByEntityID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("FindInterestingEntitiesByEntityID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.FindInterestingEntitiesByEntityID", sztracing.EntityIDs(entityID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.findInterestingEntitiesByEntityID(ctx, entityID, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": formatEntityID(entityID),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8011, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8011, err); len(recipients) > 0 {
+		details := map[string]string{
+			"entityID": formatEntityID(entityID),
+		}
+		client.notify(ctx, recipients, 8011, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -511,22 +608,28 @@ Output
 func (client *Szengine) FindInterestingEntitiesByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(25, dataSourceCode, recordID, flags)
+		client.traceEntryWithFlags(25, dataSourceCode, recordID, flags)
 		defer func() {
 			client.traceExit(26, dataSourceCode, recordID, flags, result, err, time.Since(entryTime))
 		}()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "FindInterestingEntitiesByRecordID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("FindInterestingEntitiesByRecordID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.FindInterestingEntitiesByRecordID", sztracing.DataSources(dataSourceCode), sztracing.RecordIDs(recordID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.findInterestingEntitiesByRecordID(ctx, dataSourceCode, recordID, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8012, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8012, err); len(recipients) > 0 {
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, recipients, 8012, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -549,24 +652,8 @@ Output
   - A JSON document.
 */
 func (client *Szengine) FindNetworkByEntityID(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64) (string, error) {
-	var err error
 	var result string
-	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(27, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
-		defer func() {
-			client.traceExit(28, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, result, err, time.Since(entryTime))
-		}()
-	}
-	result, err = client.findNetworkByEntityIDV2(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityIDs": entityIDs,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8013, err, details)
-		}()
-	}
+	err := client.findNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, stringResponse(&result))
 	return result, err
 }
 
@@ -588,24 +675,8 @@ Output
   - A JSON document.
 */
 func (client *Szengine) FindNetworkByRecordID(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64) (string, error) {
-	var err error
 	var result string
-	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(39, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
-		defer func() {
-			client.traceExit(40, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, result, err, time.Since(entryTime))
-		}()
-	}
-	result, err = client.findNetworkByRecordIDV2(ctx, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"recordKeys": recordKeys,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8014, err, details)
-		}()
-	}
+	err := client.findNetworkByRecordID(ctx, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, stringResponse(&result))
 	return result, err
 }
 
@@ -631,34 +702,8 @@ Output
   - A JSON document.
 */
 func (client *Szengine) FindPathByEntityID(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64) (string, error) {
-	var err error
 	var result string
-	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(31, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags)
-		defer func() {
-			client.traceExit(32, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags, result, err, time.Since(entryTime))
-		}()
-	}
-	switch {
-	case len(requiredDataSources) > 0:
-		result, err = client.findPathByEntityIDIncludingSourceV2(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags)
-	case len(avoidEntityIDs) > 0:
-		result, err = client.findPathByEntityIDWithAvoidsV2(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, flags)
-	default:
-		result, err = client.findPathByEntityIDV2(ctx, startEntityID, endEntityID, maxDegrees, flags)
-	}
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"startEntityID":       formatEntityID(startEntityID),
-				"endEntityID":         formatEntityID(endEntityID),
-				"avoidEntityIDs":      avoidEntityIDs,
-				"requiredDataSources": requiredDataSources,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8015, err, details)
-		}()
-	}
+	err := client.findPathByEntityID(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags, stringResponse(&result))
 	return result, err
 }
 
@@ -686,36 +731,8 @@ Output
   - A JSON document.
 */
 func (client *Szengine) FindPathByRecordID(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidRecordKeys string, requiredDataSources string, flags int64) (string, error) {
-	var err error
 	var result string
-	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(33, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags)
-		defer func() {
-			client.traceExit(34, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags, result, err, time.Since(entryTime))
-		}()
-	}
-	switch {
-	case len(requiredDataSources) > 0:
-		result, err = client.findPathByRecordIDIncludingSourceV2(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags)
-	case len(avoidRecordKeys) > 0:
-		result, err = client.findPathByRecordIDWithAvoidsV2(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, flags)
-	default:
-		result, err = client.findPathByRecordIDV2(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, flags)
-	}
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"startDataSourceCode": startDataSourceCode,
-				"startRecordID":       startRecordID,
-				"endDataSourceCode":   endDataSourceCode,
-				"endRecordID":         endRecordID,
-				"avoidRecordKeys":     avoidRecordKeys,
-				"requiredDataSources": requiredDataSources,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8016, err, details)
-		}()
-	}
+	err := client.findPathByRecordID(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags, stringResponse(&result))
 	return result, err
 }
 
@@ -731,17 +748,23 @@ Output
 func (client *Szengine) GetActiveConfigID(ctx context.Context) (int64, error) {
 	var err error
 	var result int64
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(35)
 		defer func() { client.traceExit(36, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetActiveConfigID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetActiveConfigID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.GetActiveConfigID")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.getActiveConfigID(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8017, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8017, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8017, err, details, entryTime, notification.NoResult)
 	}
 	return result, err
 }
@@ -761,19 +784,25 @@ Output
 func (client *Szengine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(37, entityID, flags)
+		client.traceEntryWithFlags(37, entityID, flags)
 		defer func() { client.traceExit(38, entityID, flags, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetEntityByEntityID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetEntityByEntityID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.GetEntityByEntityID", sztracing.EntityIDs(entityID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.getEntityByEntityIDV2(ctx, entityID, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": formatEntityID(entityID),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8018, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8018, err); len(recipients) > 0 {
+		details := map[string]string{
+			"entityID": formatEntityID(entityID),
+		}
+		client.notify(ctx, recipients, 8018, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -793,22 +822,28 @@ Output
 func (client *Szengine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(39, dataSourceCode, recordID, flags)
+		client.traceEntryWithFlags(39, dataSourceCode, recordID, flags)
 		defer func() {
 			client.traceExit(40, dataSourceCode, recordID, flags, result, err, time.Since(entryTime))
 		}()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetEntityByRecordID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetEntityByRecordID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.GetEntityByRecordID", sztracing.DataSources(dataSourceCode), sztracing.RecordIDs(recordID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.getEntityByRecordIDV2(ctx, dataSourceCode, recordID, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8019, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8019, err); len(recipients) > 0 {
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, recipients, 8019, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -828,22 +863,28 @@ Output
 func (client *Szengine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(45, dataSourceCode, recordID, flags)
+		client.traceEntryWithFlags(45, dataSourceCode, recordID, flags)
 		defer func() {
 			client.traceExit(46, dataSourceCode, recordID, flags, result, err, time.Since(entryTime))
 		}()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetRecord", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetRecord", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.GetRecord", sztracing.DataSources(dataSourceCode), sztracing.RecordIDs(recordID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.getRecordV2(ctx, dataSourceCode, recordID, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8020, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8020, err); len(recipients) > 0 {
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, recipients, 8020, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -861,17 +902,23 @@ Output
 func (client *Szengine) GetRedoRecord(ctx context.Context) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(47)
 		defer func() { client.traceExit(48, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetRedoRecord", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetRedoRecord", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.GetRedoRecord")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.getRedoRecord(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8021, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8021, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8021, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -889,17 +936,23 @@ Output
 func (client *Szengine) GetStats(ctx context.Context) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(49)
 		defer func() { client.traceExit(50, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetStats", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetStats", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.GetStats")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.getStats(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8022, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8022, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8022, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -919,18 +972,28 @@ Output
 func (client *Szengine) GetVirtualEntityByRecordID(ctx context.Context, recordKeys string, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(51, recordKeys, flags)
+		client.traceEntryWithFlags(51, recordKeys, flags)
 		defer func() { client.traceExit(52, recordKeys, flags, result, err, time.Since(entryTime)) }()
 	}
-	result, err = client.getVirtualEntityByRecordIDV2(ctx, recordKeys, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"recordKeys": recordKeys}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8023, err, details)
-		}()
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetVirtualEntityByRecordID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetVirtualEntityByRecordID", entryTime, &err)
+	if client.tracer != nil {
+		dataSources, recordIDs := sztracing.RecordKeysDocument(recordKeys)
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.GetVirtualEntityByRecordID", dataSources, recordIDs, sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
+	err = szargs.ValidateRecordKeys("recordKeys", recordKeys)
+	if err == nil {
+		result, err = client.getVirtualEntityByRecordIDV2(ctx, recordKeys, flags)
+	}
+	if recipients := client.getRecipients(ctx, 8023, err); len(recipients) > 0 {
+		details := map[string]string{
+			"recordKeys": recordKeys}
+		client.notify(ctx, recipients, 8023, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -947,22 +1010,8 @@ Output
   - A JSON document.
 */
 func (client *Szengine) HowEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
-	var err error
 	var result string
-	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(53, entityID, flags)
-		defer func() { client.traceExit(54, entityID, flags, result, err, time.Since(entryTime)) }()
-	}
-	result, err = client.howEntityByEntityIDV2(ctx, entityID, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": formatEntityID(entityID),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8024, err, details)
-		}()
-	}
+	err := client.howEntityByEntityID(ctx, entityID, flags, stringResponse(&result))
 	return result, err
 }
 
@@ -980,19 +1029,25 @@ Output
 func (client *Szengine) PreprocessRecord(ctx context.Context, recordDefinition string, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(77, recordDefinition, flags)
+		client.traceEntryWithFlags(77, recordDefinition, flags)
 		defer func() {
 			client.traceExit(78, recordDefinition, flags, result, err, time.Since(entryTime))
 		}()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "PreprocessRecord", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("PreprocessRecord", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.PreprocessRecord", sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.preprocessRecord(ctx, recordDefinition, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8035, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8035, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8035, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -1005,17 +1060,23 @@ Input
 */
 func (client *Szengine) PrimeEngine(ctx context.Context) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(57)
 		defer func() { client.traceExit(58, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "PrimeEngine", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("PrimeEngine", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.PrimeEngine")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.primeEngine(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8026, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8026, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8026, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -1033,21 +1094,32 @@ Output
 func (client *Szengine) ProcessRedoRecord(ctx context.Context, redoRecord string, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(59, redoRecord, flags)
+		client.traceEntryWithFlags(59, redoRecord, flags)
 		defer func() { client.traceExit(60, redoRecord, flags, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "ProcessRedoRecord", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("ProcessRedoRecord", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.ProcessRedoRecord", sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	if (flags & senzing.SzWithInfo) == senzing.SzNoFlags {
 		result, err = client.processRedoRecord(ctx, redoRecord)
 	} else {
 		result, err = client.processRedoRecordWithInfo(ctx, redoRecord)
 	}
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8027, err, details)
-		}()
+	if client.auditLog != nil {
+		entry := audit.Entry{Operation: "ProcessRedoRecord"}
+		entry.DataSourceCode, entry.RecordID = audit.RecordKey(redoRecord)
+		client.appendAudit(ctx, entry, result, err)
+	}
+	if recipients := client.getRecipients(ctx, 8027, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8027, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -1066,24 +1138,34 @@ Input
 func (client *Szengine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(61, entityID, flags)
+		client.traceEntryWithFlags(61, entityID, flags)
 		defer func() { client.traceExit(62, entityID, flags, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "ReevaluateEntity", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("ReevaluateEntity", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.ReevaluateEntity", sztracing.EntityIDs(entityID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	if (flags & senzing.SzWithInfo) == senzing.SzNoFlags {
 		result, err = client.reevaluateEntity(ctx, entityID, flags)
 	} else {
 		finalFlags := flags & ^senzing.SzWithInfo
 		result, err = client.reevaluateEntityWithInfo(ctx, entityID, finalFlags)
 	}
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": formatEntityID(entityID),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8028, err, details)
-		}()
+	if client.auditLog != nil {
+		entry := audit.Entry{EntityID: entityID, Operation: "ReevaluateEntity"}
+		client.appendAudit(ctx, entry, result, err)
+	}
+	if recipients := client.getRecipients(ctx, 8028, err); len(recipients) > 0 {
+		details := map[string]string{
+			"entityID": formatEntityID(entityID),
+		}
+		client.notify(ctx, recipients, 8028, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -1103,25 +1185,35 @@ Input
 func (client *Szengine) ReevaluateRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(63, dataSourceCode, recordID, flags)
+		client.traceEntryWithFlags(63, dataSourceCode, recordID, flags)
 		defer func() { client.traceExit(64, dataSourceCode, recordID, flags, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "ReevaluateRecord", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("ReevaluateRecord", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.ReevaluateRecord", sztracing.DataSources(dataSourceCode), sztracing.RecordIDs(recordID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
 	if (flags & senzing.SzWithInfo) == senzing.SzNoFlags {
 		result, err = client.reevaluateRecord(ctx, dataSourceCode, recordID, flags)
 	} else {
 		finalFlags := flags & ^senzing.SzWithInfo
 		result, err = client.reevaluateRecordWithInfo(ctx, dataSourceCode, recordID, finalFlags)
 	}
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8029, err, details)
-		}()
+	if client.auditLog != nil {
+		entry := audit.Entry{DataSourceCode: dataSourceCode, Operation: "ReevaluateRecord", RecordID: recordID}
+		client.appendAudit(ctx, entry, result, err)
+	}
+	if recipients := client.getRecipients(ctx, 8029, err); len(recipients) > 0 {
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, recipients, 8029, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -1135,19 +1227,26 @@ Input
 */
 func (client *Szengine) Reinitialize(ctx context.Context, configID int64) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(65, configID)
 		defer func() { client.traceExit(66, configID, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Reinitialize", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Reinitialize", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.Reinitialize")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.reinit(ctx, configID)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"configID": strconv.FormatInt(configID, baseTen),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8030, err, details)
-		}()
+	helper.ResetActiveConfigID()
+	if recipients := client.getRecipients(ctx, 8030, err); len(recipients) > 0 {
+		details := map[string]string{
+			"configID": strconv.FormatInt(configID, baseTen),
+		}
+		client.notify(ctx, recipients, 8030, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -1171,59 +1270,693 @@ Output
 func (client *Szengine) SearchByAttributes(ctx context.Context, attributes string, searchProfile string, flags int64) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(69, attributes, searchProfile, flags)
+		client.traceEntryWithFlags(69, attributes, searchProfile, flags)
 		defer func() { client.traceExit(70, attributes, searchProfile, flags, result, err, time.Since(entryTime)) }()
 	}
-	result, err = client.searchByAttributesV3(ctx, attributes, searchProfile, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"attributes":    attributes,
-				"searchProfile": searchProfile,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8031, err, details)
-		}()
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "SearchByAttributes", entryTime, &err)
 	}
+	defer client.callStatistics.Observe("SearchByAttributes", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.SearchByAttributes", sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
+	result, err = client.searchByAttributesV3(ctx, attributes, searchProfile, flags)
+	if recipients := client.getRecipients(ctx, 8031, err); len(recipients) > 0 {
+		details := map[string]string{
+			"attributes":    attributes,
+			"searchProfile": searchProfile,
+		}
+		client.notify(ctx, recipients, 8031, err, details, entryTime, len(result))
+	}
+	return result, err
+}
+
+/*
+Method WhyEntities explains the ways in which two entities are related to each other.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID1: The first of two entity IDs.
+  - entityID2: The second of two entity IDs.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	var err error
+	var result string
+	entryTime := time.Now()
+	if client.isTrace {
+		client.traceEntryWithFlags(71, entityID1, entityID2, flags)
+		defer func() { client.traceExit(72, entityID1, entityID2, flags, result, err, time.Since(entryTime)) }()
+	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "WhyEntities", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("WhyEntities", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.WhyEntities", sztracing.EntityIDs(entityID1, entityID2), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
+	result, err = client.whyEntitiesV2(ctx, entityID1, entityID2, flags)
+	if recipients := client.getRecipients(ctx, 8032, err); len(recipients) > 0 {
+		details := map[string]string{
+			"entityID1": formatEntityID(entityID1),
+			"entityID2": formatEntityID(entityID2),
+		}
+		client.notify(ctx, recipients, 8032, err, details, entryTime, len(result))
+	}
+	return result, err
+}
+
+/*
+Method WhyRecordInEntity explains why a record belongs to its resolved entitiy.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) WhyRecordInEntity(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	var err error
+	var result string
+	entryTime := time.Now()
+	if client.isTrace {
+		client.traceEntryWithFlags(73, dataSourceCode, recordID, flags)
+		defer func() { client.traceExit(74, dataSourceCode, recordID, flags, result, err, time.Since(entryTime)) }()
+	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "WhyRecordInEntity", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("WhyRecordInEntity", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.WhyRecordInEntity", sztracing.DataSources(dataSourceCode), sztracing.RecordIDs(recordID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
+	result, err = client.whyRecordInEntityV2(ctx, dataSourceCode, recordID, flags)
+	if recipients := client.getRecipients(ctx, 8033, err); len(recipients) > 0 {
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, recipients, 8033, err, details, entryTime, len(result))
+	}
+	return result, err
+}
+
+/*
+Method WhyRecords describes ways in which two records are related to each other.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode1: Identifies the provenance of the data.
+  - recordID1: The unique identifier within the records of the same data source.
+  - dataSourceCode2: Identifies the provenance of the data.
+  - recordID2: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error) {
+	var err error
+	var result string
+	entryTime := time.Now()
+	if client.isTrace {
+		client.traceEntryWithFlags(75, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+		defer func() {
+			client.traceExit(76, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags, result, err, time.Since(entryTime))
+		}()
+	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "WhyRecords", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("WhyRecords", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.WhyRecords", sztracing.DataSources(dataSourceCode1, dataSourceCode2), sztracing.RecordIDs(recordID1, recordID2), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
+	result, err = client.whyRecordsV2(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+	if recipients := client.getRecipients(ctx, 8034, err); len(recipients) > 0 {
+		details := map[string]string{
+			"dataSourceCode1": dataSourceCode1,
+			"recordID1":       recordID1,
+			"dataSourceCode2": dataSourceCode2,
+			"recordID2":       recordID2,
+		}
+		client.notify(ctx, recipients, 8034, err, details, entryTime, len(result))
+	}
+	return result, err
+}
+
+// ----------------------------------------------------------------------------
+// Byte slice and writer methods
+// ----------------------------------------------------------------------------
+
+/*
+Method FindNetworkByEntityIDBytes is [Szengine.FindNetworkByEntityID] returning the JSON document as a byte slice.
+The response is copied once from the Senzing C library, rather than into a string and again into a byte slice.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityIDs: A JSON document listing entities.
+  - maxDegrees: The maximum number of degrees in paths between entityIDs.
+  - buildOutDegrees: The number of degrees of relationships to show around each search entity. Zero (0) prevents buildout.
+  - buildOutMaxEntities: The maximum number of entities to build out in the returned network.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) FindNetworkByEntityIDBytes(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64) ([]byte, error) {
+	var result []byte
+	err := client.findNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, bytesResponse(&result))
+	return result, err
+}
+
+/*
+Method FindNetworkByEntityIDToWriter is [Szengine.FindNetworkByEntityID] writing the JSON document to a writer.
+The response is written directly from the memory of the Senzing C library, without being copied into Go.
+Nothing is written if Senzing returns an error.
+
+Input
+  - ctx: A context to control lifecycle.
+  - writer: Where the JSON document is written, such as an http.ResponseWriter or the writer of an io.Pipe read by a json.Decoder.
+  - entityIDs: A JSON document listing entities.
+  - maxDegrees: The maximum number of degrees in paths between entityIDs.
+  - buildOutDegrees: The number of degrees of relationships to show around each search entity. Zero (0) prevents buildout.
+  - buildOutMaxEntities: The maximum number of entities to build out in the returned network.
+  - flags: Flags used to control information returned.
+
+Output
+  - An error from Senzing or from the writer.
+*/
+func (client *Szengine) FindNetworkByEntityIDToWriter(ctx context.Context, writer io.Writer, entityIDs string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64) error {
+	var writeErr error
+	err := client.findNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, writeResponse(writer, &writeErr))
+	if err == nil {
+		err = writeErr
+	}
+	return err
+}
+
+/*
+Method FindNetworkByRecordIDBytes is [Szengine.FindNetworkByRecordID] returning the JSON document as a byte slice.
+The response is copied once from the Senzing C library, rather than into a string and again into a byte slice.
+
+Input
+  - ctx: A context to control lifecycle.
+  - recordKeys: A JSON document listing records.
+  - maxDegrees: The maximum number of degrees in paths between entities identified by the recordKeys.
+  - buildOutDegrees: The number of degrees of relationships to show around each search entity. Zero (0) prevents buildout.
+  - buildOutMaxEntities: The maximum number of entities to build out in the returned network.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) FindNetworkByRecordIDBytes(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64) ([]byte, error) {
+	var result []byte
+	err := client.findNetworkByRecordID(ctx, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, bytesResponse(&result))
+	return result, err
+}
+
+/*
+Method FindNetworkByRecordIDToWriter is [Szengine.FindNetworkByRecordID] writing the JSON document to a writer.
+The response is written directly from the memory of the Senzing C library, without being copied into Go.
+Nothing is written if Senzing returns an error.
+
+Input
+  - ctx: A context to control lifecycle.
+  - writer: Where the JSON document is written, such as an http.ResponseWriter or the writer of an io.Pipe read by a json.Decoder.
+  - recordKeys: A JSON document listing records.
+  - maxDegrees: The maximum number of degrees in paths between entities identified by the recordKeys.
+  - buildOutDegrees: The number of degrees of relationships to show around each search entity. Zero (0) prevents buildout.
+  - buildOutMaxEntities: The maximum number of entities to build out in the returned network.
+  - flags: Flags used to control information returned.
+
+Output
+  - An error from Senzing or from the writer.
+*/
+func (client *Szengine) FindNetworkByRecordIDToWriter(ctx context.Context, writer io.Writer, recordKeys string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64) error {
+	var writeErr error
+	err := client.findNetworkByRecordID(ctx, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, writeResponse(writer, &writeErr))
+	if err == nil {
+		err = writeErr
+	}
+	return err
+}
+
+/*
+Method FindPathByEntityIDBytes is [Szengine.FindPathByEntityID] returning the JSON document as a byte slice.
+The response is copied once from the Senzing C library, rather than into a string and again into a byte slice.
+
+Input
+  - ctx: A context to control lifecycle.
+  - startEntityID: The entity ID for the starting entity of the search path.
+  - endEntityID: The entity ID for the ending entity of the search path.
+  - maxDegrees: The maximum number of degrees in paths between search entities.
+  - avoidEntityIDs: A JSON document listing entities that should be avoided on the path.
+  - requiredDataSources: A JSON document listing data sources that should be included on the path.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) FindPathByEntityIDBytes(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64) ([]byte, error) {
+	var result []byte
+	err := client.findPathByEntityID(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags, bytesResponse(&result))
 	return result, err
 }
 
 /*
-Method WhyEntities explains the ways in which two entities are related to each other.
+Method FindPathByEntityIDToWriter is [Szengine.FindPathByEntityID] writing the JSON document to a writer.
+The response is written directly from the memory of the Senzing C library, without being copied into Go.
+Nothing is written if Senzing returns an error.
+
+Input
+  - ctx: A context to control lifecycle.
+  - writer: Where the JSON document is written, such as an http.ResponseWriter or the writer of an io.Pipe read by a json.Decoder.
+  - startEntityID: The entity ID for the starting entity of the search path.
+  - endEntityID: The entity ID for the ending entity of the search path.
+  - maxDegrees: The maximum number of degrees in paths between search entities.
+  - avoidEntityIDs: A JSON document listing entities that should be avoided on the path.
+  - requiredDataSources: A JSON document listing data sources that should be included on the path.
+  - flags: Flags used to control information returned.
+
+Output
+  - An error from Senzing or from the writer.
+*/
+func (client *Szengine) FindPathByEntityIDToWriter(ctx context.Context, writer io.Writer, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64) error {
+	var writeErr error
+	err := client.findPathByEntityID(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags, writeResponse(writer, &writeErr))
+	if err == nil {
+		err = writeErr
+	}
+	return err
+}
+
+/*
+Method FindPathByRecordIDBytes is [Szengine.FindPathByRecordID] returning the JSON document as a byte slice.
+The response is copied once from the Senzing C library, rather than into a string and again into a byte slice.
+
+Input
+  - ctx: A context to control lifecycle.
+  - startDataSourceCode: Identifies the provenance of the record for the starting entity of the search path.
+  - startRecordID: The unique identifier within the records of the same data source for the starting entity of the search path.
+  - endDataSourceCode: Identifies the provenance of the record for the ending entity of the search path.
+  - endRecordID: The unique identifier within the records of the same data source for the ending entity of the search path.
+  - maxDegrees: The maximum number of degrees in paths between search entities.
+  - avoidRecordKeys: A JSON document listing entities that should be avoided on the path.
+  - requiredDataSources: A JSON document listing data sources that should be included on the path.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) FindPathByRecordIDBytes(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidRecordKeys string, requiredDataSources string, flags int64) ([]byte, error) {
+	var result []byte
+	err := client.findPathByRecordID(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags, bytesResponse(&result))
+	return result, err
+}
+
+/*
+Method FindPathByRecordIDToWriter is [Szengine.FindPathByRecordID] writing the JSON document to a writer.
+The response is written directly from the memory of the Senzing C library, without being copied into Go.
+Nothing is written if Senzing returns an error.
+
+Input
+  - ctx: A context to control lifecycle.
+  - writer: Where the JSON document is written, such as an http.ResponseWriter or the writer of an io.Pipe read by a json.Decoder.
+  - startDataSourceCode: Identifies the provenance of the record for the starting entity of the search path.
+  - startRecordID: The unique identifier within the records of the same data source for the starting entity of the search path.
+  - endDataSourceCode: Identifies the provenance of the record for the ending entity of the search path.
+  - endRecordID: The unique identifier within the records of the same data source for the ending entity of the search path.
+  - maxDegrees: The maximum number of degrees in paths between search entities.
+  - avoidRecordKeys: A JSON document listing entities that should be avoided on the path.
+  - requiredDataSources: A JSON document listing data sources that should be included on the path.
+  - flags: Flags used to control information returned.
+
+Output
+  - An error from Senzing or from the writer.
+*/
+func (client *Szengine) FindPathByRecordIDToWriter(ctx context.Context, writer io.Writer, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidRecordKeys string, requiredDataSources string, flags int64) error {
+	var writeErr error
+	err := client.findPathByRecordID(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags, writeResponse(writer, &writeErr))
+	if err == nil {
+		err = writeErr
+	}
+	return err
+}
+
+/*
+Method HowEntityByEntityIDBytes is [Szengine.HowEntityByEntityID] returning the JSON document as a byte slice.
+The response is copied once from the Senzing C library, rather than into a string and again into a byte slice.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) HowEntityByEntityIDBytes(ctx context.Context, entityID int64, flags int64) ([]byte, error) {
+	var result []byte
+	err := client.howEntityByEntityID(ctx, entityID, flags, bytesResponse(&result))
+	return result, err
+}
+
+/*
+Method HowEntityByEntityIDToWriter is [Szengine.HowEntityByEntityID] writing the JSON document to a writer.
+The response is written directly from the memory of the Senzing C library, without being copied into Go.
+Nothing is written if Senzing returns an error.
+
+Input
+  - ctx: A context to control lifecycle.
+  - writer: Where the JSON document is written, such as an http.ResponseWriter or the writer of an io.Pipe read by a json.Decoder.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+
+Output
+  - An error from Senzing or from the writer.
+*/
+func (client *Szengine) HowEntityByEntityIDToWriter(ctx context.Context, writer io.Writer, entityID int64, flags int64) error {
+	var writeErr error
+	err := client.howEntityByEntityID(ctx, entityID, flags, writeResponse(writer, &writeErr))
+	if err == nil {
+		err = writeErr
+	}
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Typed methods
+// ----------------------------------------------------------------------------
+
+/*
+Method AddRecordTyped is like [Szengine.AddRecord], but returns the decoded with-info document.
+[senzing.SzWithInfo] is added to flags.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - recordDefinition: A JSON document containing the record to be added to the Senzing datastore.
+  - flags: Flags used to control information returned.
+
+Output
+  - The affected and interesting entities.
+*/
+func (client *Szengine) AddRecordTyped(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (*szmodel.WithInfo, error) {
+	response, err := client.AddRecord(ctx, dataSourceCode, recordID, recordDefinition, flags|senzing.SzWithInfo)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeWithInfo(response)
+}
+
+/*
+Method DeleteRecordTyped is like [Szengine.DeleteRecord], but returns the decoded with-info document.
+[senzing.SzWithInfo] is added to flags.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - The affected and interesting entities.
+*/
+func (client *Szengine) DeleteRecordTyped(ctx context.Context, dataSourceCode string, recordID string, flags int64) (*szmodel.WithInfo, error) {
+	response, err := client.DeleteRecord(ctx, dataSourceCode, recordID, flags|senzing.SzWithInfo)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeWithInfo(response)
+}
+
+/*
+Method FindNetworkByEntityIDTyped is like [Szengine.FindNetworkByEntityID], but returns the decoded document.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityIDs: A JSON document listing entities.
+  - maxDegrees: The maximum number of degrees in paths between entityIDs.
+  - buildOutDegrees: The number of degrees of relationships to show around each search entity.
+  - buildOutMaxEntities: The maximum number of entities to build out in the returned network.
+  - flags: Flags used to control information returned.
+
+Output
+  - The paths, links and entities of the network.
+*/
+func (client *Szengine) FindNetworkByEntityIDTyped(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64) (*szmodel.Network, error) {
+	response, err := client.FindNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeNetwork(response)
+}
+
+/*
+Method FindNetworkByRecordIDTyped is like [Szengine.FindNetworkByRecordID], but returns the decoded document.
+
+Input
+  - ctx: A context to control lifecycle.
+  - recordKeys: A JSON document listing records.
+  - maxDegrees: The maximum number of degrees in paths between entities identified by the recordKeys.
+  - buildOutDegrees: The number of degrees of relationships to show around each search entity.
+  - buildOutMaxEntities: The maximum number of entities to build out in the returned network.
+  - flags: Flags used to control information returned.
+
+Output
+  - The paths, links and entities of the network.
+*/
+func (client *Szengine) FindNetworkByRecordIDTyped(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64) (*szmodel.Network, error) {
+	response, err := client.FindNetworkByRecordID(ctx, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeNetwork(response)
+}
+
+/*
+Method FindPathByEntityIDTyped is like [Szengine.FindPathByEntityID], but returns the decoded document.
+
+Input
+  - ctx: A context to control lifecycle.
+  - startEntityID: The entity ID for the starting entity of the search path.
+  - endEntityID: The entity ID for the ending entity of the search path.
+  - maxDegrees: The maximum number of degrees in paths between search entities.
+  - avoidEntityIDs: A JSON document listing entities that should be avoided on the path.
+  - requiredDataSources: A JSON document listing data sources that should be included on the path.
+  - flags: Flags used to control information returned.
+
+Output
+  - The path, its links and its entities.
+*/
+func (client *Szengine) FindPathByEntityIDTyped(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64) (*szmodel.Path, error) {
+	response, err := client.FindPathByEntityID(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodePath(response)
+}
+
+/*
+Method FindPathByRecordIDTyped is like [Szengine.FindPathByRecordID], but returns the decoded document.
+
+Input
+  - ctx: A context to control lifecycle.
+  - startDataSourceCode: Identifies the provenance of the record for the starting entity of the search path.
+  - startRecordID: The unique identifier within the records of the same data source for the starting entity of the search path.
+  - endDataSourceCode: Identifies the provenance of the record for the ending entity of the search path.
+  - endRecordID: The unique identifier within the records of the same data source for the ending entity of the search path.
+  - maxDegrees: The maximum number of degrees in paths between search entities.
+  - avoidRecordKeys: A JSON document listing entities that should be avoided on the path.
+  - requiredDataSources: A JSON document listing data sources that should be included on the path.
+  - flags: Flags used to control information returned.
+
+Output
+  - The path, its links and its entities.
+*/
+func (client *Szengine) FindPathByRecordIDTyped(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidRecordKeys string, requiredDataSources string, flags int64) (*szmodel.Path, error) {
+	response, err := client.FindPathByRecordID(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodePath(response)
+}
+
+/*
+Method GetEntityByEntityIDTyped is like [Szengine.GetEntityByEntityID], but returns the decoded document.
+Parts of the document not requested by flags are left at their zero values.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+
+Output
+  - The resolved entity and, depending on flags, its related entities.
+*/
+func (client *Szengine) GetEntityByEntityIDTyped(ctx context.Context, entityID int64, flags int64) (*szmodel.Entity, error) {
+	response, err := client.GetEntityByEntityID(ctx, entityID, flags)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeEntity(response)
+}
+
+/*
+Method GetEntityByRecordIDTyped is like [Szengine.GetEntityByRecordID], but returns the decoded document.
+Parts of the document not requested by flags are left at their zero values.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - The resolved entity and, depending on flags, its related entities.
+*/
+func (client *Szengine) GetEntityByRecordIDTyped(ctx context.Context, dataSourceCode string, recordID string, flags int64) (*szmodel.Entity, error) {
+	response, err := client.GetEntityByRecordID(ctx, dataSourceCode, recordID, flags)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeEntity(response)
+}
+
+/*
+Method GetRecordTyped is like [Szengine.GetRecord], but returns the decoded document.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - The record. JSONData is empty unless requested by flags.
+*/
+func (client *Szengine) GetRecordTyped(ctx context.Context, dataSourceCode string, recordID string, flags int64) (*szmodel.Record, error) {
+	response, err := client.GetRecord(ctx, dataSourceCode, recordID, flags)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeRecord(response)
+}
+
+/*
+Method GetStatsTyped is like [Szengine.GetStats], but returns the decoded document.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The workload statistics since the previous call of GetStats.
+*/
+func (client *Szengine) GetStatsTyped(ctx context.Context) (*szmodel.Stats, error) {
+	response, err := client.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeStats(response)
+}
+
+/*
+Method GetVirtualEntityByRecordIDTyped is like [Szengine.GetVirtualEntityByRecordID], but returns the decoded document.
+Parts of the document not requested by flags are left at their zero values.
+
+Input
+  - ctx: A context to control lifecycle.
+  - recordKeys: A JSON document listing records to include in the hypothetical entity.
+  - flags: Flags used to control information returned.
+
+Output
+  - The hypothetical entity.
+*/
+func (client *Szengine) GetVirtualEntityByRecordIDTyped(ctx context.Context, recordKeys string, flags int64) (*szmodel.Entity, error) {
+	response, err := client.GetVirtualEntityByRecordID(ctx, recordKeys, flags)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeEntity(response)
+}
+
+/*
+Method HowEntityByEntityIDTyped is like [Szengine.HowEntityByEntityID], but returns the decoded document.
 
 Input
   - ctx: A context to control lifecycle.
-  - entityID1: The first of two entity IDs.
-  - entityID2: The second of two entity IDs.
+  - entityID: The unique identifier of an entity.
   - flags: Flags used to control information returned.
 
 Output
-  - A JSON document.
+  - The resolution steps and final state of the entity.
 */
-func (client *Szengine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
-	var err error
-	var result string
-	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(71, entityID1, entityID2, flags)
-		defer func() { client.traceExit(72, entityID1, entityID2, flags, result, err, time.Since(entryTime)) }()
+func (client *Szengine) HowEntityByEntityIDTyped(ctx context.Context, entityID int64, flags int64) (*szmodel.HowResponse, error) {
+	response, err := client.HowEntityByEntityID(ctx, entityID, flags)
+	if err != nil {
+		return nil, err
 	}
-	result, err = client.whyEntitiesV2(ctx, entityID1, entityID2, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID1": formatEntityID(entityID1),
-				"entityID2": formatEntityID(entityID2),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8032, err, details)
-		}()
+	return szmodel.DecodeHow(response)
+}
+
+/*
+Method ProcessRedoRecordTyped is like [Szengine.ProcessRedoRecord], but returns the decoded with-info document.
+[senzing.SzWithInfo] is added to flags.
+
+Input
+  - ctx: A context to control lifecycle.
+  - redoRecord: A redo record retrieved by [Szengine.GetRedoRecord].
+  - flags: Flags used to control information returned.
+
+Output
+  - The affected and interesting entities.
+*/
+func (client *Szengine) ProcessRedoRecordTyped(ctx context.Context, redoRecord string, flags int64) (*szmodel.WithInfo, error) {
+	response, err := client.ProcessRedoRecord(ctx, redoRecord, flags|senzing.SzWithInfo)
+	if err != nil {
+		return nil, err
 	}
-	return result, err
+	return szmodel.DecodeWithInfo(response)
 }
 
 /*
-Method WhyRecordInEntity explains why a record belongs to its resolved entitiy.
+Method ReevaluateEntityTyped is like [Szengine.ReevaluateEntity], but returns the decoded with-info document.
+[senzing.SzWithInfo] is added to flags.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+
+Output
+  - The affected and interesting entities.
+*/
+func (client *Szengine) ReevaluateEntityTyped(ctx context.Context, entityID int64, flags int64) (*szmodel.WithInfo, error) {
+	response, err := client.ReevaluateEntity(ctx, entityID, flags|senzing.SzWithInfo)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeWithInfo(response)
+}
+
+/*
+Method ReevaluateRecordTyped is like [Szengine.ReevaluateRecord], but returns the decoded with-info document.
+[senzing.SzWithInfo] is added to flags.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1232,31 +1965,80 @@ Input
   - flags: Flags used to control information returned.
 
 Output
-  - A JSON document.
+  - The affected and interesting entities.
 */
-func (client *Szengine) WhyRecordInEntity(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
-	var err error
-	var result string
-	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(73, dataSourceCode, recordID, flags)
-		defer func() { client.traceExit(74, dataSourceCode, recordID, flags, result, err, time.Since(entryTime)) }()
+func (client *Szengine) ReevaluateRecordTyped(ctx context.Context, dataSourceCode string, recordID string, flags int64) (*szmodel.WithInfo, error) {
+	response, err := client.ReevaluateRecord(ctx, dataSourceCode, recordID, flags|senzing.SzWithInfo)
+	if err != nil {
+		return nil, err
 	}
-	result, err = client.whyRecordInEntityV2(ctx, dataSourceCode, recordID, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8033, err, details)
-		}()
+	return szmodel.DecodeWithInfo(response)
+}
+
+/*
+Method SearchByAttributesTyped is like [Szengine.SearchByAttributes], but returns the decoded document.
+The search profile is used exactly as by [Szengine.SearchByAttributes].
+
+Input
+  - ctx: A context to control lifecycle.
+  - attributes: A JSON document containing the attributes desired in the result set.
+  - searchProfile: The name of the search profile to use in the search.
+    An empty string will use the default search profile.
+  - flags: Flags used to control information returned.
+
+Output
+  - The search results, which can be ranked and filtered.
+*/
+func (client *Szengine) SearchByAttributesTyped(ctx context.Context, attributes string, searchProfile string, flags int64) (*szmodel.SearchResponse, error) {
+	response, err := client.SearchByAttributes(ctx, attributes, searchProfile, flags)
+	if err != nil {
+		return nil, err
 	}
-	return result, err
+	return szmodel.DecodeSearch(response)
 }
 
 /*
-Method WhyRecords describes ways in which two records are related to each other.
+Method WhyEntitiesTyped is like [Szengine.WhyEntities], but returns the decoded document.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID1: The entity ID for the starting entity of the search path.
+  - entityID2: The entity ID for the ending entity of the search path.
+  - flags: Flags used to control information returned.
+
+Output
+  - The reasons the entities did or did not resolve.
+*/
+func (client *Szengine) WhyEntitiesTyped(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (*szmodel.WhyResponse, error) {
+	response, err := client.WhyEntities(ctx, entityID1, entityID2, flags)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeWhy(response)
+}
+
+/*
+Method WhyRecordInEntityTyped is like [Szengine.WhyRecordInEntity], but returns the decoded document.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - The reasons the record is in its entity.
+*/
+func (client *Szengine) WhyRecordInEntityTyped(ctx context.Context, dataSourceCode string, recordID string, flags int64) (*szmodel.WhyResponse, error) {
+	response, err := client.WhyRecordInEntity(ctx, dataSourceCode, recordID, flags)
+	if err != nil {
+		return nil, err
+	}
+	return szmodel.DecodeWhy(response)
+}
+
+/*
+Method WhyRecordsTyped is like [Szengine.WhyRecords], but returns the decoded document.
 
 Input
   - ctx: A context to control lifecycle.
@@ -1267,37 +2049,38 @@ Input
   - flags: Flags used to control information returned.
 
 Output
-  - A JSON document.
+  - The reasons the records did or did not resolve.
 */
-func (client *Szengine) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error) {
-	var err error
-	var result string
-	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(75, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
-		defer func() {
-			client.traceExit(76, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags, result, err, time.Since(entryTime))
-		}()
-	}
-	result, err = client.whyRecordsV2(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode1": dataSourceCode1,
-				"recordID1":       recordID1,
-				"dataSourceCode2": dataSourceCode2,
-				"recordID2":       recordID2,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8034, err, details)
-		}()
+func (client *Szengine) WhyRecordsTyped(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (*szmodel.WhyResponse, error) {
+	response, err := client.WhyRecords(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+	if err != nil {
+		return nil, err
 	}
-	return result, err
+	return szmodel.DecodeWhy(response)
 }
 
 // ----------------------------------------------------------------------------
 // Public non-interface methods
 // ----------------------------------------------------------------------------
 
+/*
+Method GetCallStatistics returns the number of calls, failures and latencies of each method
+called since the Szengine was created or ResetCallStatistics was called.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The statistics of the component, which callstats.Write prints as a table.
+*/
+func (client *Szengine) GetCallStatistics(ctx context.Context) callstats.Report {
+	_ = ctx
+	return callstats.Report{
+		ComponentID: ComponentID,
+		Methods:     client.callStatistics.Snapshot(),
+	}
+}
+
 /*
 Method GetObserverOrigin returns the "origin" value of past Observer messages.
 
@@ -1325,28 +2108,35 @@ Input
 */
 func (client *Szengine) Initialize(ctx context.Context, instanceName string, settings string, configID int64, verboseLogging int64) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(55, instanceName, settings, configID, verboseLogging)
 		defer func() {
 			client.traceExit(56, instanceName, settings, configID, verboseLogging, err, time.Since(entryTime))
 		}()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Initialize", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Initialize", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.Initialize")
+		defer sztracing.End(ctx, &err)
+	}
 	if configID > 0 {
 		err = client.initWithConfigID(ctx, instanceName, settings, configID, verboseLogging)
 	} else {
 		err = client.init(ctx, instanceName, settings, verboseLogging)
 	}
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"configID":       strconv.FormatInt(configID, baseTen),
-				"instanceName":   instanceName,
-				"settings":       settings,
-				"verboseLogging": strconv.FormatInt(verboseLogging, baseTen),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8025, err, details)
-		}()
+	helper.ResetActiveConfigID()
+	if recipients := client.getRecipients(ctx, 8025, err); len(recipients) > 0 {
+		details := map[string]string{
+			"configID":       strconv.FormatInt(configID, baseTen),
+			"instanceName":   instanceName,
+			"settings":       settings,
+			"verboseLogging": strconv.FormatInt(verboseLogging, baseTen),
+		}
+		client.notify(ctx, recipients, 8025, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -1360,66 +2150,233 @@ Input
 */
 func (client *Szengine) RegisterObserver(ctx context.Context, observer observer.Observer) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(703, observer.GetObserverID(ctx))
 		defer func() { client.traceExit(704, observer.GetObserverID(ctx), err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "RegisterObserver", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("RegisterObserver", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.RegisterObserver")
+		defer sztracing.End(ctx, &err)
+	}
 	if client.observers == nil {
 		client.observers = &subject.SimpleSubject{}
 	}
 	err = client.observers.RegisterObserver(ctx, observer)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"observerID": observer.GetObserverID(ctx),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8702, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8702, err); len(recipients) > 0 {
+		details := map[string]string{
+			"observerID": observer.GetObserverID(ctx),
+		}
+		client.notify(ctx, recipients, 8702, err, details, entryTime, notification.NoResult)
+	}
+	return err
+}
+
+/*
+Method RegisterObserverWithFilter adds the observer to the list of observers notified,
+sending it only the notifications accepted by the filter.
+The filter is applied before the details of a notification are gathered,
+so rejected notifications cost the observer nothing.
+Registering an observer again replaces its filter.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+  - filter: The notifications sent to the observer, such as notification.MessageIDs(8001) or notification.Errors. nil for all.
+*/
+func (client *Szengine) RegisterObserverWithFilter(ctx context.Context, observer observer.Observer, filter notification.Filter) error {
+	client.setFilter(ctx, observer, filter)
+	return client.RegisterObserver(ctx, observer)
+}
+
+/*
+Method ResetCallStatistics forgets the calls counted by GetCallStatistics.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szengine) ResetCallStatistics(ctx context.Context) {
+	_ = ctx
+	client.callStatistics.Reset()
+}
+
+/*
+Method SetAuditLog sets the audit log recording future calls of AddRecord, DeleteRecord, ProcessRedoRecord,
+ReevaluateEntity and ReevaluateRecord.
+The outcome of a call does not depend on the audit log: entries that cannot be appended
+are reported to the error handler of the log, as set by its SetErrorHandler method.
+
+Input
+  - ctx: A context to control lifecycle.
+  - auditLog: The audit log, as described in the audit package. nil records nothing.
+*/
+func (client *Szengine) SetAuditLog(ctx context.Context, auditLog *audit.Log) {
+	_ = ctx
+	client.auditLog = auditLog
+}
+
+/*
+Method SetDispatcher sets the dispatcher delivering future notifications to observers,
+then waits until the notifications queued by the previous dispatcher are delivered.
+
+Input
+  - ctx: A context to control lifecycle.
+  - notificationDispatcher: The dispatcher. nil restores a default one, with a blocking queue of dispatcher.DefaultCapacity.
+*/
+func (client *Szengine) SetDispatcher(ctx context.Context, notificationDispatcher *dispatcher.Dispatcher) error {
+	client.dispatcherLock.Lock()
+	previous := client.dispatcher
+	client.dispatcher = notificationDispatcher
+	client.dispatcherLock.Unlock()
+	if previous == nil {
+		return nil
+	}
+	return previous.Flush(ctx)
+}
+
+/*
+Method SetLogLevel sets the level of logging.
+
+Input
+  - ctx: A context to control lifecycle.
+  - logLevelName: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
+*/
+func (client *Szengine) SetLogLevel(ctx context.Context, logLevelName string) error {
+	var err error
+	entryTime := time.Now()
+	if client.isTrace {
+		client.traceEntry(705, logLevelName)
+		defer func() { client.traceExit(706, logLevelName, err, time.Since(entryTime)) }()
+	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "SetLogLevel", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("SetLogLevel", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.SetLogLevel")
+		defer sztracing.End(ctx, &err)
+	}
+	if !logging.IsValidLogLevelName(logLevelName) {
+		err = fmt.Errorf("invalid error level: %s", logLevelName)
+		return err
+	}
+	err = client.getLogger().SetLogLevel(logLevelName)
+	client.isTrace = (logLevelName == logging.LevelTraceName)
+	if recipients := client.getRecipients(ctx, 8703, err); len(recipients) > 0 {
+		details := map[string]string{
+			"logLevelName": logLevelName,
+		}
+		client.notify(ctx, recipients, 8703, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
 
 /*
-Method SetLogLevel sets the level of logging.
+Method SetLogger writes future log messages to an slog logger, keeping the current log level.
+
+Input
+  - ctx: A context to control lifecycle.
+  - logger: Where log messages are written, as described in package szslog. nil restores the default logging.
+*/
+func (client *Szengine) SetLogger(ctx context.Context, logger *slog.Logger) {
+	_ = ctx
+	logLevelName := client.getLogger().GetLogLevel()
+	if logger == nil {
+		client.logger = helper.GetLogger(ComponentID, szengine.IDMessages, baseCallerSkip)
+	} else {
+		client.logger = szslog.New(ComponentID, szengine.IDMessages, logger)
+	}
+	client.panicOnError(client.logger.SetLogLevel(logLevelName))
+}
+
+/*
+Method SetMetrics records future method calls in metrics,
+and adds the open export handles of the Szengine to the "sz_open_export_handles" gauge.
+Engines sharing metrics are summed in the gauge.
+
+Input
+  - ctx: A context to control lifecycle.
+  - metrics: Where calls are recorded. nil stops recording.
+*/
+func (client *Szengine) SetMetrics(ctx context.Context, metrics *szmetrics.Metrics) {
+	_ = ctx
+	if client.metrics != nil && client.metrics != metrics {
+		client.metrics.UnregisterGaugeSource(openExportHandlesGauge, client)
+	}
+	client.metrics = metrics
+	if metrics != nil {
+		metrics.RegisterGaugeSource(openExportHandlesGauge, "Export handles opened and not yet closed.", client, func(ctx context.Context) (float64, error) {
+			_ = ctx
+			return float64(client.countExportHandles()), nil
+		})
+	}
+}
+
+/*
+Method SetNotificationDetails chooses the optional details added to future Observer messages,
+as described in the notification package.
+
+Input
+  - ctx: A context to control lifecycle.
+  - details: The optional details, such as notification.Duration|notification.RequestID. notification.NoDetails, the default, adds none.
+*/
+func (client *Szengine) SetNotificationDetails(ctx context.Context, details notification.Details) {
+	_ = ctx
+	client.notificationDetails = details
+}
+
+/*
+Method SetObserverOrigin sets the "origin" value in future Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - origin: The value sent in the Observer's "origin" key/value pair.
+*/
+func (client *Szengine) SetObserverOrigin(ctx context.Context, origin string) {
+	_ = ctx
+	client.observerOrigin = origin
+}
+
+/*
+Method SetRedaction sets the policy masking personal data and secrets
+in future errors, trace logs and Observer messages.
 
 Input
   - ctx: A context to control lifecycle.
-  - logLevelName: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
+  - policy: What is redacted, as described in the redaction package. nil redacts nothing.
 */
-func (client *Szengine) SetLogLevel(ctx context.Context, logLevelName string) error {
-	var err error
-	if client.isTrace {
-		entryTime := time.Now()
-		client.traceEntry(705, logLevelName)
-		defer func() { client.traceExit(706, logLevelName, err, time.Since(entryTime)) }()
-	}
-	if !logging.IsValidLogLevelName(logLevelName) {
-		return fmt.Errorf("invalid error level: %s", logLevelName)
-	}
-	err = client.getLogger().SetLogLevel(logLevelName)
-	client.isTrace = (logLevelName == logging.LevelTraceName)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"logLevelName": logLevelName,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8703, err, details)
-		}()
-	}
-	return err
+func (client *Szengine) SetRedaction(ctx context.Context, policy *redaction.Policy) {
+	_ = ctx
+	client.redaction = policy
 }
 
 /*
-Method SetObserverOrigin sets the "origin" value in future Observer messages.
+Method SetTraceFlagNames sets whether trace entries of methods with a flags parameter
+include the names of the flags, as decoded by [szflags.String].
 
 Input
   - ctx: A context to control lifecycle.
-  - origin: The value sent in the Observer's "origin" key/value pair.
+  - enabled: True to add a "flagNames" detail to trace entries.
 */
-func (client *Szengine) SetObserverOrigin(ctx context.Context, origin string) {
+func (client *Szengine) SetTraceFlagNames(ctx context.Context, enabled bool) {
 	_ = ctx
-	client.observerOrigin = origin
+	client.isTraceFlagNames = enabled
+}
+
+/*
+Method SetTracer starts a span around each future method call.
+
+Input
+  - ctx: A context to control lifecycle.
+  - tracer: The tracer starting the spans, such as sztracing.Tracer(otel.GetTracerProvider()). nil stops tracing.
+*/
+func (client *Szengine) SetTracer(ctx context.Context, tracer trace.Tracer) {
+	_ = ctx
+	client.tracer = tracer
 }
 
 /*
@@ -1431,21 +2388,30 @@ Input
 */
 func (client *Szengine) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(707, observer.GetObserverID(ctx))
 		defer func() { client.traceExit(708, observer.GetObserverID(ctx), err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "UnregisterObserver", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("UnregisterObserver", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.UnregisterObserver")
+		defer sztracing.End(ctx, &err)
+	}
 	if client.observers != nil {
-		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
-		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
-		details := map[string]string{
-			"observerID": observer.GetObserverID(ctx),
+		// The notification is queued for the observers registered before this one is removed,
+		// so the observer being removed is notified too.
+		if recipients := client.getRecipients(ctx, 8704, err); len(recipients) > 0 {
+			details := map[string]string{
+				"observerID": observer.GetObserverID(ctx),
+			}
+			client.notify(ctx, recipients, 8704, err, details, entryTime, notification.NoResult)
 		}
-		notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8704, err, details)
 		err = client.observers.UnregisterObserver(ctx, observer)
+		client.setFilter(ctx, observer, nil)
 		if !client.observers.HasObservers(ctx) {
 			client.observers = nil
 		}
@@ -1523,6 +2489,8 @@ func (client *Szengine) closeExport(ctx context.Context, exportHandle uintptr) e
 	result := C.Sz_closeExport_helper(C.uintptr_t(exportHandle))
 	if result != noError {
 		err = client.newError(ctx, 4003, exportHandle, result)
+	} else {
+		client.removeExportHandle(exportHandle)
 	}
 	return err
 }
@@ -1613,6 +2581,8 @@ func (client *Szengine) exportCsvEntityReport(ctx context.Context, csvColumnList
 	result := C.Sz_exportCSVEntityReport_helper(csvColumnListForC, C.longlong(flags))
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4007, csvColumnList, flags, result.returnCode, result)
+	} else {
+		client.addExportHandle((uintptr)(result.exportHandle))
 	}
 	resultExportHandle = (uintptr)(result.exportHandle)
 	return resultExportHandle, err
@@ -1626,6 +2596,8 @@ func (client *Szengine) exportJSONEntityReport(ctx context.Context, flags int64)
 	result := C.Sz_exportJSONEntityReport_helper(C.longlong(flags))
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4008, flags, result.returnCode, result)
+	} else {
+		client.addExportHandle((uintptr)(result.exportHandle))
 	}
 	resultExportHandle = (uintptr)(result.exportHandle)
 	return resultExportHandle, err
@@ -1677,36 +2649,163 @@ func (client *Szengine) findInterestingEntitiesByRecordID(ctx context.Context, d
 	return resultResponse, err
 }
 
-func (client *Szengine) findNetworkByEntityIDV2(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64) (string, error) {
+// Trace, validate and observe FindNetworkByEntityID, passing the response to handleResponse.
+func (client *Szengine) findNetworkByEntityID(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64, handleResponse responseHandler) error {
+	var err error
+	var result string
+	entryTime := time.Now()
+	if client.isTrace {
+		client.traceEntryWithFlags(27, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+		handleResponse = tracedResponse(&result, handleResponse)
+		defer func() {
+			client.traceExit(28, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, result, err, time.Since(entryTime))
+		}()
+	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "FindNetworkByEntityID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("FindNetworkByEntityID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.FindNetworkByEntityID", sztracing.EntityIDsDocument(entityIDs), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
+	resultSize := notification.NoResult
+	if client.observers != nil && client.notificationDetails&notification.ResultSize != 0 {
+		handleResponse = sizedResponse(&resultSize, handleResponse)
+	}
+	err = szargs.ValidateEntityIDs("entityIDs", entityIDs)
+	if err == nil {
+		err = client.findNetworkByEntityIDV2(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, handleResponse)
+	}
+	if recipients := client.getRecipients(ctx, 8013, err); len(recipients) > 0 {
+		details := map[string]string{
+			"entityIDs": entityIDs,
+		}
+		client.notify(ctx, recipients, 8013, err, details, entryTime, resultSize)
+	}
+	return err
+}
+
+func (client *Szengine) findNetworkByEntityIDV2(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64, handleResponse responseHandler) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	var err error
-	var resultResponse string
 	entityListForC := C.CString(entityIDs)
 	defer C.free(unsafe.Pointer(entityListForC))
 	result := C.Sz_findNetworkByEntityID_V2_helper(entityListForC, C.longlong(maxDegrees), C.longlong(buildOutDegrees), C.longlong(buildOutMaxEntities), C.longlong(flags))
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4013, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, result.returnCode)
 	}
-	resultResponse = C.GoString(result.response)
+	if err == nil {
+		handleResponse(result.response)
+	}
 	C.SzHelper_free(unsafe.Pointer(result.response))
-	return resultResponse, err
+	return err
+}
+
+// Trace, validate and observe FindNetworkByRecordID, passing the response to handleResponse.
+func (client *Szengine) findNetworkByRecordID(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64, handleResponse responseHandler) error {
+	var err error
+	var result string
+	entryTime := time.Now()
+	if client.isTrace {
+		client.traceEntryWithFlags(39, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+		handleResponse = tracedResponse(&result, handleResponse)
+		defer func() {
+			client.traceExit(40, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, result, err, time.Since(entryTime))
+		}()
+	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "FindNetworkByRecordID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("FindNetworkByRecordID", entryTime, &err)
+	if client.tracer != nil {
+		dataSources, recordIDs := sztracing.RecordKeysDocument(recordKeys)
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.FindNetworkByRecordID", dataSources, recordIDs, sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
+	resultSize := notification.NoResult
+	if client.observers != nil && client.notificationDetails&notification.ResultSize != 0 {
+		handleResponse = sizedResponse(&resultSize, handleResponse)
+	}
+	err = szargs.ValidateRecordKeys("recordKeys", recordKeys)
+	if err == nil {
+		err = client.findNetworkByRecordIDV2(ctx, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, handleResponse)
+	}
+	if recipients := client.getRecipients(ctx, 8014, err); len(recipients) > 0 {
+		details := map[string]string{
+			"recordKeys": recordKeys,
+		}
+		client.notify(ctx, recipients, 8014, err, details, entryTime, resultSize)
+	}
+	return err
 }
 
-func (client *Szengine) findNetworkByRecordIDV2(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64) (string, error) {
+func (client *Szengine) findNetworkByRecordIDV2(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegrees int64, buildOutMaxEntities int64, flags int64, handleResponse responseHandler) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	var err error
-	var resultResponse string
 	recordListForC := C.CString(recordKeys)
 	defer C.free(unsafe.Pointer(recordListForC))
 	result := C.Sz_findNetworkByRecordID_V2_helper(recordListForC, C.longlong(maxDegrees), C.longlong(buildOutDegrees), C.longlong(buildOutMaxEntities), C.longlong(flags))
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4015, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags, result.returnCode)
 	}
-	resultResponse = C.GoString(result.response)
+	if err == nil {
+		handleResponse(result.response)
+	}
 	C.SzHelper_free(unsafe.Pointer(result.response))
-	return resultResponse, err
+	return err
+}
+
+// Trace, validate and observe FindPathByEntityID, passing the response to handleResponse.
+func (client *Szengine) findPathByEntityID(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64, handleResponse responseHandler) error {
+	var err error
+	var result string
+	entryTime := time.Now()
+	if client.isTrace {
+		client.traceEntryWithFlags(31, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags)
+		handleResponse = tracedResponse(&result, handleResponse)
+		defer func() {
+			client.traceExit(32, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags, result, err, time.Since(entryTime))
+		}()
+	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "FindPathByEntityID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("FindPathByEntityID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.FindPathByEntityID", sztracing.EntityIDs(startEntityID, endEntityID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
+	resultSize := notification.NoResult
+	if client.observers != nil && client.notificationDetails&notification.ResultSize != 0 {
+		handleResponse = sizedResponse(&resultSize, handleResponse)
+	}
+	err = errors.Join(
+		szargs.ValidateEntityIDs("avoidEntityIDs", avoidEntityIDs),
+		szargs.ValidateDataSources("requiredDataSources", requiredDataSources),
+	)
+	switch {
+	case err != nil:
+		// Malformed arguments are not passed to Senzing.
+	case len(requiredDataSources) > 0:
+		err = client.findPathByEntityIDIncludingSourceV2(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags, handleResponse)
+	case len(avoidEntityIDs) > 0:
+		err = client.findPathByEntityIDWithAvoidsV2(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, flags, handleResponse)
+	default:
+		err = client.findPathByEntityIDV2(ctx, startEntityID, endEntityID, maxDegrees, flags, handleResponse)
+	}
+	if recipients := client.getRecipients(ctx, 8015, err); len(recipients) > 0 {
+		details := map[string]string{
+			"startEntityID":       formatEntityID(startEntityID),
+			"endEntityID":         formatEntityID(endEntityID),
+			"avoidEntityIDs":      avoidEntityIDs,
+			"requiredDataSources": requiredDataSources,
+		}
+		client.notify(ctx, recipients, 8015, err, details, entryTime, resultSize)
+	}
+	return err
 }
 
 /*
@@ -1719,25 +2818,79 @@ Input
   - endEntityID: The entity ID for the ending entity of the search path.
   - maxDegrees: The maximum number of degrees in paths between search entities.
   - flags: Flags used to control information returned.
+  - handleResponse: Receives the JSON document before it is freed.
 
 Output
 
-  - A JSON document.  Example:
+  - The JSON document passed to handleResponse.  Example:
 
     `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 14:43:49.024","LAST_SEEN_DT":"2022-12-06 14:43:49.164"}],"LAST_SEEN_DT":"2022-12-06 14:43:49.164"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:43:49.104","LAST_SEEN_DT":"2022-12-06 14:43:49.104"}],"LAST_SEEN_DT":"2022-12-06 14:43:49.104"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
-func (client *Szengine) findPathByEntityIDV2(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, flags int64) (string, error) {
+func (client *Szengine) findPathByEntityIDV2(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, flags int64, handleResponse responseHandler) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	var err error
-	var resultResponse string
 	result := C.Sz_findPathByEntityID_V2_helper(C.longlong(startEntityID), C.longlong(endEntityID), C.longlong(maxDegrees), C.longlong(flags))
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4017, startEntityID, endEntityID, maxDegrees, flags, result.returnCode)
 	}
-	resultResponse = C.GoString(result.response)
+	if err == nil {
+		handleResponse(result.response)
+	}
 	C.SzHelper_free(unsafe.Pointer(result.response))
-	return resultResponse, err
+	return err
+}
+
+// Trace, validate and observe FindPathByRecordID, passing the response to handleResponse.
+func (client *Szengine) findPathByRecordID(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidRecordKeys string, requiredDataSources string, flags int64, handleResponse responseHandler) error {
+	var err error
+	var result string
+	entryTime := time.Now()
+	if client.isTrace {
+		client.traceEntryWithFlags(33, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags)
+		handleResponse = tracedResponse(&result, handleResponse)
+		defer func() {
+			client.traceExit(34, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags, result, err, time.Since(entryTime))
+		}()
+	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "FindPathByRecordID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("FindPathByRecordID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.FindPathByRecordID", sztracing.DataSources(startDataSourceCode, endDataSourceCode), sztracing.RecordIDs(startRecordID, endRecordID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
+	resultSize := notification.NoResult
+	if client.observers != nil && client.notificationDetails&notification.ResultSize != 0 {
+		handleResponse = sizedResponse(&resultSize, handleResponse)
+	}
+	err = errors.Join(
+		szargs.ValidateRecordKeys("avoidRecordKeys", avoidRecordKeys),
+		szargs.ValidateDataSources("requiredDataSources", requiredDataSources),
+	)
+	switch {
+	case err != nil:
+		// Malformed arguments are not passed to Senzing.
+	case len(requiredDataSources) > 0:
+		err = client.findPathByRecordIDIncludingSourceV2(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags, handleResponse)
+	case len(avoidRecordKeys) > 0:
+		err = client.findPathByRecordIDWithAvoidsV2(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, flags, handleResponse)
+	default:
+		err = client.findPathByRecordIDV2(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, flags, handleResponse)
+	}
+	if recipients := client.getRecipients(ctx, 8016, err); len(recipients) > 0 {
+		details := map[string]string{
+			"startDataSourceCode": startDataSourceCode,
+			"startRecordID":       startRecordID,
+			"endDataSourceCode":   endDataSourceCode,
+			"endRecordID":         endRecordID,
+			"avoidRecordKeys":     avoidRecordKeys,
+			"requiredDataSources": requiredDataSources,
+		}
+		client.notify(ctx, recipients, 8016, err, details, entryTime, resultSize)
+	}
+	return err
 }
 
 /*
@@ -1754,15 +2907,12 @@ Input
   - endRecordID: The unique identifier within the records of the same data source for the ending entity of the search path.
   - maxDegrees: The maximum number of degrees in paths between search entities.
   - flags: Flags used to control information returned.
-
-Output
-  - A JSON document.
+  - handleResponse: Receives the JSON document before it is freed.
 */
-func (client *Szengine) findPathByRecordIDV2(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, flags int64) (string, error) {
+func (client *Szengine) findPathByRecordIDV2(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, flags int64, handleResponse responseHandler) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	var err error
-	var resultResponse string
 	startDataSourceCodeForC := C.CString(startDataSourceCode)
 	defer C.free(unsafe.Pointer(startDataSourceCodeForC))
 	startRecordIDForC := C.CString(startRecordID)
@@ -1775,9 +2925,11 @@ func (client *Szengine) findPathByRecordIDV2(ctx context.Context, startDataSourc
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4019, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, flags, result.returnCode)
 	}
-	resultResponse = C.GoString(result.response)
+	if err == nil {
+		handleResponse(result.response)
+	}
 	C.SzHelper_free(unsafe.Pointer(result.response))
-	return resultResponse, err
+	return err
 }
 
 /*
@@ -1797,24 +2949,23 @@ Input
   - maxDegrees: The maximum number of degrees in paths between search entities.
   - avoidedEntities: A JSON document listing entities that should be avoided on the path.
   - flags: Flags used to control information returned.
-
-Output
-  - A JSON document.
+  - handleResponse: Receives the JSON document before it is freed.
 */
-func (client *Szengine) findPathByEntityIDWithAvoidsV2(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidedEntities string, flags int64) (string, error) {
+func (client *Szengine) findPathByEntityIDWithAvoidsV2(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidedEntities string, flags int64, handleResponse responseHandler) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	var err error
-	var resultResponse string
 	avoidedEntitiesForC := C.CString(avoidedEntities)
 	defer C.free(unsafe.Pointer(avoidedEntitiesForC))
 	result := C.Sz_findPathByEntityIDWithAvoids_V2_helper(C.longlong(startEntityID), C.longlong(endEntityID), C.longlong(maxDegrees), avoidedEntitiesForC, C.longlong(flags))
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4021, startEntityID, endEntityID, maxDegrees, avoidedEntities, flags, result.returnCode)
 	}
-	resultResponse = C.GoString(result.response)
+	if err == nil {
+		handleResponse(result.response)
+	}
 	C.SzHelper_free(unsafe.Pointer(result.response))
-	return resultResponse, err
+	return err
 }
 
 /*
@@ -1837,15 +2988,12 @@ Input
   - maxDegrees: The maximum number of degrees in paths between search entities.
   - avoidedRecords: A JSON document listing records that should be avoided on the path.
   - flags: Flags used to control information returned.
-
-Output
-  - A JSON document.
+  - handleResponse: Receives the JSON document before it is freed.
 */
-func (client *Szengine) findPathByRecordIDWithAvoidsV2(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidedRecords string, flags int64) (string, error) {
+func (client *Szengine) findPathByRecordIDWithAvoidsV2(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidedRecords string, flags int64, handleResponse responseHandler) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	var err error
-	var resultResponse string
 	startDataSourceCodeForC := C.CString(startDataSourceCode)
 	defer C.free(unsafe.Pointer(startDataSourceCodeForC))
 	startRecordIDForC := C.CString(startRecordID)
@@ -1860,9 +3008,11 @@ func (client *Szengine) findPathByRecordIDWithAvoidsV2(ctx context.Context, star
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4023, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidedRecords, flags, result.returnCode)
 	}
-	resultResponse = C.GoString(result.response)
+	if err == nil {
+		handleResponse(result.response)
+	}
 	C.SzHelper_free(unsafe.Pointer(result.response))
-	return resultResponse, err
+	return err
 }
 
 /*
@@ -1880,15 +3030,12 @@ Input
   - avoidedEntities: A JSON document listing entities that should be avoided on the path.
   - requiredDataSources: A JSON document listing data sources that should be included on the path.
   - flags: Flags used to control information returned.
-
-Output
-  - A JSON document.
+  - handleResponse: Receives the JSON document before it is freed.
 */
-func (client *Szengine) findPathByEntityIDIncludingSourceV2(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidedEntities string, requiredDataSources string, flags int64) (string, error) {
+func (client *Szengine) findPathByEntityIDIncludingSourceV2(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidedEntities string, requiredDataSources string, flags int64, handleResponse responseHandler) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	var err error
-	var resultResponse string
 	avoidedEntitiesForC := C.CString(avoidedEntities)
 	defer C.free(unsafe.Pointer(avoidedEntitiesForC))
 	requiredDataSourcesForC := C.CString(requiredDataSources)
@@ -1897,9 +3044,11 @@ func (client *Szengine) findPathByEntityIDIncludingSourceV2(ctx context.Context,
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4025, startEntityID, endEntityID, maxDegrees, avoidedEntities, requiredDataSources, flags, result.returnCode)
 	}
-	resultResponse = C.GoString(result.response)
+	if err == nil {
+		handleResponse(result.response)
+	}
 	C.SzHelper_free(unsafe.Pointer(result.response))
-	return resultResponse, err
+	return err
 }
 
 /*
@@ -1919,15 +3068,12 @@ Input
   - avoidedRecords: A JSON document listing records that should be avoided on the path.
   - requiredDataSources: A JSON document listing data sources that should be included on the path.
   - flags: Flags used to control information returned.
-
-Output
-  - A JSON document.
+  - handleResponse: Receives the JSON document before it is freed.
 */
-func (client *Szengine) findPathByRecordIDIncludingSourceV2(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidedRecords string, requiredDataSources string, flags int64) (string, error) {
+func (client *Szengine) findPathByRecordIDIncludingSourceV2(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidedRecords string, requiredDataSources string, flags int64, handleResponse responseHandler) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	var err error
-	var resultResponse string
 	startDataSourceCodeForC := C.CString(startDataSourceCode)
 	defer C.free(unsafe.Pointer(startDataSourceCodeForC))
 	startRecordIDForC := C.CString(startRecordID)
@@ -1944,9 +3090,11 @@ func (client *Szengine) findPathByRecordIDIncludingSourceV2(ctx context.Context,
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4027, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidedRecords, requiredDataSources, flags, result.returnCode)
 	}
-	resultResponse = C.GoString(result.response)
+	if err == nil {
+		handleResponse(result.response)
+	}
 	C.SzHelper_free(unsafe.Pointer(result.response))
-	return resultResponse, err
+	return err
 }
 
 func (client *Szengine) getActiveConfigID(ctx context.Context) (int64, error) {
@@ -2056,18 +3204,51 @@ func (client *Szengine) getVirtualEntityByRecordIDV2(ctx context.Context, record
 	return resultResponse, err
 }
 
-func (client *Szengine) howEntityByEntityIDV2(ctx context.Context, entityID int64, flags int64) (string, error) {
+// Trace, validate and observe HowEntityByEntityID, passing the response to handleResponse.
+func (client *Szengine) howEntityByEntityID(ctx context.Context, entityID int64, flags int64, handleResponse responseHandler) error {
+	var err error
+	var result string
+	entryTime := time.Now()
+	if client.isTrace {
+		client.traceEntryWithFlags(53, entityID, flags)
+		handleResponse = tracedResponse(&result, handleResponse)
+		defer func() { client.traceExit(54, entityID, flags, result, err, time.Since(entryTime)) }()
+	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "HowEntityByEntityID", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("HowEntityByEntityID", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szengine.HowEntityByEntityID", sztracing.EntityIDs(entityID), sztracing.Flags(flags))
+		defer sztracing.End(ctx, &err)
+	}
+	resultSize := notification.NoResult
+	if client.observers != nil && client.notificationDetails&notification.ResultSize != 0 {
+		handleResponse = sizedResponse(&resultSize, handleResponse)
+	}
+	err = client.howEntityByEntityIDV2(ctx, entityID, flags, handleResponse)
+	if recipients := client.getRecipients(ctx, 8024, err); len(recipients) > 0 {
+		details := map[string]string{
+			"entityID": formatEntityID(entityID),
+		}
+		client.notify(ctx, recipients, 8024, err, details, entryTime, resultSize)
+	}
+	return err
+}
+
+func (client *Szengine) howEntityByEntityIDV2(ctx context.Context, entityID int64, flags int64, handleResponse responseHandler) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	var err error
-	var resultResponse string
 	result := C.Sz_howEntityByEntityID_V2_helper(C.longlong(entityID), C.longlong(flags))
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4040, entityID, flags, result.returnCode)
 	}
-	resultResponse = C.GoString(result.response)
+	if err == nil {
+		handleResponse(result.response)
+	}
 	C.SzHelper_free(unsafe.Pointer(result.response))
-	return resultResponse, err
+	return err
 }
 
 /*
@@ -2480,6 +3661,98 @@ func (client *Szengine) whyRecordsV2(ctx context.Context, dataSourceCode1 string
 // Internal methods
 // ----------------------------------------------------------------------------
 
+// --- Auditing ---------------------------------------------------------------
+
+// Append the audit entry of a mutating call. Failures of the audit log go to its error handler, not to the caller.
+func (client *Szengine) appendAudit(ctx context.Context, entry audit.Entry, withInfo string, err error) {
+	entry.Actor = audit.ActorFromContext(ctx)
+	entry.AffectedEntities = audit.AffectedEntities(withInfo)
+	entry.ComponentID = ComponentID
+	entry.ConfigID = client.getNotifiedConfigID(ctx)
+	entry.Outcome = audit.Success
+	entry.RequestID = notification.RequestIDFromContext(ctx)
+	if err != nil {
+		entry.Error = err.Error()
+		entry.Outcome = audit.Failure
+	}
+	client.auditLog.Record(entry)
+}
+
+// --- Observing --------------------------------------------------------------
+
+// Get the notification dispatcher, creating a default one on first use.
+func (client *Szengine) getDispatcher() *dispatcher.Dispatcher {
+	client.dispatcherLock.Lock()
+	defer client.dispatcherLock.Unlock()
+	if client.dispatcher == nil {
+		client.dispatcher = dispatcher.New(dispatcher.DefaultCapacity, dispatcher.Block)
+	}
+	return client.dispatcher
+}
+
+// Get the active configuration ID for notifications and audit entries, asking the Senzing C library only after it changed.
+func (client *Szengine) getNotifiedConfigID(ctx context.Context) int64 {
+	return helper.GetActiveConfigID(func() (int64, error) { return client.getActiveConfigID(ctx) })
+}
+
+// Get the observers whose filters accept a notification. Empty if there are none.
+func (client *Szengine) getRecipients(ctx context.Context, messageID int, err error) []observer.Observer {
+	if client.observers == nil {
+		return nil
+	}
+	observers := client.observers.GetObservers(ctx)
+	client.filterLock.RLock()
+	defer client.filterLock.RUnlock()
+	if len(client.filters) == 0 {
+		return observers
+	}
+	event := notification.Event{
+		ComponentID: ComponentID,
+		MessageID:   messageID,
+		Err:         err,
+	}
+	result := make([]observer.Observer, 0, len(observers))
+	for _, registered := range observers {
+		filter, isFiltered := client.filters[registered.GetObserverID(ctx)]
+		if !isFiltered || filter(event) {
+			result = append(result, registered)
+		}
+	}
+	return result
+}
+
+// Send a message to the recipients, with the optional details chosen by SetNotificationDetails.
+func (client *Szengine) notify(ctx context.Context, recipients []observer.Observer, messageID int, err error, details map[string]string, entryTime time.Time, resultSize int) {
+	client.redaction.Details(details)
+	if client.notificationDetails != notification.NoDetails {
+		call := notification.Call{
+			EntryTime:  entryTime,
+			Err:        err,
+			ResultSize: resultSize,
+		}
+		if client.notificationDetails&notification.ConfigID != 0 {
+			call.ConfigID = client.getNotifiedConfigID(ctx)
+		}
+		notification.Add(ctx, details, client.notificationDetails, call)
+	}
+	client.getDispatcher().DispatchTo(ctx, recipients, client.observerOrigin, ComponentID, messageID, err, details)
+}
+
+// Set the filter of an observer. nil removes it.
+func (client *Szengine) setFilter(ctx context.Context, registered observer.Observer, filter notification.Filter) {
+	observerID := registered.GetObserverID(ctx)
+	client.filterLock.Lock()
+	defer client.filterLock.Unlock()
+	if filter == nil {
+		delete(client.filters, observerID)
+		return
+	}
+	if client.filters == nil {
+		client.filters = map[string]notification.Filter{}
+	}
+	client.filters[observerID] = filter
+}
+
 // --- Logging ----------------------------------------------------------------
 
 // Get the Logger singleton.
@@ -2500,12 +3773,74 @@ func (client *Szengine) getMessenger() messenger.Messenger {
 
 // Trace method entry.
 func (client *Szengine) traceEntry(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+	client.getLogger().Log(errorNumber, client.redaction.Values(details)...)
+}
+
+// Trace entry of a method whose last parameter is flags, optionally adding the names of the flags.
+func (client *Szengine) traceEntryWithFlags(errorNumber int, details ...interface{}) {
+	if client.isTraceFlagNames && len(details) > 0 {
+		if flags, ok := details[len(details)-1].(int64); ok {
+			details = append(details, map[string]string{"flagNames": szflags.String(flags)})
+		}
+	}
+	client.getLogger().Log(errorNumber, client.redaction.Values(details)...)
 }
 
 // Trace method exit.
 func (client *Szengine) traceExit(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+	client.getLogger().Log(errorNumber, client.redaction.Values(details)...)
+}
+
+// --- Responses --------------------------------------------------------------
+
+// Copy a response into a byte slice.
+func bytesResponse(target *[]byte) responseHandler {
+	return func(response *C.char) {
+		if response == nil {
+			*target = []byte{}
+			return
+		}
+		*target = C.GoBytes(unsafe.Pointer(response), C.int(C.strlen(response)))
+	}
+}
+
+// Copy a response into a string.
+func stringResponse(target *string) responseHandler {
+	return func(response *C.char) {
+		*target = C.GoString(response)
+	}
+}
+
+// Record the length of a response for notifications, then pass it on.
+func sizedResponse(target *int, handleResponse responseHandler) responseHandler {
+	return func(response *C.char) {
+		if response != nil {
+			*target = int(C.strlen(response))
+		}
+		handleResponse(response)
+	}
+}
+
+// Copy a response into a string for tracing, then pass it on.
+func tracedResponse(target *string, handleResponse responseHandler) responseHandler {
+	return func(response *C.char) {
+		*target = C.GoString(response)
+		handleResponse(response)
+	}
+}
+
+// Write a response from C memory, without copying it, recording any error of the writer.
+func writeResponse(writer io.Writer, target *error) responseHandler {
+	return func(response *C.char) {
+		if response == nil {
+			return
+		}
+		length := C.strlen(response)
+		if length == 0 {
+			return
+		}
+		_, *target = writer.Write(unsafe.Slice((*byte)(unsafe.Pointer(response)), int(length)))
+	}
 }
 
 func formatEntityID(entityID int64) string {
@@ -2522,11 +3857,14 @@ func (client *Szengine) newError(ctx context.Context, errorNumber int, details .
 	if err != nil {
 		lastException = err.Error()
 	}
+	lastException = client.redaction.Redact(lastException)
+	details = client.redaction.Values(details)
+	parameters := details
 	details = append(details, messenger.MessageCode{Value: fmt.Sprintf(ExceptionCodeTemplate, lastExceptionCode)})
 	details = append(details, messenger.MessageReason{Value: lastException})
 	details = append(details, errors.New(lastException))
 	errorMessage := client.getMessenger().NewJSON(errorNumber, details...)
-	return szerror.New(lastExceptionCode, errorMessage)
+	return nativeerror.New(ComponentID, errorNumber, szengine.IDMessages, lastExceptionCode, lastException, errorMessage, parameters...)
 }
 
 /*
@@ -2552,8 +3890,8 @@ Input
 func (client *Szengine) clearLastException(ctx context.Context) error {
 	_ = ctx
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(3)
 		defer func() { client.traceExit(4, err, time.Since(entryTime)) }()
 	}
@@ -2574,8 +3912,8 @@ func (client *Szengine) getLastException(ctx context.Context) (string, error) {
 	_ = ctx
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(41)
 		defer func() { client.traceExit(42, result, err, time.Since(entryTime)) }()
 	}
@@ -2598,8 +3936,8 @@ func (client *Szengine) getLastExceptionCode(ctx context.Context) (int, error) {
 	_ = ctx
 	var err error
 	var result int
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(43)
 		defer func() { client.traceExit(44, result, err, time.Since(entryTime)) }()
 	}
@@ -2609,6 +3947,30 @@ func (client *Szengine) getLastExceptionCode(ctx context.Context) (int, error) {
 
 // --- Misc -------------------------------------------------------------------
 
+// Count an export handle as open.
+func (client *Szengine) addExportHandle(exportHandle uintptr) {
+	client.exportHandleLock.Lock()
+	defer client.exportHandleLock.Unlock()
+	if client.openExportHandles == nil {
+		client.openExportHandles = map[uintptr]struct{}{}
+	}
+	client.openExportHandles[exportHandle] = struct{}{}
+}
+
+// The number of export handles opened and not yet closed.
+func (client *Szengine) countExportHandles() int {
+	client.exportHandleLock.Lock()
+	defer client.exportHandleLock.Unlock()
+	return len(client.openExportHandles)
+}
+
+// Stop counting an export handle as open. Closing a handle again, or one never opened, changes nothing.
+func (client *Szengine) removeExportHandle(exportHandle uintptr) {
+	client.exportHandleLock.Lock()
+	defer client.exportHandleLock.Unlock()
+	delete(client.openExportHandles, exportHandle)
+}
+
 // Make a byte array.
 func (client *Szengine) getByteArray(size int) []byte {
 	return make([]byte, size)
@@ -4,7 +4,6 @@ package szengine_test
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"strconv"
@@ -19,12 +18,6 @@ import (
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 )
 
-type GetEntityByRecordIDResponse struct {
-	ResolvedEntity struct {
-		EntityID int64 `json:"ENTITY_ID"`
-	} `json:"RESOLVED_ENTITY"`
-}
-
 const (
 	baseTen         = 10
 	jsonIndentation = "    "
@@ -6496,17 +6489,11 @@ func getEntityIDForRecord(datasource string, id string) (int64, error) {
 	ctx := context.TODO()
 	// var result int64
 	szEngine := getSzEngine(ctx)
-	response, err := szEngine.GetEntityByRecordID(ctx, datasource, id, senzing.SzWithoutInfo)
-	if err != nil {
-		return result, err
-	}
-	getEntityByRecordIDResponse := &GetEntityByRecordIDResponse{}
-	err = json.Unmarshal([]byte(response), &getEntityByRecordIDResponse)
+	entity, err := szEngine.GetEntityByRecordIDTyped(ctx, datasource, id, senzing.SzWithoutInfo)
 	if err != nil {
 		return result, err
 	}
-
-	result = getEntityByRecordIDResponse.ResolvedEntity.EntityID
+	result = entity.ResolvedEntity.EntityID
 	return result, err
 }
 
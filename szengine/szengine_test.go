@@ -1,14 +1,18 @@
 package szengine
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -19,13 +23,27 @@ import (
 	"github.com/senzing-garage/go-helpers/testfixtures"
 	"github.com/senzing-garage/go-helpers/truthset"
 	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/sz-sdk-go-core/audit"
+	"github.com/senzing-garage/sz-sdk-go-core/callstats"
+	"github.com/senzing-garage/sz-sdk-go-core/dispatcher"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"github.com/senzing-garage/sz-sdk-go-core/notification"
+	"github.com/senzing-garage/sz-sdk-go-core/redaction"
+	"github.com/senzing-garage/sz-sdk-go-core/szargs"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-go-core/szmetrics"
+	"github.com/senzing-garage/sz-sdk-go-core/szmodel"
+	"github.com/senzing-garage/sz-sdk-go-core/szslog"
+	"github.com/senzing-garage/sz-sdk-go-core/sztracing"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szerror"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 const (
@@ -93,12 +111,6 @@ var (
 	szEngineSingleton *Szengine
 )
 
-type GetEntityByRecordIDResponse struct {
-	ResolvedEntity struct {
-		EntityID int64 `json:"ENTITY_ID"`
-	} `json:"RESOLVED_ENTITY"`
-}
-
 // ----------------------------------------------------------------------------
 // Interface methods - test
 // ----------------------------------------------------------------------------
@@ -814,6 +826,27 @@ func TestSzengine_FindNetworkByEntityID(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestSzengine_FindNetworkByEntityID_entityIDsBuilder(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	entityID1, err := getEntityID(truthset.CustomerRecords["1001"])
+	require.NoError(test, err)
+	entityID2, err := getEntityID(truthset.CustomerRecords["1002"])
+	require.NoError(test, err)
+	entityIDs := szargs.EntityIDs([]int64{entityID1, entityID2})
+	flags := senzing.SzFindNetworkDefaultFlags
+	actual, err := szEngine.FindNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+	require.NoError(test, err)
+	printActual(test, actual)
+}
+
 func TestSzengine_FindNetworkByEntityID_badEntityIDs(test *testing.T) {
 	ctx := context.TODO()
 	szEngine := getTestObject(ctx, test)
@@ -1473,6 +1506,22 @@ func TestSzengine_FindPathByRecordID_badAvoidRecordKeys(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestSzengine_FindPathByRecordID_malformedAvoidRecordKeys(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	record1 := truthset.CustomerRecords["1001"]
+	record2 := truthset.CustomerRecords["1002"]
+	malformedAvoidRecordKeys := `{"RECORDS": [{"DATA_SOURCE": "` + record1.DataSource + `"}]}`
+	flags := senzing.SzNoFlags
+	actual, err := szEngine.FindPathByRecordID(ctx, record1.DataSource, record1.ID, record2.DataSource, record2.ID, maxDegrees, malformedAvoidRecordKeys, requiredDataSources, flags)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	var argumentError *szargs.ArgumentError
+	require.ErrorAs(test, err, &argumentError)
+	assert.Equal(test, "avoidRecordKeys", argumentError.Argument)
+	assert.Equal(test, "RECORDS[0].RECORD_ID", argumentError.Path)
+	printActual(test, actual)
+}
+
 func TestSzengine_FindPathByRecordID_badRequiredDataSources(test *testing.T) {
 	ctx := context.TODO()
 	records := []record.Record{
@@ -1776,6 +1825,22 @@ func TestSzengine_GetRecord_badRecordID(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestSzengine_GetRecord_badRecordID_nativeError(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	record := truthset.CustomerRecords["1001"]
+	flags := senzing.SzNoFlags
+	_, err := szEngine.GetRecord(ctx, record.DataSource, badRecordID, flags)
+	var nativeError *nativeerror.Error
+	require.ErrorAs(test, err, &nativeError)
+	printActual(test, nativeError)
+	assert.Equal(test, ComponentID, nativeError.ComponentID)
+	assert.Equal(test, "szengine.Sz_getRecord_V2", nativeError.Method)
+	assert.Equal(test, "SENZ0033", nativeError.Code())
+	assert.Contains(test, nativeError.Parameters, badRecordID)
+	assert.NotContains(test, nativeError.Redacted().Parameters, badRecordID)
+}
+
 func TestSzengine_GetRecord_nilDataSourceCode(test *testing.T) {
 	ctx := context.TODO()
 	records := []record.Record{
@@ -2660,178 +2725,1180 @@ func TestSzengine_newError(test *testing.T) {
 }
 
 // ----------------------------------------------------------------------------
-// Logging and observing
+// Byte slice and writer methods
 // ----------------------------------------------------------------------------
 
-func TestSzengine_SetLogLevel_badLogLevelName(test *testing.T) {
+func TestSzengine_FindNetworkByEntityIDBytes(test *testing.T) {
 	ctx := context.TODO()
-	szConfig := getTestObject(ctx, test)
-	_ = szConfig.SetLogLevel(ctx, badLogLevelName)
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	entityIDs, err := getEntityIDs(records)
+	require.NoError(test, err)
+	flags := senzing.SzFindNetworkDefaultFlags
+	expected, err := szEngine.FindNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+	require.NoError(test, err)
+	actual, err := szEngine.FindNetworkByEntityIDBytes(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+	require.NoError(test, err)
+	printActual(test, string(actual))
+	assert.JSONEq(test, expected, string(actual))
 }
 
-func TestSzengine_SetObserverOrigin(test *testing.T) {
+func TestSzengine_FindNetworkByEntityIDToWriter(test *testing.T) {
 	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
 	szEngine := getTestObject(ctx, test)
-	origin := "Machine: nn; Task: UnitTest"
-	szEngine.SetObserverOrigin(ctx, origin)
+	entityIDs, err := getEntityIDs(records)
+	require.NoError(test, err)
+	flags := senzing.SzFindNetworkDefaultFlags
+	expected, err := szEngine.FindNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+	require.NoError(test, err)
+	var buffer bytes.Buffer
+	err = szEngine.FindNetworkByEntityIDToWriter(ctx, &buffer, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+	require.NoError(test, err)
+	printActual(test, buffer.String())
+	assert.JSONEq(test, expected, buffer.String())
 }
 
-func TestSzengine_GetObserverOrigin(test *testing.T) {
+func TestSzengine_FindNetworkByEntityIDToWriter_badEntityIDs(test *testing.T) {
 	ctx := context.TODO()
 	szEngine := getTestObject(ctx, test)
-	origin := "Machine: nn; Task: UnitTest"
-	szEngine.SetObserverOrigin(ctx, origin)
-	actual := szEngine.GetObserverOrigin(ctx)
-	assert.Equal(test, origin, actual)
-	printActual(test, actual)
+	badEntityIDs := "}{"
+	var buffer bytes.Buffer
+	err := szEngine.FindNetworkByEntityIDToWriter(ctx, &buffer, badEntityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, senzing.SzNoFlags)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.Zero(test, buffer.Len())
 }
 
-func TestSzengine_UnregisterObserver(test *testing.T) {
+func TestSzengine_FindNetworkByEntityIDToWriter_writerError(test *testing.T) {
 	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
 	szEngine := getTestObject(ctx, test)
-	err := szEngine.UnregisterObserver(ctx, observerSingleton)
+	entityIDs, err := getEntityIDs(records)
 	require.NoError(test, err)
+	err = szEngine.FindNetworkByEntityIDToWriter(ctx, failingWriter{}, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, senzing.SzFindNetworkDefaultFlags)
+	require.ErrorIs(test, err, errWriter)
 }
 
-// ----------------------------------------------------------------------------
-// Object creation / destruction
-// ----------------------------------------------------------------------------
-
-func TestSzengine_AsInterface(test *testing.T) {
-	expected := int64(4)
+func TestSzengine_FindNetworkByRecordIDBytes(test *testing.T) {
 	ctx := context.TODO()
-	szEngine := getSzEngineAsInterface(ctx)
-	actual, err := szEngine.CountRedoRecords(ctx)
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
 	require.NoError(test, err)
-	printActual(test, actual)
-	assert.Equal(test, expected, actual)
+	szEngine := getTestObject(ctx, test)
+	recordKeys := szargs.RecordKeys([]szmodel.RecordKey{
+		{DataSource: records[0].DataSource, RecordID: records[0].ID},
+		{DataSource: records[1].DataSource, RecordID: records[1].ID},
+	})
+	actual, err := szEngine.FindNetworkByRecordIDBytes(ctx, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, senzing.SzFindNetworkDefaultFlags)
+	require.NoError(test, err)
+	printActual(test, string(actual))
+	assert.True(test, json.Valid(actual))
 }
 
-func TestSzengine_Initialize(test *testing.T) {
+func TestSzengine_FindPathByEntityIDToWriter(test *testing.T) {
 	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
 	szEngine := getTestObject(ctx, test)
-	settings, err := getSettings()
+	startEntityID, err := getEntityID(records[0])
 	require.NoError(test, err)
-	configID := senzing.SzInitializeWithDefaultConfiguration
-	err = szEngine.Initialize(ctx, instanceName, settings, configID, verboseLogging)
+	endEntityID, err := getEntityID(records[1])
+	require.NoError(test, err)
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(szEngine.FindPathByEntityIDToWriter(ctx, writer, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, senzing.SzFindPathDefaultFlags))
+	}()
+	actual := szmodel.Path{}
+	err = json.NewDecoder(reader).Decode(&actual)
 	require.NoError(test, err)
+	printActual(test, actual)
+	require.NotEmpty(test, actual.EntityPaths)
+	assert.Equal(test, startEntityID, actual.EntityPaths[0].StartEntityID)
 }
 
-// TODO: Implement TestSzengine_Initialize_error
-// func TestSzengine_Initialize_error(test *testing.T) {}
-
-func TestSzengine_Initialize_withConfigID(test *testing.T) {
+func TestSzengine_FindPathByRecordIDBytes(test *testing.T) {
 	ctx := context.TODO()
-	szEngine := getTestObject(ctx, test)
-	settings, err := getSettings()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
 	require.NoError(test, err)
-	configID := getDefaultConfigID()
-	err = szEngine.Initialize(ctx, instanceName, settings, configID, verboseLogging)
+	szEngine := getTestObject(ctx, test)
+	record1 := records[0]
+	record2 := records[1]
+	actual, err := szEngine.FindPathByRecordIDBytes(ctx, record1.DataSource, record1.ID, record2.DataSource, record2.ID, maxDegrees, avoidRecordKeys, requiredDataSources, senzing.SzFindPathDefaultFlags)
 	require.NoError(test, err)
+	printActual(test, string(actual))
+	assert.True(test, json.Valid(actual))
 }
 
-// TODO: Implement TestSzengine_Initialize_withConfigID_error
-// func TestSzengine_Initialize_withConfigID_error(test *testing.T) {}
+func TestSzengine_FindPathByRecordIDBytes_badDataSourceCode(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	record2 := truthset.CustomerRecords["1002"]
+	actual, err := szEngine.FindPathByRecordIDBytes(ctx, badDataSourceCode, badRecordID, record2.DataSource, record2.ID, maxDegrees, avoidRecordKeys, requiredDataSources, senzing.SzNoFlags)
+	require.ErrorIs(test, err, szerror.ErrSzUnknownDataSource)
+	printActual(test, string(actual))
+}
 
-func TestSzengine_Reinitialize(test *testing.T) {
+func TestSzengine_HowEntityByEntityIDBytes(test *testing.T) {
 	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+		truthset.CustomerRecords["1003"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
 	szEngine := getTestObject(ctx, test)
-	configID, err := szEngine.GetActiveConfigID(ctx)
+	entityID, err := getEntityID(records[0])
 	require.NoError(test, err)
-	err = szEngine.Reinitialize(ctx, configID)
+	expected, err := szEngine.HowEntityByEntityID(ctx, entityID, senzing.SzHowEntityDefaultFlags)
 	require.NoError(test, err)
-	printActual(test, configID)
+	actual, err := szEngine.HowEntityByEntityIDBytes(ctx, entityID, senzing.SzHowEntityDefaultFlags)
+	require.NoError(test, err)
+	printActual(test, string(actual))
+	assert.Equal(test, expected, string(actual))
 }
 
-// TODO: Implement TestSzengine_Reinitialize_badConfigID
-// func TestSzengine_Reinitialize_badConfigID(test *testing.T) {}
-
-func TestSzengine_Destroy(test *testing.T) {
+func TestSzengine_HowEntityByEntityIDToWriter(test *testing.T) {
 	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+		truthset.CustomerRecords["1003"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
 	szEngine := getTestObject(ctx, test)
-	err := szEngine.Destroy(ctx)
+	entityID, err := getEntityID(records[0])
 	require.NoError(test, err)
+	var buffer bytes.Buffer
+	err = szEngine.HowEntityByEntityIDToWriter(ctx, &buffer, entityID, senzing.SzHowEntityDefaultFlags)
+	require.NoError(test, err)
+	printActual(test, buffer.String())
+	assert.True(test, json.Valid(buffer.Bytes()))
 }
 
-// TODO: Implement TestSzengine_Destroy_error
-// func TestSzengine_Destroy_error(test *testing.T) {}
-
-func TestSzengine_Destroy_withObserver(test *testing.T) {
+func TestSzengine_ToWriter_senzingError(test *testing.T) {
 	ctx := context.TODO()
-	szEngineSingleton = nil
 	szEngine := getTestObject(ctx, test)
-	err := szEngine.Destroy(ctx)
-	require.NoError(test, err)
+	writer := &recordingWriter{}
+	err := szEngine.HowEntityByEntityIDToWriter(ctx, writer, badEntityID, senzing.SzHowEntityDefaultFlags)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+	err = szEngine.FindPathByEntityIDToWriter(ctx, writer, badEntityID, badEntityID, maxDegrees, "", "", senzing.SzFindPathDefaultFlags)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+	err = szEngine.FindNetworkByEntityIDToWriter(ctx, writer, szargs.EntityIDs([]int64{badEntityID}), maxDegrees, buildOutDegrees, buildOutMaxEntities, senzing.SzFindNetworkDefaultFlags)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+	assert.Zero(test, writer.calls)
+	assert.Zero(test, writer.written)
 }
 
 // ----------------------------------------------------------------------------
-// Internal functions
+// Typed methods
 // ----------------------------------------------------------------------------
 
-func addRecords(ctx context.Context, records []record.Record) error {
-	var err error
-	szEngine, err := getSzEngine(ctx)
-	if err != nil {
-		return err
+func TestSzengine_AddRecordTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
 	}
-	flags := senzing.SzWithoutInfo
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	szEngine := getTestObject(ctx, test)
+	flags := senzing.SzNoFlags
 	for _, record := range records {
-		_, err = szEngine.AddRecord(ctx, record.DataSource, record.ID, record.JSON, flags)
-		if err != nil {
-			return err
-		}
+		actual, err := szEngine.AddRecordTyped(ctx, record.DataSource, record.ID, record.JSON, flags)
+		require.NoError(test, err)
+		assert.Equal(test, record.ID, actual.RecordID)
+		assert.NotEmpty(test, actual.AffectedEntityIDs())
+		printActual(test, actual)
 	}
-	return err
 }
 
-func deleteRecords(ctx context.Context, records []record.Record) error {
-	var err error
-	szEngine, err := getSzEngine(ctx)
-	if err != nil {
-		return err
-	}
-	flags := senzing.SzWithoutInfo
-	for _, record := range records {
-		_, err = szEngine.DeleteRecord(ctx, record.DataSource, record.ID, flags)
-		if err != nil {
-			return err
-		}
+func TestSzengine_DeleteRecordTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1005"],
 	}
-	return err
-}
-
-func getDatabaseTemplatePath() string {
-	return filepath.FromSlash("../testdata/sqlite/G2C.db")
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	record := truthset.CustomerRecords["1005"]
+	actual, err := szEngine.DeleteRecordTyped(ctx, record.DataSource, record.ID, senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Len(test, actual.AffectedEntityIDs(), 1)
+	printActual(test, actual)
 }
 
-func getDefaultConfigID() int64 {
-	return defaultConfigID
+func TestSzengine_FindNetworkByEntityIDTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	entityID1, err := getEntityIDString(truthset.CustomerRecords["1001"])
+	require.NoError(test, err)
+	entityID2, err := getEntityIDString(truthset.CustomerRecords["1002"])
+	require.NoError(test, err)
+	entityIDs := `{"ENTITIES": [{"ENTITY_ID": ` + entityID1 + `}, {"ENTITY_ID": ` + entityID2 + `}]}`
+	actual, err := szEngine.FindNetworkByEntityIDTyped(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, senzing.SzFindNetworkDefaultFlags)
+	require.NoError(test, err)
+	assert.NotEmpty(test, actual.Entities)
+	printActual(test, actual)
 }
 
-func getEntityID(record record.Record) (int64, error) {
-	return getEntityIDForRecord(record.DataSource, record.ID)
+func TestSzengine_FindNetworkByRecordIDTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	record1 := truthset.CustomerRecords["1001"]
+	record2 := truthset.CustomerRecords["1002"]
+	recordKeys := `{"RECORDS": [{"DATA_SOURCE": "` + record1.DataSource + `", "RECORD_ID": "` + record1.ID + `"}, {"DATA_SOURCE": "` + record2.DataSource + `", "RECORD_ID": "` + record2.ID + `"}]}`
+	actual, err := szEngine.FindNetworkByRecordIDTyped(ctx, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, senzing.SzFindNetworkDefaultFlags)
+	require.NoError(test, err)
+	assert.NotEmpty(test, actual.Entities)
+	printActual(test, actual)
 }
 
-func getEntityIDForRecord(datasource string, id string) (int64, error) {
-	var result int64
-	var err error
+func TestSzengine_FindPathByEntityIDTyped(test *testing.T) {
 	ctx := context.TODO()
-	// var result int64
-	szEngine, err := getSzEngine(ctx)
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	startEntityID, err := getEntityID(truthset.CustomerRecords["1001"])
+	require.NoError(test, err)
+	endEntityID, err := getEntityID(truthset.CustomerRecords["1002"])
+	require.NoError(test, err)
+	actual, err := szEngine.FindPathByEntityIDTyped(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, senzing.SzFindPathDefaultFlags)
+	require.NoError(test, err)
+	require.NotEmpty(test, actual.EntityPaths)
+	assert.Equal(test, startEntityID, actual.EntityPaths[0].StartEntityID)
+	printActual(test, actual)
+}
+
+func TestSzengine_FindPathByRecordIDTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	record1 := truthset.CustomerRecords["1001"]
+	record2 := truthset.CustomerRecords["1002"]
+	actual, err := szEngine.FindPathByRecordIDTyped(ctx, record1.DataSource, record1.ID, record2.DataSource, record2.ID, maxDegrees, avoidRecordKeys, requiredDataSources, senzing.SzFindPathDefaultFlags)
+	require.NoError(test, err)
+	require.NotEmpty(test, actual.EntityPaths)
+	printActual(test, actual)
+}
+
+func TestSzengine_FindPathByRecordIDTyped_badDataSourceCode(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	actual, err := szEngine.FindPathByRecordIDTyped(ctx, badDataSourceCode, "1001", badDataSourceCode, "1002", maxDegrees, avoidRecordKeys, requiredDataSources, senzing.SzNoFlags)
+	require.ErrorIs(test, err, szerror.ErrSzUnknownDataSource)
+	assert.Nil(test, actual)
+}
+
+func TestSzengine_GetEntityByEntityIDTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	entityID, err := getEntityID(truthset.CustomerRecords["1001"])
+	require.NoError(test, err)
+	for _, flags := range []int64{senzing.SzNoFlags, senzing.SzEntityDefaultFlags, senzing.SzEntityIncludeAllFeatures | senzing.SzEntityIncludeRecordData} {
+		actual, err := szEngine.GetEntityByEntityIDTyped(ctx, entityID, flags)
+		require.NoError(test, err)
+		assert.Equal(test, entityID, actual.ResolvedEntity.EntityID)
+		printActual(test, actual)
+	}
+}
+
+func TestSzengine_GetEntityByEntityIDTyped_badEntityID(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	flags := senzing.SzNoFlags
+	actual, err := szEngine.GetEntityByEntityIDTyped(ctx, badEntityID, flags)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+	assert.Nil(test, actual)
+}
+
+func TestSzengine_GetEntityByRecordIDTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	record := truthset.CustomerRecords["1001"]
+	flags := senzing.SzEntityDefaultFlags | senzing.SzEntityIncludeRecordData
+	actual, err := szEngine.GetEntityByRecordIDTyped(ctx, record.DataSource, record.ID, flags)
+	require.NoError(test, err)
+	assert.NotNil(test, actual.Record(record.DataSource, record.ID))
+	assert.Equal(test, []string{record.DataSource}, actual.DataSources())
+	printActual(test, actual)
+}
+
+func TestSzengine_GetRecordTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	record := truthset.CustomerRecords["1001"]
+	actual, err := szEngine.GetRecordTyped(ctx, record.DataSource, record.ID, senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, record.ID, actual.RecordID)
+	assert.Empty(test, actual.JSONData)
+	actual, err = szEngine.GetRecordTyped(ctx, record.DataSource, record.ID, senzing.SzRecordDefaultFlags)
+	require.NoError(test, err)
+	assert.JSONEq(test, record.JSON, string(actual.JSONData))
+}
+
+func TestSzengine_GetStatsTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	szEngine := getTestObject(ctx, test)
+	_, err := szEngine.GetStats(ctx)
+	require.NoError(test, err)
+	err = addRecords(ctx, records)
+	require.NoError(test, err)
+	actual, err := szEngine.GetStatsTyped(ctx)
+	require.NoError(test, err)
+	assert.GreaterOrEqual(test, actual.Workload.LoadedRecords, int64(len(records)))
+	printActual(test, actual)
+}
+
+func TestSzengine_GetVirtualEntityByRecordIDTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	record1 := truthset.CustomerRecords["1001"]
+	record2 := truthset.CustomerRecords["1002"]
+	recordList := `{"RECORDS": [{"DATA_SOURCE": "` + record1.DataSource + `", "RECORD_ID": "` + record1.ID + `"}, {"DATA_SOURCE": "` + record2.DataSource + `", "RECORD_ID": "` + record2.ID + `"}]}`
+	flags := senzing.SzVirtualEntityDefaultFlags
+	actual, err := szEngine.GetVirtualEntityByRecordIDTyped(ctx, recordList, flags)
+	require.NoError(test, err)
+	assert.Equal(test, int64(2), actual.RecordCount())
+	printActual(test, actual)
+}
+
+func TestSzengine_HowEntityByEntityIDTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	entityID, err := getEntityID(truthset.CustomerRecords["1001"])
+	require.NoError(test, err)
+	actual, err := szEngine.HowEntityByEntityIDTyped(ctx, entityID, senzing.SzHowEntityDefaultFlags)
+	require.NoError(test, err)
+	assert.NotEmpty(test, actual.HowResults.FinalState.VirtualEntities)
+	printActual(test, actual)
+}
+
+func TestSzengine_ReevaluateEntityTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	entityID, err := getEntityID(truthset.CustomerRecords["1001"])
+	require.NoError(test, err)
+	actual, err := szEngine.ReevaluateEntityTyped(ctx, entityID, senzing.SzNoFlags)
+	require.NoError(test, err)
+	printActual(test, actual)
+}
+
+func TestSzengine_ReevaluateRecordTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	record := truthset.CustomerRecords["1001"]
+	actual, err := szEngine.ReevaluateRecordTyped(ctx, record.DataSource, record.ID, senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, record.ID, actual.RecordID)
+	printActual(test, actual)
+}
+
+func TestSzengine_SearchByAttributesTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+		truthset.CustomerRecords["1003"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	attributes := `{"NAMES": [{"NAME_TYPE": "PRIMARY", "NAME_LAST": "JOHNSON"}], "SSN_NUMBER": "053-39-3251"}`
+	for _, searchProfile := range []string{"", "SEARCH"} {
+		actual, err := szEngine.SearchByAttributesTyped(ctx, attributes, searchProfile, senzing.SzSearchByAttributesDefaultFlags)
+		require.NoError(test, err)
+		for _, searchResult := range actual.Ranked() {
+			assert.NotZero(test, searchResult.Entity.ResolvedEntity.EntityID)
+		}
+		printActual(test, actual)
+	}
+}
+
+func TestSzengine_WhyEntitiesTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	entityID1, err := getEntityID(truthset.CustomerRecords["1001"])
+	require.NoError(test, err)
+	entityID2, err := getEntityID(truthset.CustomerRecords["1002"])
+	require.NoError(test, err)
+	actual, err := szEngine.WhyEntitiesTyped(ctx, entityID1, entityID2, senzing.SzWhyEntitiesDefaultFlags)
+	require.NoError(test, err)
+	require.NotEmpty(test, actual.WhyResults)
+	assert.Equal(test, entityID1, actual.WhyResults[0].EntityID)
+	printActual(test, actual)
+}
+
+func TestSzengine_WhyRecordInEntityTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	record := truthset.CustomerRecords["1001"]
+	actual, err := szEngine.WhyRecordInEntityTyped(ctx, record.DataSource, record.ID, senzing.SzWhyRecordInEntityIDefaultFlags)
+	require.NoError(test, err)
+	require.NotEmpty(test, actual.WhyResults)
+	assert.Contains(test, actual.WhyResults[0].FocusRecords, szmodel.RecordKey{DataSource: record.DataSource, RecordID: record.ID})
+	printActual(test, actual)
+}
+
+func TestSzengine_WhyRecordsTyped(test *testing.T) {
+	ctx := context.TODO()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	defer func() { handleError(deleteRecords(ctx, records)) }()
+	err := addRecords(ctx, records)
+	require.NoError(test, err)
+	szEngine := getTestObject(ctx, test)
+	record1 := truthset.CustomerRecords["1001"]
+	record2 := truthset.CustomerRecords["1002"]
+	actual, err := szEngine.WhyRecordsTyped(ctx, record1.DataSource, record1.ID, record2.DataSource, record2.ID, senzing.SzWhyRecordsDefaultFlags)
+	require.NoError(test, err)
+	require.NotEmpty(test, actual.WhyResults)
+	printActual(test, actual)
+}
+
+func TestSzengine_WhyRecordsTyped_badDataSourceCode(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	actual, err := szEngine.WhyRecordsTyped(ctx, badDataSourceCode, "1001", badDataSourceCode, "1002", senzing.SzNoFlags)
+	require.ErrorIs(test, err, szerror.ErrSzUnknownDataSource)
+	assert.Nil(test, actual)
+}
+
+// ----------------------------------------------------------------------------
+// Logging and observing
+// ----------------------------------------------------------------------------
+
+func TestSzengine_SetDispatcher(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	notificationDispatcher := dispatcher.New(4, dispatcher.Block)
+	require.NoError(test, szEngine.SetDispatcher(ctx, notificationDispatcher))
+	defer func() { require.NoError(test, szEngine.SetDispatcher(ctx, nil)) }()
+	recorder := &messageRecorder{}
+	require.NoError(test, szEngine.RegisterObserver(ctx, recorder))
+	defer func() { require.NoError(test, szEngine.UnregisterObserver(ctx, recorder)) }()
+	for range 100 {
+		_, err := szEngine.GetActiveConfigID(ctx)
+		require.NoError(test, err)
+	}
+	require.NoError(test, notificationDispatcher.Flush(ctx))
+	messageIDs := recorder.messageIDs()
+	require.Len(test, messageIDs, 101)
+	assert.Equal(test, "8702", messageIDs[0])
+	for _, messageID := range messageIDs[1:] {
+		assert.Equal(test, "8017", messageID)
+	}
+	assert.Zero(test, notificationDispatcher.Dropped())
+}
+
+func TestSzengine_SetLogLevel_badLogLevelName(test *testing.T) {
+	ctx := context.TODO()
+	szConfig := getTestObject(ctx, test)
+	_ = szConfig.SetLogLevel(ctx, badLogLevelName)
+}
+
+func TestSzengine_SetLogger(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	var buffer bytes.Buffer
+	handler := slog.NewJSONHandler(&buffer, &slog.HandlerOptions{Level: szslog.LevelTrace, ReplaceAttr: szslog.ReplaceLevel})
+	szEngine.SetLogger(ctx, slog.New(handler))
+	defer szEngine.SetLogger(ctx, nil)
+	err := szEngine.SetLogLevel(ctx, "TRACE")
+	require.NoError(test, err)
+	defer func() { require.NoError(test, szEngine.SetLogLevel(ctx, logLevel)) }()
+	record := truthset.CustomerRecords["1001"]
+	_, err = szEngine.GetRecord(ctx, record.DataSource, record.ID, senzing.SzNoFlags)
+	require.NoError(test, err)
+	actual := buffer.String()
+	printActual(test, actual)
+	assert.Contains(test, actual, `"level":"TRACE"`)
+	assert.Contains(test, actual, `"component":"szengine","method":"GetRecord"`)
+	assert.Contains(test, actual, `"duration":`)
+}
+
+func TestSzengine_SetMetrics(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	metrics := szmetrics.New()
+	szEngine.SetMetrics(ctx, metrics)
+	defer szEngine.SetMetrics(ctx, nil)
+	record := truthset.CustomerRecords["1001"]
+	_, err := szEngine.GetRecord(ctx, record.DataSource, record.ID, senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = szEngine.GetRecord(ctx, record.DataSource, badRecordID, senzing.SzNoFlags)
+	require.Error(test, err)
+	exportHandle, err := szEngine.ExportJSONEntityReport(ctx, senzing.SzNoFlags)
+	require.NoError(test, err)
+	var buffer bytes.Buffer
+	require.NoError(test, metrics.Write(ctx, &buffer))
+	require.NoError(test, szEngine.CloseExport(ctx, exportHandle))
+	actual := buffer.String()
+	printActual(test, actual)
+	assert.Contains(test, actual, `sz_calls_total{component="szengine",method="GetRecord"} 2`)
+	assert.Contains(test, actual, `sz_call_errors_total{component="szengine",method="GetRecord",code="SENZ0033"} 1`)
+	assert.Contains(test, actual, "sz_open_export_handles 1\n")
+}
+
+func TestSzengine_SetMetrics_twice(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	metrics := szmetrics.New()
+	szEngine.SetMetrics(ctx, metrics)
+	szEngine.SetMetrics(ctx, metrics)
+	other := &Szengine{}
+	other.SetMetrics(ctx, metrics)
+	defer szEngine.SetMetrics(ctx, nil)
+	exportHandle, err := szEngine.ExportJSONEntityReport(ctx, senzing.SzNoFlags)
+	require.NoError(test, err)
+	defer func() { require.NoError(test, szEngine.CloseExport(ctx, exportHandle)) }()
+	var buffer bytes.Buffer
+	require.NoError(test, metrics.Write(ctx, &buffer))
+	actual := buffer.String()
+	printActual(test, actual)
+	assert.Equal(test, 1, strings.Count(actual, "# TYPE sz_open_export_handles gauge\n"))
+	assert.Contains(test, actual, "sz_open_export_handles 1\n")
+	szEngine.SetMetrics(ctx, nil)
+	buffer.Reset()
+	require.NoError(test, metrics.Write(ctx, &buffer))
+	assert.Contains(test, buffer.String(), "sz_open_export_handles 0\n")
+}
+
+func TestSzengine_SetMetrics_closeExportTwice(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	metrics := szmetrics.New()
+	szEngine.SetMetrics(ctx, metrics)
+	defer szEngine.SetMetrics(ctx, nil)
+	exportHandle, err := szEngine.ExportJSONEntityReport(ctx, senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.NoError(test, szEngine.CloseExport(ctx, exportHandle))
+	require.NoError(test, szEngine.CloseExport(ctx, exportHandle))
+	var buffer bytes.Buffer
+	require.NoError(test, metrics.Write(ctx, &buffer))
+	actual := buffer.String()
+	printActual(test, actual)
+	assert.Contains(test, actual, "sz_open_export_handles 0\n")
+}
+
+func TestSzengine_GetCallStatistics(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	szEngine.ResetCallStatistics(ctx)
+	defer szEngine.ResetCallStatistics(ctx)
+	record := truthset.CustomerRecords["1001"]
+	for range 3 {
+		_, err := szEngine.GetRecord(ctx, record.DataSource, record.ID, senzing.SzNoFlags)
+		require.NoError(test, err)
+	}
+	_, err := szEngine.GetRecord(ctx, badDataSourceCode, record.ID, senzing.SzNoFlags)
+	require.Error(test, err)
+	actual := szEngine.GetCallStatistics(ctx)
+	printActual(test, actual)
+	assert.Equal(test, ComponentID, actual.ComponentID)
+	require.Len(test, actual.Methods, 1)
+	getRecord := actual.Methods[0]
+	assert.Equal(test, "GetRecord", getRecord.Method)
+	assert.Equal(test, uint64(4), getRecord.Calls)
+	assert.Equal(test, uint64(1), getRecord.Errors)
+	assert.Positive(test, getRecord.P50)
+	assert.LessOrEqual(test, getRecord.P99, getRecord.Maximum)
+	var buffer bytes.Buffer
+	require.NoError(test, callstats.Write(&buffer, actual))
+	printActual(test, buffer.String())
+	assert.Contains(test, buffer.String(), "GetRecord")
+	szEngine.ResetCallStatistics(ctx)
+	assert.Empty(test, szEngine.GetCallStatistics(ctx).Methods)
+}
+
+func TestSzengine_SetAuditLog(test *testing.T) {
+	ctx := audit.WithActor(context.TODO(), "jane.doe")
+	szEngine := getTestObject(ctx, test)
+	path := filepath.Join(test.TempDir(), "audit.jsonl")
+	auditLog, err := audit.Open(path)
+	require.NoError(test, err)
+	szEngine.SetAuditLog(ctx, auditLog)
+	defer szEngine.SetAuditLog(ctx, nil)
+	record := truthset.CustomerRecords["1003"]
+	_, err = szEngine.AddRecord(ctx, record.DataSource, record.ID, record.JSON, senzing.SzWithInfo)
+	require.NoError(test, err)
+	_, err = szEngine.ReevaluateRecord(ctx, record.DataSource, record.ID, senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = szEngine.DeleteRecord(ctx, record.DataSource, record.ID, senzing.SzWithInfo)
+	require.NoError(test, err)
+	_, err = szEngine.AddRecord(ctx, badDataSourceCode, record.ID, record.JSON, senzing.SzNoFlags)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	require.NoError(test, auditLog.Close())
+	content, err := os.ReadFile(path)
+	require.NoError(test, err)
+	printActual(test, string(content))
+	summary, err := audit.Verify(bytes.NewReader(content))
+	require.NoError(test, err)
+	assert.Equal(test, int64(4), summary.Entries)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(test, lines, 4)
+	entries := make([]audit.Entry, len(lines))
+	for index, line := range lines {
+		require.NoError(test, json.Unmarshal([]byte(line), &entries[index]))
+		assert.Equal(test, "jane.doe", entries[index].Actor)
+		assert.Equal(test, ComponentID, entries[index].ComponentID)
+	}
+	assert.Equal(test, []string{"AddRecord", "ReevaluateRecord", "DeleteRecord", "AddRecord"}, []string{entries[0].Operation, entries[1].Operation, entries[2].Operation, entries[3].Operation})
+	assert.Equal(test, record.ID, entries[0].RecordID)
+	assert.NotEmpty(test, entries[0].AffectedEntities)
+	assert.Empty(test, entries[1].AffectedEntities)
+	assert.Equal(test, audit.Success, entries[2].Outcome)
+	assert.Equal(test, audit.Failure, entries[3].Outcome)
+	assert.NotEmpty(test, entries[3].Error)
+}
+
+func TestSzengine_SetNotificationDetails(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	notificationDispatcher := dispatcher.New(0, dispatcher.Block)
+	require.NoError(test, szEngine.SetDispatcher(ctx, notificationDispatcher))
+	defer func() { require.NoError(test, szEngine.SetDispatcher(ctx, nil)) }()
+	recorder := &messageRecorder{}
+	require.NoError(test, szEngine.RegisterObserver(ctx, recorder))
+	defer func() { require.NoError(test, szEngine.UnregisterObserver(ctx, recorder)) }()
+	record := truthset.CustomerRecords["1001"]
+	_, err := szEngine.GetRecord(ctx, record.DataSource, record.ID, senzing.SzNoFlags)
+	require.NoError(test, err)
+	szEngine.SetNotificationDetails(ctx, notification.AllDetails)
+	defer szEngine.SetNotificationDetails(ctx, notification.NoDetails)
+	requestCtx := notification.WithRequestID(ctx, "request-1")
+	actual, err := szEngine.GetRecord(requestCtx, record.DataSource, record.ID, senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = szEngine.GetRecord(requestCtx, badDataSourceCode, record.ID, senzing.SzNoFlags)
+	require.Error(test, err)
+	activeConfigID, err := szEngine.GetActiveConfigID(ctx)
+	require.NoError(test, err)
+	require.NoError(test, notificationDispatcher.Flush(ctx))
+	messages := recorder.decoded()
+	require.Len(test, messages, 5)
+	printActual(test, messages)
+	assert.Equal(test, record.ID, messages[1]["recordID"])
+	assert.NotContains(test, messages[1], notification.DurationKey)
+	assert.NotContains(test, messages[1], notification.RequestIDKey)
+	assert.Equal(test, record.ID, messages[2]["recordID"])
+	assert.NotEmpty(test, messages[2][notification.DurationKey])
+	assert.Equal(test, strconv.Itoa(len(actual)), messages[2][notification.ResultSizeKey])
+	assert.Equal(test, strconv.FormatInt(activeConfigID, 10), messages[2][notification.ConfigIDKey])
+	assert.Equal(test, "request-1", messages[2][notification.RequestIDKey])
+	assert.NotContains(test, messages[2], notification.ExceptionCodeKey)
+	assert.NotEmpty(test, messages[3]["error"])
+	assert.Regexp(test, "^SENZ[0-9]{4}$", messages[3][notification.ExceptionCodeKey])
+	assert.NotContains(test, messages[4], notification.ResultSizeKey)
+	for _, message := range messages {
+		messageID, err := strconv.Atoi(message["messageId"])
+		require.NoError(test, err)
+		schema, isKnown := notification.Schemas[ComponentID][messageID]
+		require.True(test, isKnown, messageID)
+		for _, key := range schema.Details {
+			assert.Contains(test, message, key, schema.Method)
+		}
+	}
+}
+
+func TestSzengine_SetObserverOrigin(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	origin := "Machine: nn; Task: UnitTest"
+	szEngine.SetObserverOrigin(ctx, origin)
+}
+
+func TestSzengine_GetObserverOrigin(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	origin := "Machine: nn; Task: UnitTest"
+	szEngine.SetObserverOrigin(ctx, origin)
+	actual := szEngine.GetObserverOrigin(ctx)
+	assert.Equal(test, origin, actual)
+	printActual(test, actual)
+}
+
+func TestSzengine_SetRedaction(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	var buffer bytes.Buffer
+	handler := slog.NewJSONHandler(&buffer, &slog.HandlerOptions{Level: szslog.LevelTrace, ReplaceAttr: szslog.ReplaceLevel})
+	szEngine.SetLogger(ctx, slog.New(handler))
+	defer szEngine.SetLogger(ctx, nil)
+	err := szEngine.SetLogLevel(ctx, "TRACE")
+	require.NoError(test, err)
+	defer func() { require.NoError(test, szEngine.SetLogLevel(ctx, logLevel)) }()
+	szEngine.SetRedaction(ctx, &redaction.Policy{Credentials: true, Keys: redaction.DefaultKeys})
+	defer szEngine.SetRedaction(ctx, nil)
+	recordDefinition := `{"DATA_SOURCE": "` + badDataSourceCode + `", "RECORD_ID": "1001", "NAME_FULL": "Robert Smith", "SSN_NUMBER": "123-45-6789"}`
+	_, err = szEngine.AddRecord(ctx, badDataSourceCode, "1001", recordDefinition, senzing.SzNoFlags)
+	require.Error(test, err)
+	printActual(test, err)
+	assert.NotContains(test, err.Error(), "123-45-6789")
+	assert.Contains(test, err.Error(), "<redacted length=11 ")
+	var nativeError *nativeerror.Error
+	require.ErrorAs(test, err, &nativeError)
+	for _, parameter := range nativeError.Parameters {
+		assert.NotContains(test, parameter, "123-45-6789")
+	}
+	actual := buffer.String()
+	printActual(test, actual)
+	assert.Contains(test, actual, `"method":"AddRecord"`)
+	assert.NotContains(test, actual, "123-45-6789")
+}
+
+func TestSzengine_SetTraceFlagNames(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	szEngine.SetTraceFlagNames(ctx, true)
+	defer szEngine.SetTraceFlagNames(ctx, false)
+	err := szEngine.SetLogLevel(ctx, "TRACE")
+	require.NoError(test, err)
+	defer func() { require.NoError(test, szEngine.SetLogLevel(ctx, logLevel)) }()
+	entityID, err := getEntityID(truthset.CustomerRecords["1001"])
+	require.NoError(test, err)
+	actual, err := szEngine.GetEntityByEntityID(ctx, entityID, senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	printActual(test, actual)
+}
+
+func TestSzengine_SetTracer(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)).Tracer("test")
+	szEngine.SetTracer(ctx, tracer)
+	defer szEngine.SetTracer(ctx, nil)
+	parentCtx, parent := tracer.Start(ctx, "request")
+	record := truthset.CustomerRecords["1001"]
+	_, err := szEngine.GetRecord(parentCtx, record.DataSource, record.ID, senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = szEngine.GetRecord(parentCtx, record.DataSource, badRecordID, senzing.SzNoFlags)
+	require.Error(test, err)
+	parent.End()
+	spans := exporter.GetSpans()
+	require.Len(test, spans, 3)
+	for _, span := range spans[:2] {
+		assert.Equal(test, "szengine.GetRecord", span.Name)
+		assert.Equal(test, parent.SpanContext().SpanID(), span.Parent.SpanID())
+		assert.Contains(test, span.Attributes, sztracing.DataSources(record.DataSource))
+		assert.Contains(test, span.Attributes, sztracing.Flags(senzing.SzNoFlags))
+	}
+	assert.Contains(test, spans[0].Attributes, sztracing.RecordIDs(record.ID))
+	assert.Equal(test, codes.Unset, spans[0].Status.Code)
+	assert.Contains(test, spans[1].Attributes, sztracing.RecordIDs(badRecordID))
+	assert.Contains(test, spans[1].Attributes, sztracing.ErrorCodeKey.String("SENZ0033"))
+	assert.Equal(test, codes.Error, spans[1].Status.Code)
+}
+
+func TestSzengine_UnregisterObserver(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	err := szEngine.UnregisterObserver(ctx, observerSingleton)
+	require.NoError(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Object creation / destruction
+// ----------------------------------------------------------------------------
+
+func TestSzengine_AsInterface(test *testing.T) {
+	expected := int64(4)
+	ctx := context.TODO()
+	szEngine := getSzEngineAsInterface(ctx)
+	actual, err := szEngine.CountRedoRecords(ctx)
+	require.NoError(test, err)
+	printActual(test, actual)
+	assert.Equal(test, expected, actual)
+}
+
+func TestSzengine_Initialize(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	settings, err := getSettings()
+	require.NoError(test, err)
+	configID := senzing.SzInitializeWithDefaultConfiguration
+	err = szEngine.Initialize(ctx, instanceName, settings, configID, verboseLogging)
+	require.NoError(test, err)
+}
+
+// TODO: Implement TestSzengine_Initialize_error
+// func TestSzengine_Initialize_error(test *testing.T) {}
+
+func TestSzengine_Initialize_withConfigID(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	settings, err := getSettings()
+	require.NoError(test, err)
+	configID := getDefaultConfigID()
+	err = szEngine.Initialize(ctx, instanceName, settings, configID, verboseLogging)
+	require.NoError(test, err)
+}
+
+// TODO: Implement TestSzengine_Initialize_withConfigID_error
+// func TestSzengine_Initialize_withConfigID_error(test *testing.T) {}
+
+func TestSzengine_RegisterObserverWithFilter(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	notificationDispatcher := dispatcher.New(0, dispatcher.Block)
+	require.NoError(test, szEngine.SetDispatcher(ctx, notificationDispatcher))
+	defer func() { require.NoError(test, szEngine.SetDispatcher(ctx, nil)) }()
+	recorder := &messageRecorder{}
+	require.NoError(test, szEngine.RegisterObserverWithFilter(ctx, recorder, notification.Any(notification.MessageIDs(8017), notification.Errors)))
+	record := truthset.CustomerRecords["1001"]
+	_, err := szEngine.GetRecord(ctx, record.DataSource, record.ID, senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = szEngine.GetRecord(ctx, badDataSourceCode, record.ID, senzing.SzNoFlags)
+	require.Error(test, err)
+	_, err = szEngine.GetActiveConfigID(ctx)
+	require.NoError(test, err)
+	require.NoError(test, szEngine.RegisterObserverWithFilter(ctx, recorder, notification.MessageIDs(8704)))
+	_, err = szEngine.GetActiveConfigID(ctx)
+	require.NoError(test, err)
+	require.NoError(test, szEngine.UnregisterObserver(ctx, recorder))
+	require.NoError(test, notificationDispatcher.Flush(ctx))
+	assert.Equal(test, []string{"8020", "8017", "8704"}, recorder.messageIDs())
+}
+
+func TestSzengine_Reinitialize(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	configID, err := szEngine.GetActiveConfigID(ctx)
+	require.NoError(test, err)
+	err = szEngine.Reinitialize(ctx, configID)
+	require.NoError(test, err)
+	printActual(test, configID)
+}
+
+// TODO: Implement TestSzengine_Reinitialize_badConfigID
+// func TestSzengine_Reinitialize_badConfigID(test *testing.T) {}
+
+func TestSzengine_Destroy(test *testing.T) {
+	ctx := context.TODO()
+	szEngine := getTestObject(ctx, test)
+	err := szEngine.Destroy(ctx)
+	require.NoError(test, err)
+}
+
+// TODO: Implement TestSzengine_Destroy_error
+// func TestSzengine_Destroy_error(test *testing.T) {}
+
+func TestSzengine_Destroy_withObserver(test *testing.T) {
+	ctx := context.TODO()
+	szEngineSingleton = nil
+	szEngine := getTestObject(ctx, test)
+	err := szEngine.Destroy(ctx)
+	require.NoError(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Benchmarks
+// ----------------------------------------------------------------------------
+
+func BenchmarkSzengine_FindNetworkByEntityID(benchmark *testing.B) {
+	ctx := context.TODO()
+	szEngine, entityIDs := setupBenchmarkNetwork(ctx, benchmark)
+	benchmark.ReportAllocs()
+	benchmark.ResetTimer()
+	for range benchmark.N {
+		actual, err := szEngine.FindNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, senzing.SzFindNetworkDefaultFlags)
+		require.NoError(benchmark, err)
+		network := szmodel.Network{}
+		require.NoError(benchmark, json.Unmarshal([]byte(actual), &network))
+	}
+}
+
+func BenchmarkSzengine_FindNetworkByEntityIDBytes(benchmark *testing.B) {
+	ctx := context.TODO()
+	szEngine, entityIDs := setupBenchmarkNetwork(ctx, benchmark)
+	benchmark.ReportAllocs()
+	benchmark.ResetTimer()
+	for range benchmark.N {
+		actual, err := szEngine.FindNetworkByEntityIDBytes(ctx, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, senzing.SzFindNetworkDefaultFlags)
+		require.NoError(benchmark, err)
+		network := szmodel.Network{}
+		require.NoError(benchmark, json.Unmarshal(actual, &network))
+	}
+}
+
+func BenchmarkSzengine_FindNetworkByEntityIDToWriter(benchmark *testing.B) {
+	ctx := context.TODO()
+	szEngine, entityIDs := setupBenchmarkNetwork(ctx, benchmark)
+	var buffer bytes.Buffer
+	benchmark.ReportAllocs()
+	benchmark.ResetTimer()
+	for range benchmark.N {
+		buffer.Reset()
+		err := szEngine.FindNetworkByEntityIDToWriter(ctx, &buffer, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, senzing.SzFindNetworkDefaultFlags)
+		require.NoError(benchmark, err)
+		network := szmodel.Network{}
+		require.NoError(benchmark, json.Unmarshal(buffer.Bytes(), &network))
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func addRecords(ctx context.Context, records []record.Record) error {
+	var err error
+	szEngine, err := getSzEngine(ctx)
 	if err != nil {
-		return result, err
+		return err
+	}
+	flags := senzing.SzWithoutInfo
+	for _, record := range records {
+		_, err = szEngine.AddRecord(ctx, record.DataSource, record.ID, record.JSON, flags)
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func deleteRecords(ctx context.Context, records []record.Record) error {
+	var err error
+	szEngine, err := getSzEngine(ctx)
+	if err != nil {
+		return err
 	}
-	response, err := szEngine.GetEntityByRecordID(ctx, datasource, id, senzing.SzWithoutInfo)
+	flags := senzing.SzWithoutInfo
+	for _, record := range records {
+		_, err = szEngine.DeleteRecord(ctx, record.DataSource, record.ID, flags)
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func getDatabaseTemplatePath() string {
+	return filepath.FromSlash("../testdata/sqlite/G2C.db")
+}
+
+func getDefaultConfigID() int64 {
+	return defaultConfigID
+}
+
+func getEntityID(record record.Record) (int64, error) {
+	return getEntityIDForRecord(record.DataSource, record.ID)
+}
+
+// Errors and writers used to test the writer methods.
+
+var errWriter = errors.New("failing writer")
+
+// A writer recording how much is written to it.
+type recordingWriter struct {
+	calls   int
+	written int
+}
+
+func (writer *recordingWriter) Write(data []byte) (int, error) {
+	writer.calls++
+	writer.written += len(data)
+	return len(data), nil
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(data []byte) (int, error) {
+	_ = data
+	return 0, errWriter
+}
+
+// An observer recording the message IDs of notifications.
+
+type messageRecorder struct {
+	lock     sync.Mutex
+	messages []string
+}
+
+func (recorder *messageRecorder) GetObserverID(ctx context.Context) string {
+	_ = ctx
+	return "messageRecorder"
+}
+
+func (recorder *messageRecorder) UpdateObserver(ctx context.Context, message string) {
+	_ = ctx
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.messages = append(recorder.messages, message)
+}
+
+func (recorder *messageRecorder) decoded() []map[string]string {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	result := []map[string]string{}
+	for _, message := range recorder.messages {
+		decoded := map[string]string{}
+		if err := json.Unmarshal([]byte(message), &decoded); err == nil {
+			result = append(result, decoded)
+		}
+	}
+	return result
+}
+
+func (recorder *messageRecorder) messageIDs() []string {
+	result := []string{}
+	for _, decoded := range recorder.decoded() {
+		result = append(result, decoded["messageId"])
+	}
+	return result
+}
+
+func getEntityIDs(records []record.Record) (string, error) {
+	entityIDs := []int64{}
+	for _, record := range records {
+		entityID, err := getEntityID(record)
+		if err != nil {
+			return "", err
+		}
+		entityIDs = append(entityIDs, entityID)
+	}
+	return szargs.EntityIDs(entityIDs), nil
+}
+
+func getEntityIDForRecord(datasource string, id string) (int64, error) {
+	var result int64
+	var err error
+	ctx := context.TODO()
+	// var result int64
+	szEngine, err := getSzEngine(ctx)
 	if err != nil {
 		return result, err
 	}
-	getEntityByRecordIDResponse := &GetEntityByRecordIDResponse{}
-	err = json.Unmarshal([]byte(response), &getEntityByRecordIDResponse)
+	entity, err := szEngine.GetEntityByRecordIDTyped(ctx, datasource, id, senzing.SzWithoutInfo)
 	if err != nil {
 		return result, err
 	}
-
-	result = getEntityByRecordIDResponse.ResolvedEntity.EntityID
+	result = entity.ResolvedEntity.EntityID
 	return result, err
 }
 
@@ -2945,6 +4012,21 @@ func handleErrorWithString(aString string, err error) {
 	handleError(err)
 }
 
+// Load the truth set customers for a benchmark and list their entities.
+func setupBenchmarkNetwork(ctx context.Context, benchmark *testing.B) (*Szengine, string) {
+	records := []record.Record{}
+	for _, recordID := range []string{"1001", "1002", "1003", "1004", "1005", "1009"} {
+		records = append(records, truthset.CustomerRecords[recordID])
+	}
+	require.NoError(benchmark, addRecords(ctx, records))
+	benchmark.Cleanup(func() { handleError(deleteRecords(ctx, records)) })
+	szEngine, err := getSzEngine(ctx)
+	require.NoError(benchmark, err)
+	entityIDs, err := getEntityIDs(records)
+	require.NoError(benchmark, err)
+	return szEngine, entityIDs
+}
+
 func printActual(test *testing.T, actual interface{}) {
 	printResult(test, "Actual", actual)
 }
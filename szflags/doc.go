@@ -0,0 +1,15 @@
+/*
+Package szflags decodes, composes and validates the flags parameters of [senzing.SzEngine] methods.
+
+The flags parameters are bitmasks of the "SzXxx" constants in [senzing].
+[Names] and [String] decode a value, so that flags=3221225472 in a trace log reads as
+"SzFindPathIncludeMatchingInfo|SzEntityIncludeRecordUnmappedData".
+[Compose] and [Parse] build a value from constant names, as given on a command line or in a configuration file.
+Names may be written as Go constants, such as SzEntityIncludeEntityName,
+or in upper snake case, such as SZ_ENTITY_INCLUDE_ENTITY_NAME.
+[Validate] reports flags that have no effect on a given method.
+
+[senzing]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+package szflags
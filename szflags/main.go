@@ -0,0 +1,202 @@
+package szflags
+
+import (
+	"errors"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Type Flag is a named flag constant of [senzing].
+type Flag struct {
+	Name  string
+	Value int64
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Errors returned by Compose, Parse and Validate.
+var (
+	ErrUnknownFlag   = errors.New("szflags: unknown flag")
+	ErrUnknownMethod = errors.New("szflags: unknown method")
+)
+
+/*
+BitFlags are the single-bit flags in bit order.
+Bits that share a value with a search flag, such as SzSearchIncludeResolved, are listed by their export name.
+Reserved bits are named BitNN, numbered from 1 as in [senzing].
+*/
+var BitFlags = []Flag{
+	{"SzExportIncludeMultiRecordEntities", senzing.SzExportIncludeMultiRecordEntities},
+	{"SzExportIncludePossiblySame", senzing.SzExportIncludePossiblySame},
+	{"SzExportIncludePossiblyRelated", senzing.SzExportIncludePossiblyRelated},
+	{"SzExportIncludeNameOnly", senzing.SzExportIncludeNameOnly},
+	{"SzExportIncludeDisclosed", senzing.SzExportIncludeDisclosed},
+	{"SzExportIncludeSingleRecordEntities", senzing.SzExportIncludeSingleRecordEntities},
+	{"SzEntityIncludePossiblySameRelations", senzing.SzEntityIncludePossiblySameRelations},
+	{"SzEntityIncludePossiblyRelatedRelations", senzing.SzEntityIncludePossiblyRelatedRelations},
+	{"SzEntityIncludeNameOnlyRelations", senzing.SzEntityIncludeNameOnlyRelations},
+	{"SzEntityIncludeDisclosedRelations", senzing.SzEntityIncludeDisclosedRelations},
+	{"SzEntityIncludeAllFeatures", senzing.SzEntityIncludeAllFeatures},
+	{"SzEntityIncludeRepresentativeFeatures", senzing.SzEntityIncludeRepresentativeFeatures},
+	{"SzEntityIncludeEntityName", senzing.SzEntityIncludeEntityName},
+	{"SzEntityIncludeRecordSummary", senzing.SzEntityIncludeRecordSummary},
+	{"SzEntityIncludeRecordData", senzing.SzEntityIncludeRecordData},
+	{"SzEntityIncludeRecordMatchingInfo", senzing.SzEntityIncludeRecordMatchingInfo},
+	{"SzEntityIncludeRecordJSONData", senzing.SzEntityIncludeRecordJSONData},
+	{"Bit18", senzing.Bit18},
+	{"SzEntityIncludeRecordFeatureIDs", senzing.SzEntityIncludeRecordFeatureIDs},
+	{"SzEntityIncludeRelatedEntityName", senzing.SzEntityIncludeRelatedEntityName},
+	{"SzEntityIncludeRelatedMatchingInfo", senzing.SzEntityIncludeRelatedMatchingInfo},
+	{"SzEntityIncludeRelatedRecordSummary", senzing.SzEntityIncludeRelatedRecordSummary},
+	{"SzEntityIncludeRelatedRecordData", senzing.SzEntityIncludeRelatedRecordData},
+	{"SzEntityIncludeInternalFeatures", senzing.SzEntityIncludeInternalFeatures},
+	{"SzEntityIncludeFeatureStats", senzing.SzEntityIncludeFeatureStats},
+	{"SzFindPathStrictAvoid", senzing.SzFindPathStrictAvoid},
+	{"SzIncludeFeatureScores", senzing.SzIncludeFeatureScores},
+	{"SzSearchIncludeStats", senzing.SzSearchIncludeStats},
+	{"SzEntityIncludeRecordTypes", senzing.SzEntityIncludeRecordTypes},
+	{"SzEntityIncludeRelatedRecordTypes", senzing.SzEntityIncludeRelatedRecordTypes},
+	{"SzFindPathIncludeMatchingInfo", senzing.SzFindPathIncludeMatchingInfo},
+	{"SzEntityIncludeRecordUnmappedData", senzing.SzEntityIncludeRecordUnmappedData},
+	{"SzSearchIncludeAllCandidates", senzing.SzSearchIncludeAllCandidates},
+	{"SzFindNetworkIncludeMatchingInfo", senzing.SzFindNetworkIncludeMatchingInfo},
+	{"SzIncludeMatchKeyDetails", senzing.SzIncludeMatchKeyDetails},
+	{"SzEntityIncludeRecordFeatureDetails", senzing.SzEntityIncludeRecordFeatureDetails},
+	{"SzEntityIncludeRecordFeatureStats", senzing.SzEntityIncludeRecordFeatureStats},
+	{"SzSearchIncludeRequest", senzing.SzSearchIncludeRequest},
+	{"SzSearchIncludeRequestDetails", senzing.SzSearchIncludeRequestDetails},
+	{"Bit40", senzing.Bit40},
+	{"Bit41", senzing.Bit41},
+	{"Bit42", senzing.Bit42},
+	{"Bit43", senzing.Bit43},
+	{"Bit44", senzing.Bit44},
+	{"Bit45", senzing.Bit45},
+	{"Bit46", senzing.Bit46},
+	{"Bit47", senzing.Bit47},
+	{"Bit48", senzing.Bit48},
+	{"Bit49", senzing.Bit49},
+	{"Bit50", senzing.Bit50},
+	{"Bit51", senzing.Bit51},
+	{"Bit52", senzing.Bit52},
+	{"Bit53", senzing.Bit53},
+	{"Bit54", senzing.Bit54},
+	{"Bit55", senzing.Bit55},
+	{"Bit56", senzing.Bit56},
+	{"Bit57", senzing.Bit57},
+	{"Bit58", senzing.Bit58},
+	{"Bit59", senzing.Bit59},
+	{"Bit60", senzing.Bit60},
+	{"Bit61", senzing.Bit61},
+	{"Bit62", senzing.Bit62},
+	{"SzWithInfo", senzing.SzWithInfo},
+}
+
+/*
+CompoundFlags are the aliases and combinations of bit flags accepted by Compose and Parse.
+*/
+var CompoundFlags = []Flag{
+	{"SzNoFlags", senzing.SzNoFlags},
+	{"SzExportIncludeAllEntities", senzing.SzExportIncludeAllEntities},
+	{"SzExportIncludeAllHavingRelationships", senzing.SzExportIncludeAllHavingRelationships},
+	{"SzEntityIncludeAllRelations", senzing.SzEntityIncludeAllRelations},
+	{"SzSearchIncludeAllEntities", senzing.SzSearchIncludeAllEntities},
+	{"SzSearchIncludeNameOnly", senzing.SzSearchIncludeNameOnly},
+	{"SzSearchIncludePossiblyRelated", senzing.SzSearchIncludePossiblyRelated},
+	{"SzSearchIncludePossiblySame", senzing.SzSearchIncludePossiblySame},
+	{"SzSearchIncludeResolved", senzing.SzSearchIncludeResolved},
+	{"SzSearchByAttributesAll", senzing.SzSearchByAttributesAll},
+	{"SzSearchByAttributesMinimalAll", senzing.SzSearchByAttributesMinimalAll},
+	{"SzSearchByAttributesMinimalStrong", senzing.SzSearchByAttributesMinimalStrong},
+	{"SzSearchByAttributesStrong", senzing.SzSearchByAttributesStrong},
+	{"SzEntityBriefDefaultFlags", senzing.SzEntityBriefDefaultFlags},
+	{"SzEntityCoreFlags", senzing.SzEntityCoreFlags},
+	{"SzEntityDefaultFlags", senzing.SzEntityDefaultFlags},
+	{"SzExportDefaultFlags", senzing.SzExportDefaultFlags},
+	{"SzFindNetworkDefaultFlags", senzing.SzFindNetworkDefaultFlags},
+	{"SzFindPathDefaultFlags", senzing.SzFindPathDefaultFlags},
+	{"SzHowEntityDefaultFlags", senzing.SzHowEntityDefaultFlags},
+	{"SzRecordDefaultFlags", senzing.SzRecordDefaultFlags},
+	{"SzSearchByAttributesDefaultFlags", senzing.SzSearchByAttributesDefaultFlags},
+	{"SzVirtualEntityDefaultFlags", senzing.SzVirtualEntityDefaultFlags},
+	{"SzWhyEntitiesDefaultFlags", senzing.SzWhyEntitiesDefaultFlags},
+	{"SzWhyRecordInEntityIDefaultFlags", senzing.SzWhyRecordInEntityIDefaultFlags},
+	{"SzWhyRecordsDefaultFlags", senzing.SzWhyRecordsDefaultFlags},
+}
+
+// Flags controlling the entity document shared by most methods returning entities.
+var entityFlags = senzing.Flags(
+	senzing.SzEntityIncludeAllRelations,
+	senzing.SzEntityIncludeAllFeatures,
+	senzing.SzEntityIncludeRepresentativeFeatures,
+	senzing.SzEntityIncludeEntityName,
+	senzing.SzEntityIncludeRecordSummary,
+	senzing.SzEntityIncludeRecordData,
+	senzing.SzEntityIncludeRecordMatchingInfo,
+	senzing.SzEntityIncludeRecordJSONData,
+	senzing.SzEntityIncludeRecordFeatureIDs,
+	senzing.SzEntityIncludeRelatedEntityName,
+	senzing.SzEntityIncludeRelatedMatchingInfo,
+	senzing.SzEntityIncludeRelatedRecordSummary,
+	senzing.SzEntityIncludeRelatedRecordData,
+	senzing.SzEntityIncludeInternalFeatures,
+	senzing.SzEntityIncludeFeatureStats,
+	senzing.SzEntityIncludeRecordTypes,
+	senzing.SzEntityIncludeRelatedRecordTypes,
+	senzing.SzEntityIncludeRecordUnmappedData,
+	senzing.SzEntityIncludeRecordFeatureDetails,
+	senzing.SzEntityIncludeRecordFeatureStats,
+)
+
+// Flags controlling the related entities of an entity document.
+var relatedEntityFlags = senzing.Flags(
+	senzing.SzEntityIncludeRelatedEntityName,
+	senzing.SzEntityIncludeRelatedMatchingInfo,
+	senzing.SzEntityIncludeRelatedRecordSummary,
+	senzing.SzEntityIncludeRelatedRecordData,
+	senzing.SzEntityIncludeRelatedRecordTypes,
+)
+
+// Flags controlling the record document of GetRecord and PreprocessRecord.
+var recordFlags = senzing.Flags(
+	senzing.SzEntityIncludeRecordJSONData,
+	senzing.SzEntityIncludeRecordFeatureIDs,
+	senzing.SzEntityIncludeRecordUnmappedData,
+	senzing.SzEntityIncludeRecordFeatureDetails,
+	senzing.SzEntityIncludeRecordFeatureStats,
+)
+
+// The flags that affect each Szengine method with a flags parameter.
+var methodFlags = map[string]int64{
+	"AddRecord":                         senzing.SzWithInfo,
+	"DeleteRecord":                      senzing.SzWithInfo,
+	"ExportCsvEntityReport":             senzing.SzExportIncludeAllEntities | senzing.SzExportIncludeAllHavingRelationships | entityFlags,
+	"ExportCsvEntityReportIterator":     senzing.SzExportIncludeAllEntities | senzing.SzExportIncludeAllHavingRelationships | entityFlags,
+	"ExportJSONEntityReport":            senzing.SzExportIncludeAllEntities | senzing.SzExportIncludeAllHavingRelationships | entityFlags,
+	"ExportJSONEntityReportIterator":    senzing.SzExportIncludeAllEntities | senzing.SzExportIncludeAllHavingRelationships | entityFlags,
+	"FindInterestingEntitiesByEntityID": senzing.SzNoFlags,
+	"FindInterestingEntitiesByRecordID": senzing.SzNoFlags,
+	"FindNetworkByEntityID":             entityFlags | senzing.SzFindNetworkIncludeMatchingInfo,
+	"FindNetworkByRecordID":             entityFlags | senzing.SzFindNetworkIncludeMatchingInfo,
+	"FindPathByEntityID":                entityFlags | senzing.SzFindPathStrictAvoid | senzing.SzFindPathIncludeMatchingInfo,
+	"FindPathByRecordID":                entityFlags | senzing.SzFindPathStrictAvoid | senzing.SzFindPathIncludeMatchingInfo,
+	"GetEntityByEntityID":               entityFlags,
+	"GetEntityByRecordID":               entityFlags,
+	"GetRecord":                         recordFlags,
+	"GetVirtualEntityByRecordID":        entityFlags &^ (senzing.SzEntityIncludeAllRelations | relatedEntityFlags),
+	"HowEntityByEntityID":               senzing.SzIncludeFeatureScores | senzing.SzIncludeMatchKeyDetails,
+	"PreprocessRecord":                  recordFlags,
+	"ProcessRedoRecord":                 senzing.SzWithInfo,
+	"ReevaluateEntity":                  senzing.SzWithInfo,
+	"ReevaluateRecord":                  senzing.SzWithInfo,
+	"SearchByAttributes": senzing.SzSearchIncludeAllEntities | entityFlags | senzing.SzIncludeFeatureScores | senzing.SzSearchIncludeStats |
+		senzing.SzSearchIncludeAllCandidates | senzing.SzIncludeMatchKeyDetails | senzing.SzSearchIncludeRequest | senzing.SzSearchIncludeRequestDetails,
+	"WhyEntities":       entityFlags | senzing.SzIncludeFeatureScores | senzing.SzIncludeMatchKeyDetails,
+	"WhyRecordInEntity": entityFlags | senzing.SzIncludeFeatureScores | senzing.SzIncludeMatchKeyDetails,
+	"WhyRecords":        entityFlags | senzing.SzIncludeFeatureScores | senzing.SzIncludeMatchKeyDetails,
+}
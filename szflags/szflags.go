@@ -0,0 +1,175 @@
+package szflags
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Interface functions
+// ----------------------------------------------------------------------------
+
+/*
+The Compose function combines flags given by name.
+Each name is a constant of [BitFlags] or [CompoundFlags], in Go or upper snake case,
+or a number in decimal or, with a 0x prefix, hexadecimal.
+
+Input
+  - names: The names of the flags to combine.
+
+Output
+  - The combined flags.
+  - An error wrapping ErrUnknownFlag if a name is not recognized.
+*/
+func Compose(names ...string) (int64, error) {
+	var result int64
+	for _, name := range names {
+		flag, err := lookup(name)
+		if err != nil {
+			return senzing.SzNoFlags, err
+		}
+		result |= flag
+	}
+	return result, nil
+}
+
+/*
+The Methods function returns the names of the Szengine methods known to Validate.
+
+Output
+  - The method names, sorted.
+*/
+func Methods() []string {
+	result := make([]string, 0, len(methodFlags))
+	for method := range methodFlags {
+		result = append(result, method)
+	}
+	sort.Strings(result)
+	return result
+}
+
+/*
+The Names function decodes flags into the names of the bit flags that are set.
+
+Input
+  - flags: The flags to decode.
+
+Output
+  - The names of the set bits, in bit order. Empty if no bits are set.
+*/
+func Names(flags int64) []string {
+	result := []string{}
+	for _, flag := range BitFlags {
+		if flags&flag.Value != 0 {
+			result = append(result, flag.Name)
+		}
+	}
+	return result
+}
+
+/*
+The Parse function combines flags written as a single string,
+with names separated by "|", "," or white space, such as "SzEntityIncludeEntityName | SzEntityIncludeRecordSummary".
+
+Input
+  - text: The names of the flags to combine.
+
+Output
+  - The combined flags. An empty string is SzNoFlags.
+  - An error wrapping ErrUnknownFlag if a name is not recognized.
+*/
+func Parse(text string) (int64, error) {
+	names := strings.FieldsFunc(text, func(character rune) bool {
+		return character == '|' || character == ',' || character == ' ' || character == '\t' || character == '\n'
+	})
+	return Compose(names...)
+}
+
+/*
+The String function decodes flags into a "|"-separated list of names.
+
+Input
+  - flags: The flags to decode.
+
+Output
+  - The names of the set bits, or "SzNoFlags" if no bits are set.
+*/
+func String(flags int64) string {
+	if flags == senzing.SzNoFlags {
+		return "SzNoFlags"
+	}
+	return strings.Join(Names(flags), "|")
+}
+
+/*
+The Validate function reports flags that have no effect on a method of Szengine.
+
+Input
+  - method: The name of an Szengine method with a flags parameter, such as "GetEntityByEntityID".
+  - flags: The flags passed to the method.
+
+Output
+  - Warnings, one per problem, or an empty list if every flag is meaningful.
+  - An error wrapping ErrUnknownMethod if the method has no flags parameter.
+*/
+func Validate(method string, flags int64) ([]string, error) {
+	relevant, isFound := methodFlags[method]
+	if !isFound {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMethod, method)
+	}
+	result := []string{}
+	for _, flag := range BitFlags {
+		if flags&flag.Value == 0 || relevant&flag.Value != 0 {
+			continue
+		}
+		if strings.HasPrefix(flag.Name, "Bit") {
+			result = append(result, fmt.Sprintf("%s is reserved and has no effect on %s", flag.Name, method))
+			continue
+		}
+		result = append(result, fmt.Sprintf("%s has no effect on %s", flag.Name, method))
+	}
+	flags &= relevant
+	if flags&senzing.SzEntityIncludeAllFeatures != 0 && flags&senzing.SzEntityIncludeRepresentativeFeatures != 0 {
+		result = append(result, "SzEntityIncludeAllFeatures and SzEntityIncludeRepresentativeFeatures are both set; only one applies")
+	}
+	if relevant&senzing.SzEntityIncludeAllRelations != 0 && flags&senzing.SzEntityIncludeAllRelations == 0 {
+		for _, name := range Names(flags & relatedEntityFlags) {
+			result = append(result, fmt.Sprintf("%s has no effect without a relation flag such as SzEntityIncludeAllRelations", name))
+		}
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Look up a flag by name or number.
+func lookup(name string) (int64, error) {
+	name = strings.TrimSpace(name)
+	if len(name) > 0 && name[0] >= '0' && name[0] <= '9' {
+		result, err := strconv.ParseInt(name, 0, 64)
+		if err != nil {
+			return senzing.SzNoFlags, fmt.Errorf("%w: %s", ErrUnknownFlag, name)
+		}
+		return result, nil
+	}
+	key := normalize(name)
+	for _, flags := range [][]Flag{BitFlags, CompoundFlags} {
+		for _, flag := range flags {
+			if normalize(flag.Name) == key {
+				return flag.Value, nil
+			}
+		}
+	}
+	return senzing.SzNoFlags, fmt.Errorf("%w: %s", ErrUnknownFlag, name)
+}
+
+// Normalize a name so that Go and upper snake case spellings compare equal.
+func normalize(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
@@ -0,0 +1,146 @@
+package szflags
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const printResults = false
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzflags_BitFlags(test *testing.T) {
+	require.Len(test, BitFlags, 63)
+	for index, flag := range BitFlags {
+		assert.Equal(test, int64(1)<<index, flag.Value, flag.Name)
+	}
+}
+
+func TestSzflags_Compose(test *testing.T) {
+	actual, err := Compose("SzEntityIncludeEntityName", "SZ_ENTITY_INCLUDE_RECORD_SUMMARY", "szentityincluderecordjsondata")
+	require.NoError(test, err)
+	assert.Equal(test, senzing.SzEntityIncludeEntityName|senzing.SzEntityIncludeRecordSummary|senzing.SzEntityIncludeRecordJSONData, actual)
+	actual, err = Compose("SzEntityDefaultFlags", "SZ_WITH_INFO")
+	require.NoError(test, err)
+	assert.Equal(test, senzing.SzEntityDefaultFlags|senzing.SzWithInfo, actual)
+	actual, err = Compose("3221225472", "0x1000")
+	require.NoError(test, err)
+	assert.Equal(test, senzing.SzFindPathIncludeMatchingInfo|senzing.SzEntityIncludeRecordUnmappedData|senzing.SzEntityIncludeEntityName, actual)
+	actual, err = Compose()
+	require.NoError(test, err)
+	assert.Equal(test, senzing.SzNoFlags, actual)
+}
+
+func TestSzflags_Compose_unknown(test *testing.T) {
+	for _, name := range []string{"SzEntityIncludeEverything", "12abc", ""} {
+		_, err := Compose(name)
+		require.ErrorIs(test, err, ErrUnknownFlag, name)
+	}
+}
+
+func TestSzflags_Methods(test *testing.T) {
+	actual := Methods()
+	printActual(test, actual)
+	assert.Contains(test, actual, "GetEntityByEntityID")
+	assert.Contains(test, actual, "SearchByAttributes")
+	assert.IsIncreasing(test, actual)
+}
+
+func TestSzflags_Names(test *testing.T) {
+	assert.Equal(test, []string{"SzFindPathIncludeMatchingInfo", "SzEntityIncludeRecordUnmappedData"}, Names(3221225472))
+	assert.Empty(test, Names(senzing.SzNoFlags))
+	assert.Len(test, Names(senzing.SzEntityDefaultFlags), bits.OnesCount64(uint64(senzing.SzEntityDefaultFlags)))
+	assert.Equal(test, []string{"Bit18", "Bit62", "SzWithInfo"}, Names(senzing.Bit18|senzing.Bit62|senzing.SzWithInfo))
+}
+
+func TestSzflags_Parse(test *testing.T) {
+	actual, err := Parse("SzEntityIncludeEntityName | SZ_ENTITY_INCLUDE_RECORD_SUMMARY,SzIncludeFeatureScores\tSzWithInfo")
+	require.NoError(test, err)
+	assert.Equal(test, senzing.SzEntityIncludeEntityName|senzing.SzEntityIncludeRecordSummary|senzing.SzIncludeFeatureScores|senzing.SzWithInfo, actual)
+	actual, err = Parse("  ")
+	require.NoError(test, err)
+	assert.Equal(test, senzing.SzNoFlags, actual)
+	_, err = Parse("SzEntityIncludeEntityName|Nope")
+	require.ErrorIs(test, err, ErrUnknownFlag)
+}
+
+func TestSzflags_String(test *testing.T) {
+	assert.Equal(test, "SzFindPathIncludeMatchingInfo|SzEntityIncludeRecordUnmappedData", String(3221225472))
+	assert.Equal(test, "SzNoFlags", String(senzing.SzNoFlags))
+	for _, flags := range []int64{senzing.SzEntityDefaultFlags, senzing.SzSearchByAttributesAll, senzing.SzWithInfo | senzing.Bit40} {
+		actual, err := Parse(String(flags))
+		require.NoError(test, err)
+		assert.Equal(test, flags, actual)
+	}
+}
+
+func TestSzflags_Validate(test *testing.T) {
+	testCases := []struct {
+		name     string
+		method   string
+		flags    int64
+		expected []string
+	}{
+		{name: "defaults", method: "GetEntityByEntityID", flags: senzing.SzEntityDefaultFlags, expected: []string{}},
+		{name: "search defaults", method: "SearchByAttributes", flags: senzing.SzSearchByAttributesDefaultFlags, expected: []string{}},
+		{name: "why defaults", method: "WhyEntities", flags: senzing.SzWhyEntitiesDefaultFlags, expected: []string{}},
+		{name: "path defaults", method: "FindPathByRecordID", flags: senzing.SzFindPathDefaultFlags, expected: []string{}},
+		{name: "network defaults", method: "FindNetworkByEntityID", flags: senzing.SzFindNetworkDefaultFlags, expected: []string{}},
+		{name: "export defaults", method: "ExportJSONEntityReport", flags: senzing.SzExportDefaultFlags, expected: []string{}},
+		{name: "record defaults", method: "GetRecord", flags: senzing.SzRecordDefaultFlags, expected: []string{}},
+		{name: "with info", method: "AddRecord", flags: senzing.SzWithInfo, expected: []string{}},
+		{
+			name:     "irrelevant",
+			method:   "GetEntityByEntityID",
+			flags:    senzing.SzEntityIncludeEntityName | senzing.SzWithInfo | senzing.SzFindPathStrictAvoid | senzing.Bit40,
+			expected: []string{"SzFindPathStrictAvoid has no effect on GetEntityByEntityID", "Bit40 is reserved and has no effect on GetEntityByEntityID", "SzWithInfo has no effect on GetEntityByEntityID"},
+		},
+		{
+			name:     "features",
+			method:   "GetVirtualEntityByRecordID",
+			flags:    senzing.SzEntityIncludeAllFeatures | senzing.SzEntityIncludeRepresentativeFeatures,
+			expected: []string{"SzEntityIncludeAllFeatures and SzEntityIncludeRepresentativeFeatures are both set; only one applies"},
+		},
+		{
+			name:     "related without relations",
+			method:   "GetEntityByRecordID",
+			flags:    senzing.SzEntityIncludeEntityName | senzing.SzEntityIncludeRelatedEntityName | senzing.SzEntityIncludeRelatedMatchingInfo,
+			expected: []string{"SzEntityIncludeRelatedEntityName has no effect without a relation flag such as SzEntityIncludeAllRelations", "SzEntityIncludeRelatedMatchingInfo has no effect without a relation flag such as SzEntityIncludeAllRelations"},
+		},
+		{
+			name:     "related with one relation",
+			method:   "GetEntityByRecordID",
+			flags:    senzing.SzEntityIncludeDisclosedRelations | senzing.SzEntityIncludeRelatedEntityName,
+			expected: []string{},
+		},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			actual, err := Validate(testCase.method, testCase.flags)
+			require.NoError(test, err)
+			printActual(test, actual)
+			assert.Equal(test, testCase.expected, actual)
+		})
+	}
+}
+
+func TestSzflags_Validate_unknownMethod(test *testing.T) {
+	_, err := Validate("GetStats", senzing.SzNoFlags)
+	require.ErrorIs(test, err, ErrUnknownMethod)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %+v", actual)
+	}
+}
@@ -0,0 +1,31 @@
+/*
+Package szmetrics records calls of the Sz objects and exposes them in the Prometheus text format.
+
+Metrics are opt-in. Create a [Metrics], pass it to the SetMetrics method of
+[szconfig.Szconfig], [szconfigmanager.Szconfigmanager], [szdiagnostic.Szdiagnostic],
+[szengine.Szengine] or [szproduct.Szproduct], and serve it over HTTP:
+
+	metrics := szmetrics.New()
+	szEngine.SetMetrics(ctx, metrics)
+	metrics.RegisterGauge("sz_redo_records", "Records waiting in the redo queue.", szmetrics.RedoRecords(szEngine))
+	http.Handle("/metrics", metrics)
+
+For every method call it records:
+
+  - sz_calls_total: A counter of calls, by component and method.
+  - sz_call_errors_total: A counter of failed calls, by component, method and Senzing exception code.
+  - sz_call_duration_seconds: A histogram of call latency, by component and method.
+
+Registered gauges, such as the redo backlog or the open export handles of an Szengine,
+are read each time the metrics are scraped.
+Each gauge name is written once: RegisterGauge replaces a gauge of the same name,
+and RegisterGaugeSource sums the sources of a gauge, such as several Szengine objects sharing the metrics.
+When no Metrics is set, the only cost to a method call is a nil check.
+
+[szconfig.Szconfig]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfig#Szconfig
+[szconfigmanager.Szconfigmanager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfigmanager#Szconfigmanager
+[szdiagnostic.Szdiagnostic]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szdiagnostic#Szdiagnostic
+[szengine.Szengine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine#Szengine
+[szproduct.Szproduct]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szproduct#Szproduct
+*/
+package szmetrics
@@ -0,0 +1,86 @@
+package szmetrics
+
+import (
+	"context"
+	"sync"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Metrics struct accumulates method calls and serves them, with registered gauges, in the Prometheus text format.
+Its methods may be called concurrently.
+*/
+type Metrics struct {
+	Buckets []float64 // Upper bounds, in seconds, of the latency histogram buckets. Changes after the first call is observed are ignored.
+	bounds  []float64
+	calls   map[callKey]*callStats
+	errors  map[errorKey]uint64
+	gauges  []*gauge
+	lock    sync.Mutex
+}
+
+// Type GaugeFunc reads the current value of a gauge when metrics are scraped.
+type GaugeFunc func(ctx context.Context) (float64, error)
+
+type callKey struct {
+	component string
+	method    string
+}
+
+type callStats struct {
+	buckets []uint64
+	count   uint64
+	sum     float64
+}
+
+type errorKey struct {
+	callKey
+	code string
+}
+
+type gauge struct {
+	help    string
+	name    string
+	sources []gaugeSource
+}
+
+type gaugeSource struct {
+	gaugeFunc GaugeFunc
+	source    any
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Names of the recorded metrics.
+const (
+	CallDurationMetric = "sz_call_duration_seconds"
+	CallErrorsMetric   = "sz_call_errors_total"
+	CallsMetric        = "sz_calls_total"
+)
+
+// ContentType is the media type of the Prometheus text format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// OtherErrorCode labels errors that do not come from the Senzing C library, such as malformed arguments.
+const OtherErrorCode = "OTHER"
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// ComponentNames label the components of the SDK by component ID.
+var ComponentNames = map[int]string{
+	6001: "szconfig",
+	6002: "szconfigmanager",
+	6003: "szdiagnostic",
+	6004: "szengine",
+	6006: "szproduct",
+}
+
+// DefaultBuckets are the latency histogram buckets used when Buckets is empty, from 0.5 milliseconds to 10 seconds.
+var DefaultBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
@@ -0,0 +1,339 @@
+package szmetrics
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Constructor
+// ----------------------------------------------------------------------------
+
+/*
+The New function returns empty metrics using DefaultBuckets.
+
+Output
+  - The metrics.
+*/
+func New() *Metrics {
+	return &Metrics{
+		Buckets: DefaultBuckets,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Metrics methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Observe records a method call.
+It is meant to be deferred at the start of the method:
+
+	defer metrics.Observe(ComponentID, "AddRecord", time.Now(), &err)
+
+Input
+  - componentID: The component of the method, such as 6004 for szengine.
+  - method: The name of the method.
+  - entryTime: When the call started.
+  - err: The error returned by the call, read when Observe runs. May be nil.
+*/
+func (metrics *Metrics) Observe(componentID int, method string, entryTime time.Time, err *error) {
+	seconds := time.Since(entryTime).Seconds()
+	key := callKey{component: componentName(componentID), method: method}
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+	if metrics.calls == nil {
+		metrics.bounds = append([]float64{}, metrics.buckets()...)
+		metrics.calls = map[callKey]*callStats{}
+		metrics.errors = map[errorKey]uint64{}
+	}
+	buckets := metrics.bounds
+	stats, isFound := metrics.calls[key]
+	if !isFound {
+		stats = &callStats{buckets: make([]uint64, len(buckets))}
+		metrics.calls[key] = stats
+	}
+	stats.count++
+	stats.sum += seconds
+	for index, bound := range buckets {
+		if seconds <= bound {
+			stats.buckets[index]++
+		}
+	}
+	if err != nil && *err != nil {
+		metrics.errors[errorKey{callKey: key, code: errorCode(*err)}]++
+	}
+}
+
+/*
+Method RegisterGauge adds a gauge read each time the metrics are written.
+Registering a name again replaces the gauge of that name.
+A gauge whose GaugeFunc fails is left out of that scrape.
+
+Input
+  - name: The metric name, such as "sz_redo_records".
+  - help: A description of the metric.
+  - gaugeFunc: Reads the value of the gauge.
+*/
+func (metrics *Metrics) RegisterGauge(name string, help string, gaugeFunc GaugeFunc) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+	registered := metrics.getGauge(name, help)
+	registered.sources = []gaugeSource{{gaugeFunc: gaugeFunc}}
+}
+
+/*
+Method RegisterGaugeSource adds one source to a gauge whose value is the sum of its sources,
+such as the open export handles of several Szengine objects sharing the metrics.
+Registering a source again replaces its GaugeFunc.
+A gauge with a failing GaugeFunc is left out of that scrape.
+
+Input
+  - name: The metric name, such as "sz_open_export_handles".
+  - help: A description of the metric.
+  - source: Identifies the source, such as the object it reads. Must be comparable.
+  - gaugeFunc: Reads the value of the source.
+*/
+func (metrics *Metrics) RegisterGaugeSource(name string, help string, source any, gaugeFunc GaugeFunc) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+	registered := metrics.getGauge(name, help)
+	for index := range registered.sources {
+		if registered.sources[index].source == source {
+			registered.sources[index].gaugeFunc = gaugeFunc
+			return
+		}
+	}
+	registered.sources = append(registered.sources, gaugeSource{gaugeFunc: gaugeFunc, source: source})
+}
+
+/*
+Method UnregisterGaugeSource removes a source added by RegisterGaugeSource.
+A gauge left without sources is no longer written.
+
+Input
+  - name: The metric name.
+  - source: The source.
+*/
+func (metrics *Metrics) UnregisterGaugeSource(name string, source any) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+	for gaugeIndex, registered := range metrics.gauges {
+		if registered.name != name {
+			continue
+		}
+		for index := range registered.sources {
+			if registered.sources[index].source == source {
+				registered.sources = append(registered.sources[:index], registered.sources[index+1:]...)
+				break
+			}
+		}
+		if len(registered.sources) == 0 {
+			metrics.gauges = append(metrics.gauges[:gaugeIndex], metrics.gauges[gaugeIndex+1:]...)
+		}
+		return
+	}
+}
+
+/*
+Method ServeHTTP writes the metrics in the Prometheus text format, so that Metrics is an [http.Handler].
+
+Input
+  - writer: The response.
+  - request: The scrape request. Its context is passed to gauges.
+*/
+func (metrics *Metrics) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	// Render before writing anything, so that an error can still be reported with its status code.
+	var buffer bytes.Buffer
+	if err := metrics.Write(request.Context(), &buffer); err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writer.Header().Set("Content-Type", ContentType)
+	writer.Header().Set("Content-Length", strconv.Itoa(buffer.Len()))
+	_, _ = buffer.WriteTo(writer) // The scraper sees a short body if the connection fails.
+}
+
+/*
+Method Write writes the metrics in the Prometheus text format.
+
+Input
+  - ctx: A context to control lifecycle, passed to gauges.
+  - writer: Where the metrics are written.
+*/
+func (metrics *Metrics) Write(ctx context.Context, writer io.Writer) error {
+	metrics.lock.Lock()
+	buckets := metrics.buckets()
+	calls := make([]callKey, 0, len(metrics.calls))
+	callStatsCopy := map[callKey]callStats{}
+	for key, stats := range metrics.calls {
+		calls = append(calls, key)
+		callStatsCopy[key] = callStats{buckets: append([]uint64{}, stats.buckets...), count: stats.count, sum: stats.sum}
+	}
+	errorKeys := make([]errorKey, 0, len(metrics.errors))
+	errorCounts := map[errorKey]uint64{}
+	for key, count := range metrics.errors {
+		errorKeys = append(errorKeys, key)
+		errorCounts[key] = count
+	}
+	gauges := make([]gauge, 0, len(metrics.gauges))
+	for _, registered := range metrics.gauges {
+		gauges = append(gauges, gauge{help: registered.help, name: registered.name, sources: append([]gaugeSource{}, registered.sources...)})
+	}
+	metrics.lock.Unlock()
+
+	sort.Slice(calls, func(i, j int) bool { return calls[i].less(calls[j]) })
+	sort.Slice(errorKeys, func(i, j int) bool {
+		if errorKeys[i].callKey != errorKeys[j].callKey {
+			return errorKeys[i].callKey.less(errorKeys[j].callKey)
+		}
+		return errorKeys[i].code < errorKeys[j].code
+	})
+
+	bufferedWriter := bufio.NewWriter(writer)
+	writeHeader(bufferedWriter, CallsMetric, "Calls of Sz methods.", "counter")
+	for _, key := range calls {
+		fmt.Fprintf(bufferedWriter, "%s{%s} %d\n", CallsMetric, key.labels(), callStatsCopy[key].count)
+	}
+	writeHeader(bufferedWriter, CallErrorsMetric, "Failed calls of Sz methods, by Senzing exception code.", "counter")
+	for _, key := range errorKeys {
+		fmt.Fprintf(bufferedWriter, "%s{%s,code=%s} %d\n", CallErrorsMetric, key.labels(), quote(key.code), errorCounts[key])
+	}
+	writeHeader(bufferedWriter, CallDurationMetric, "Latency of Sz methods.", "histogram")
+	for _, key := range calls {
+		stats := callStatsCopy[key]
+		for index, bound := range buckets {
+			fmt.Fprintf(bufferedWriter, "%s_bucket{%s,le=%s} %d\n", CallDurationMetric, key.labels(), quote(formatFloat(bound)), stats.buckets[index])
+		}
+		fmt.Fprintf(bufferedWriter, "%s_bucket{%s,le=\"+Inf\"} %d\n", CallDurationMetric, key.labels(), stats.count)
+		fmt.Fprintf(bufferedWriter, "%s_sum{%s} %s\n", CallDurationMetric, key.labels(), formatFloat(stats.sum))
+		fmt.Fprintf(bufferedWriter, "%s_count{%s} %d\n", CallDurationMetric, key.labels(), stats.count)
+	}
+	for _, gauge := range gauges {
+		value, err := gauge.value(ctx)
+		if err != nil {
+			continue
+		}
+		writeHeader(bufferedWriter, gauge.name, gauge.help, "gauge")
+		fmt.Fprintf(bufferedWriter, "%s %s\n", gauge.name, formatFloat(value))
+	}
+	return bufferedWriter.Flush()
+}
+
+// ----------------------------------------------------------------------------
+// Gauges
+// ----------------------------------------------------------------------------
+
+/*
+The RedoRecords function returns a gauge of the redo backlog of an engine.
+
+Input
+  - szEngine: The engine whose CountRedoRecords is read.
+
+Output
+  - The gauge.
+*/
+func RedoRecords(szEngine senzing.SzEngine) GaugeFunc {
+	return func(ctx context.Context) (float64, error) {
+		count, err := szEngine.CountRedoRecords(ctx)
+		return float64(count), err
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// The registered gauge of a name, added if missing. Its help is replaced.
+func (metrics *Metrics) getGauge(name string, help string) *gauge {
+	for _, registered := range metrics.gauges {
+		if registered.name == name {
+			registered.help = help
+			return registered
+		}
+	}
+	result := &gauge{help: help, name: name}
+	metrics.gauges = append(metrics.gauges, result)
+	return result
+}
+
+// The histogram buckets: those of the first observation, or else Buckets.
+func (metrics *Metrics) buckets() []float64 {
+	if metrics.bounds != nil {
+		return metrics.bounds
+	}
+	if len(metrics.Buckets) == 0 {
+		return DefaultBuckets
+	}
+	return metrics.Buckets
+}
+
+func (key callKey) labels() string {
+	return "component=" + quote(key.component) + ",method=" + quote(key.method)
+}
+
+func (key callKey) less(other callKey) bool {
+	if key.component != other.component {
+		return key.component < other.component
+	}
+	return key.method < other.method
+}
+
+// The sum of the sources of a gauge.
+func (registered gauge) value(ctx context.Context) (float64, error) {
+	var result float64
+	for _, source := range registered.sources {
+		value, err := source.gaugeFunc(ctx)
+		if err != nil {
+			return 0, err
+		}
+		result += value
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func componentName(componentID int) string {
+	if name, isFound := ComponentNames[componentID]; isFound {
+		return name
+	}
+	return strconv.Itoa(componentID)
+}
+
+// Label an error with its Senzing exception code, such as SENZ0033.
+func errorCode(err error) string {
+	var nativeError *nativeerror.Error
+	if errors.As(err, &nativeError) {
+		return nativeError.Code()
+	}
+	return OtherErrorCode
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// Quote a label value, escaping backslashes, quotes and line feeds.
+func quote(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+func writeHeader(writer io.Writer, name string, help string, metricType string) {
+	fmt.Fprintf(writer, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
@@ -0,0 +1,226 @@
+package szmetrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szengine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	printResults = false
+)
+
+var errGauge = errors.New("gauge failed")
+
+type mockEngine struct {
+	senzing.SzEngine
+	count int64
+	err   error
+}
+
+func (engine *mockEngine) CountRedoRecords(ctx context.Context) (int64, error) {
+	_ = ctx
+	return engine.count, engine.err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzmetrics_Observe(test *testing.T) {
+	ctx := context.TODO()
+	metrics := New()
+	observe(metrics, 6004, "AddRecord", nil)
+	observe(metrics, 6004, "AddRecord", nil)
+	observe(metrics, 6004, "GetRecord", newNativeError())
+	observe(metrics, 6004, "GetRecord", errors.New("malformed"))
+	actual := scrape(ctx, test, metrics)
+	printActual(test, actual)
+	assert.Contains(test, actual, "# TYPE sz_calls_total counter\n")
+	assert.Contains(test, actual, `sz_calls_total{component="szengine",method="AddRecord"} 2`+"\n")
+	assert.Contains(test, actual, `sz_calls_total{component="szengine",method="GetRecord"} 2`+"\n")
+	assert.Contains(test, actual, `sz_call_errors_total{component="szengine",method="GetRecord",code="SENZ0033"} 1`+"\n")
+	assert.Contains(test, actual, `sz_call_errors_total{component="szengine",method="GetRecord",code="OTHER"} 1`+"\n")
+	assert.NotContains(test, actual, `sz_call_errors_total{component="szengine",method="AddRecord"`)
+	assert.Contains(test, actual, "# TYPE sz_call_duration_seconds histogram\n")
+	assert.Contains(test, actual, `sz_call_duration_seconds_bucket{component="szengine",method="AddRecord",le="10"} 2`+"\n")
+	assert.Contains(test, actual, `sz_call_duration_seconds_bucket{component="szengine",method="AddRecord",le="+Inf"} 2`+"\n")
+	assert.Contains(test, actual, `sz_call_duration_seconds_count{component="szengine",method="AddRecord"} 2`+"\n")
+	assert.Contains(test, actual, `sz_call_duration_seconds_sum{component="szengine",method="AddRecord"} `)
+}
+
+func TestSzmetrics_Observe_buckets(test *testing.T) {
+	ctx := context.TODO()
+	metrics := &Metrics{Buckets: []float64{1, 60}}
+	metrics.Observe(6001, "GetDataSources", time.Now().Add(-2*time.Second), nil)
+	metrics.Buckets = []float64{100}
+	actual := scrape(ctx, test, metrics)
+	printActual(test, actual)
+	assert.Contains(test, actual, `sz_call_duration_seconds_bucket{component="szconfig",method="GetDataSources",le="1"} 0`+"\n")
+	assert.Contains(test, actual, `sz_call_duration_seconds_bucket{component="szconfig",method="GetDataSources",le="60"} 1`+"\n")
+	assert.NotContains(test, actual, `le="100"`)
+}
+
+func TestSzmetrics_Observe_concurrent(test *testing.T) {
+	ctx := context.TODO()
+	metrics := New()
+	var waitGroup sync.WaitGroup
+	for range 8 {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for range 100 {
+				observe(metrics, 6004, "AddRecord", nil)
+			}
+		}()
+	}
+	for range 8 {
+		_ = scrape(ctx, test, metrics)
+	}
+	waitGroup.Wait()
+	assert.Contains(test, scrape(ctx, test, metrics), `sz_calls_total{component="szengine",method="AddRecord"} 800`+"\n")
+}
+
+func TestSzmetrics_Observe_unknownComponent(test *testing.T) {
+	ctx := context.TODO()
+	metrics := New()
+	observe(metrics, 9999, "Do\"It\\", nil)
+	actual := scrape(ctx, test, metrics)
+	assert.Contains(test, actual, `sz_calls_total{component="9999",method="Do\"It\\"} 1`+"\n")
+}
+
+func TestSzmetrics_RegisterGauge(test *testing.T) {
+	ctx := context.TODO()
+	metrics := New()
+	metrics.RegisterGauge("sz_test_value", "A test value.", func(ctx context.Context) (float64, error) {
+		_ = ctx
+		return 1.5, nil
+	})
+	metrics.RegisterGauge("sz_test_failing", "A failing value.", func(ctx context.Context) (float64, error) {
+		_ = ctx
+		return 0, errGauge
+	})
+	actual := scrape(ctx, test, metrics)
+	printActual(test, actual)
+	assert.Contains(test, actual, "# TYPE sz_test_value gauge\nsz_test_value 1.5\n")
+	assert.NotContains(test, actual, "sz_test_failing")
+}
+
+func TestSzmetrics_RegisterGauge_replaced(test *testing.T) {
+	ctx := context.TODO()
+	metrics := New()
+	for _, value := range []float64{1, 2} {
+		metrics.RegisterGauge("sz_test_value", "A test value.", func(ctx context.Context) (float64, error) {
+			_ = ctx
+			return value, nil
+		})
+	}
+	actual := scrape(ctx, test, metrics)
+	printActual(test, actual)
+	assert.Equal(test, 1, strings.Count(actual, "# TYPE sz_test_value gauge\n"))
+	assert.Contains(test, actual, "sz_test_value 2\n")
+}
+
+func TestSzmetrics_RegisterGaugeSource(test *testing.T) {
+	ctx := context.TODO()
+	metrics := New()
+	constant := func(value float64) GaugeFunc {
+		return func(ctx context.Context) (float64, error) {
+			_ = ctx
+			return value, nil
+		}
+	}
+	metrics.RegisterGaugeSource("sz_test_total", "A test total.", "first", constant(1))
+	metrics.RegisterGaugeSource("sz_test_total", "A test total.", "second", constant(2))
+	metrics.RegisterGaugeSource("sz_test_total", "A test total.", "second", constant(3))
+	actual := scrape(ctx, test, metrics)
+	printActual(test, actual)
+	assert.Equal(test, 1, strings.Count(actual, "# HELP sz_test_total "))
+	assert.Contains(test, actual, "sz_test_total 4\n")
+	metrics.UnregisterGaugeSource("sz_test_total", "first")
+	assert.Contains(test, scrape(ctx, test, metrics), "sz_test_total 3\n")
+	metrics.UnregisterGaugeSource("sz_test_total", "second")
+	assert.NotContains(test, scrape(ctx, test, metrics), "sz_test_total")
+	metrics.RegisterGaugeSource("sz_test_total", "A test total.", "failing", func(ctx context.Context) (float64, error) {
+		_ = ctx
+		return 0, errGauge
+	})
+	metrics.RegisterGaugeSource("sz_test_total", "A test total.", "first", constant(1))
+	assert.NotContains(test, scrape(ctx, test, metrics), "sz_test_total")
+}
+
+func TestSzmetrics_RedoRecords(test *testing.T) {
+	ctx := context.TODO()
+	value, err := RedoRecords(&mockEngine{count: 42})(ctx)
+	require.NoError(test, err)
+	assert.InDelta(test, 42, value, 0)
+	_, err = RedoRecords(&mockEngine{err: errGauge})(ctx)
+	require.ErrorIs(test, err, errGauge)
+}
+
+func TestSzmetrics_ServeHTTP(test *testing.T) {
+	ctx := context.TODO()
+	metrics := New()
+	observe(metrics, 6006, "GetVersion", nil)
+	server := httptest.NewServer(metrics)
+	defer server.Close()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(test, err)
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(test, err)
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	require.NoError(test, err)
+	printActual(test, string(body))
+	assert.Equal(test, http.StatusOK, response.StatusCode)
+	assert.Equal(test, ContentType, response.Header.Get("Content-Type"))
+	assert.Equal(test, strconv.Itoa(len(body)), response.Header.Get("Content-Length"))
+	assert.Contains(test, string(body), `sz_calls_total{component="szproduct",method="GetVersion"} 1`+"\n")
+}
+
+func TestSzmetrics_Write_empty(test *testing.T) {
+	ctx := context.TODO()
+	actual := scrape(ctx, test, New())
+	assert.Equal(test, 3, strings.Count(actual, "# TYPE "))
+	for _, line := range strings.Split(strings.TrimSpace(actual), "\n") {
+		assert.True(test, strings.HasPrefix(line, "# "), line)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func newNativeError() error {
+	return nativeerror.New(6004, 4035, szengine.IDMessages, 33, "0033E|Unknown record", "unknown record", "CUSTOMERS", "1001", int64(0))
+}
+
+func observe(metrics *Metrics, componentID int, method string, err error) {
+	metrics.Observe(componentID, method, time.Now(), &err)
+}
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %s", actual)
+	}
+}
+
+func scrape(ctx context.Context, test *testing.T, metrics *Metrics) string {
+	var buffer bytes.Buffer
+	require.NoError(test, metrics.Write(ctx, &buffer))
+	return buffer.String()
+}
@@ -0,0 +1,21 @@
+/*
+Package szmodel contains Go types for the JSON documents returned by
+FindNetworkByEntityID, FindNetworkByRecordID, FindPathByEntityID, FindPathByRecordID,
+GetEntityByEntityID, GetEntityByRecordID, GetRecord, GetStats, GetVirtualEntityByRecordID, HowEntityByEntityID,
+SearchByAttributes, WhyEntities, WhyRecordInEntity and WhyRecords of [senzing.SzEngine],
+by the methods called with senzing.SzWithInfo and by the JSON entity export.
+
+The flags given to a method determine which parts of a document are present,
+so every field other than identifiers is optional: parts that were not requested
+are left at their zero values and fields added by newer versions of Senzing are ignored.
+
+[DecodeEntity], [DecodeHow], [DecodeNetwork], [DecodePath], [DecodeRecord], [DecodeSearch], [DecodeStats],
+[DecodeWhy] and [DecodeWithInfo] decode a response.
+[SearchResponse] has helpers to rank and filter search results.
+The typed variants of the methods, such as [szengine.Szengine.GetEntityByEntityIDTyped],
+call the method and decode its response.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+[szengine.Szengine.GetEntityByEntityIDTyped]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine#Szengine.GetEntityByEntityIDTyped
+*/
+package szmodel
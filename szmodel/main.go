@@ -0,0 +1,404 @@
+package szmodel
+
+import "encoding/json"
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Type AffectedEntity identifies an entity affected by a mutation.
+type AffectedEntity struct {
+	EntityID int64 `json:"ENTITY_ID"`
+}
+
+// Type CandidateKey is a feature that made an entity a candidate for matching.
+type CandidateKey struct {
+	FeatID   int64  `json:"FEAT_ID"`
+	FeatDesc string `json:"FEAT_DESC"`
+}
+
+/*
+Type Counts is a set of counters keyed by name, such as feature types.
+GetStats reports them as a list of single-key objects, such as [{"NAME": 19}, {"DOB": 25}],
+which decodes into a map.
+*/
+type Counts map[string]int64
+
+// Type Entity is the response of GetEntityByEntityID, GetEntityByRecordID and GetVirtualEntityByRecordID.
+type Entity struct {
+	ResolvedEntity  ResolvedEntity  `json:"RESOLVED_ENTITY"`
+	RelatedEntities []RelatedEntity `json:"RELATED_ENTITIES,omitempty"`
+}
+
+// Type EntityLink is a relationship between two entities of a network or path.
+type EntityLink struct {
+	MinEntityID    int64  `json:"MIN_ENTITY_ID"`
+	MaxEntityID    int64  `json:"MAX_ENTITY_ID"`
+	MatchLevel     int64  `json:"MATCH_LEVEL,omitempty"`
+	MatchLevelCode string `json:"MATCH_LEVEL_CODE"`
+	MatchKey       string `json:"MATCH_KEY"`
+	ErruleCode     string `json:"ERRULE_CODE"`
+	IsDisclosed    int64  `json:"IS_DISCLOSED"`
+	IsAmbiguous    int64  `json:"IS_AMBIGUOUS"`
+}
+
+// Type EntityPath is a sequence of entities connecting a start entity to an end entity.
+type EntityPath struct {
+	StartEntityID int64   `json:"START_ENTITY_ID"`
+	EndEntityID   int64   `json:"END_ENTITY_ID"`
+	Entities      []int64 `json:"ENTITIES"`
+}
+
+// Type ExpressedFeatureCall is the number of calls to an expressed feature function.
+type ExpressedFeatureCall struct {
+	EfcallID  int64  `json:"EFCALL_ID"`
+	EfuncCode string `json:"EFUNC_CODE"`
+	NumCalls  int64  `json:"numCalls"`
+}
+
+// Type Feature is a single value of a feature of a resolved entity.
+type Feature struct {
+	FeatDesc       string             `json:"FEAT_DESC"`
+	LibFeatID      int64              `json:"LIB_FEAT_ID"`
+	UsageType      string             `json:"USAGE_TYPE,omitempty"`
+	FeatDescValues []FeatureDescValue `json:"FEAT_DESC_VALUES,omitempty"`
+}
+
+// Type FeatureDescValue is one of the values combined into a feature value.
+type FeatureDescValue struct {
+	FeatDesc            string `json:"FEAT_DESC"`
+	LibFeatID           int64  `json:"LIB_FEAT_ID"`
+	UsedForCand         string `json:"USED_FOR_CAND,omitempty"`
+	UsedForScoring      string `json:"USED_FOR_SCORING,omitempty"`
+	EntityCount         int64  `json:"ENTITY_COUNT,omitempty"`
+	CandidateCapReached string `json:"CANDIDATE_CAP_REACHED,omitempty"`
+	ScoringCapReached   string `json:"SCORING_CAP_REACHED,omitempty"`
+	Suppressed          string `json:"SUPPRESSED,omitempty"`
+}
+
+/*
+Type FeatureScore is the comparison of an inbound feature with a feature of a candidate entity.
+Which scores are present depends on the feature type:
+names have GNR_* scores, most other features have FULL_SCORE.
+*/
+type FeatureScore struct {
+	InboundFeat            string `json:"INBOUND_FEAT"`
+	CandidateFeat          string `json:"CANDIDATE_FEAT"`
+	InboundFeatID          int64  `json:"INBOUND_FEAT_ID,omitempty"`
+	CandidateFeatID        int64  `json:"CANDIDATE_FEAT_ID,omitempty"`
+	InboundFeatUsageType   string `json:"INBOUND_FEAT_USAGE_TYPE,omitempty"`
+	CandidateFeatUsageType string `json:"CANDIDATE_FEAT_USAGE_TYPE,omitempty"`
+	FullScore              int64  `json:"FULL_SCORE,omitempty"`
+	GnrFn                  int64  `json:"GNR_FN,omitempty"`
+	GnrSn                  int64  `json:"GNR_SN,omitempty"`
+	GnrGn                  int64  `json:"GNR_GN,omitempty"`
+	GnrOn                  int64  `json:"GNR_ON,omitempty"`
+	GenerationMatch        int64  `json:"GENERATION_MATCH,omitempty"`
+	ScoreBucket            string `json:"SCORE_BUCKET,omitempty"`
+	ScoreBehavior          string `json:"SCORE_BEHAVIOR,omitempty"`
+}
+
+// Type FinalState is the entities resulting from the resolution steps of a How result.
+type FinalState struct {
+	NeedReevaluation int64           `json:"NEED_REEVALUATION"`
+	VirtualEntities  []VirtualEntity `json:"VIRTUAL_ENTITIES"`
+}
+
+// Type HowResponse is the response of HowEntityByEntityID.
+type HowResponse struct {
+	HowResults HowResults `json:"HOW_RESULTS"`
+}
+
+// Type HowResults is the sequence of steps that resolved an entity.
+type HowResults struct {
+	ResolutionSteps []ResolutionStep `json:"RESOLUTION_STEPS"`
+	FinalState      FinalState       `json:"FINAL_STATE"`
+}
+
+// Type InterestingEntities lists entities found interesting after a mutation.
+type InterestingEntities struct {
+	Entities []InterestingEntity `json:"ENTITIES"`
+	Notices  []Notice            `json:"NOTICES,omitempty"`
+}
+
+// Type InterestingEntity is an entity found interesting after a mutation.
+type InterestingEntity struct {
+	EntityID      int64          `json:"ENTITY_ID"`
+	Degrees       int64          `json:"DEGREES"`
+	Flags         []string       `json:"FLAGS,omitempty"`
+	SampleRecords []SampleRecord `json:"SAMPLE_RECORDS,omitempty"`
+}
+
+// Type Network is the response of FindNetworkByEntityID and FindNetworkByRecordID.
+type Network struct {
+	EntityPaths           []EntityPath `json:"ENTITY_PATHS"`
+	EntityNetworkLinks    []EntityLink `json:"ENTITY_NETWORK_LINKS,omitempty"`
+	Entities              []Entity     `json:"ENTITIES"`
+	MaxEntityLimitReached string       `json:"MAX_ENTITY_LIMIT_REACHED,omitempty"`
+}
+
+// Type Notice is a notice reported with the interesting entities.
+type Notice struct {
+	Code        string `json:"CODE"`
+	Description string `json:"DESCRIPTION"`
+}
+
+/*
+Type MatchInfo describes how a search result matched the search attributes,
+how two entities or records are related in a Why result, or how two virtual entities matched in a How result.
+Why results report WHY_KEY and WHY_ERRULE_CODE instead of MATCH_KEY and ERRULE_CODE.
+*/
+type MatchInfo struct {
+	MatchLevel         int64                     `json:"MATCH_LEVEL,omitempty"`
+	MatchLevelCode     string                    `json:"MATCH_LEVEL_CODE,omitempty"`
+	MatchKey           string                    `json:"MATCH_KEY,omitempty"`
+	ErruleCode         string                    `json:"ERRULE_CODE,omitempty"`
+	WhyKey             string                    `json:"WHY_KEY,omitempty"`
+	WhyErruleCode      string                    `json:"WHY_ERRULE_CODE,omitempty"`
+	CandidateKeys      map[string][]CandidateKey `json:"CANDIDATE_KEYS,omitempty"`
+	DisclosedRelations json.RawMessage           `json:"DISCLOSED_RELATIONS,omitempty"`
+	FeatureScores      map[string][]FeatureScore `json:"FEATURE_SCORES,omitempty"`
+}
+
+// Type MemberRecord is a record of a virtual entity, grouped by internal ID.
+type MemberRecord struct {
+	InternalID int64       `json:"INTERNAL_ID"`
+	Records    []RecordKey `json:"RECORDS"`
+}
+
+// Type Path is the response of FindPathByEntityID and FindPathByRecordID.
+type Path struct {
+	EntityPaths     []EntityPath `json:"ENTITY_PATHS"`
+	EntityPathLinks []EntityLink `json:"ENTITY_PATH_LINKS,omitempty"`
+	Entities        []Entity     `json:"ENTITIES"`
+}
+
+/*
+Type Record is a record that has been resolved into an entity.
+It is also the response of GetRecord, which has only DATA_SOURCE, RECORD_ID and, depending on flags, JSON_DATA.
+*/
+type Record struct {
+	DataSource     string          `json:"DATA_SOURCE"`
+	RecordID       string          `json:"RECORD_ID"`
+	EntityType     string          `json:"ENTITY_TYPE,omitempty"`
+	InternalID     int64           `json:"INTERNAL_ID,omitempty"`
+	EntityKey      string          `json:"ENTITY_KEY,omitempty"`
+	EntityDesc     string          `json:"ENTITY_DESC,omitempty"`
+	MatchKey       string          `json:"MATCH_KEY"`
+	MatchLevel     int64           `json:"MATCH_LEVEL"`
+	MatchLevelCode string          `json:"MATCH_LEVEL_CODE"`
+	ErruleCode     string          `json:"ERRULE_CODE"`
+	LastSeenDt     string          `json:"LAST_SEEN_DT,omitempty"`
+	Features       []RecordFeature `json:"FEATURES,omitempty"`
+	JSONData       json.RawMessage `json:"JSON_DATA,omitempty"`
+}
+
+// Type RecordKey identifies a record.
+type RecordKey struct {
+	DataSource string `json:"DATA_SOURCE"`
+	RecordID   string `json:"RECORD_ID"`
+}
+
+// Type RecordFeature identifies a feature of the resolved entity that a record contributed.
+type RecordFeature struct {
+	LibFeatID int64  `json:"LIB_FEAT_ID"`
+	UsageType string `json:"USAGE_TYPE,omitempty"`
+}
+
+// Type RecordSummary is the count of records from a single data source within an entity.
+type RecordSummary struct {
+	DataSource  string `json:"DATA_SOURCE"`
+	RecordCount int64  `json:"RECORD_COUNT"`
+	FirstSeenDt string `json:"FIRST_SEEN_DT,omitempty"`
+	LastSeenDt  string `json:"LAST_SEEN_DT,omitempty"`
+}
+
+// Type RelatedEntity is an entity related to the resolved entity.
+type RelatedEntity struct {
+	EntityID       int64           `json:"ENTITY_ID"`
+	EntityName     string          `json:"ENTITY_NAME,omitempty"`
+	MatchLevel     int64           `json:"MATCH_LEVEL"`
+	MatchLevelCode string          `json:"MATCH_LEVEL_CODE"`
+	MatchKey       string          `json:"MATCH_KEY"`
+	ErruleCode     string          `json:"ERRULE_CODE"`
+	IsDisclosed    int64           `json:"IS_DISCLOSED"`
+	IsAmbiguous    int64           `json:"IS_AMBIGUOUS"`
+	RecordSummary  []RecordSummary `json:"RECORD_SUMMARY,omitempty"`
+	LastSeenDt     string          `json:"LAST_SEEN_DT,omitempty"`
+}
+
+// Type ResolvedEntity is the entity described by a response.
+type ResolvedEntity struct {
+	EntityID      int64                `json:"ENTITY_ID"`
+	EntityName    string               `json:"ENTITY_NAME,omitempty"`
+	Features      map[string][]Feature `json:"FEATURES,omitempty"`
+	RecordSummary []RecordSummary      `json:"RECORD_SUMMARY,omitempty"`
+	Records       []Record             `json:"RECORDS,omitempty"`
+	LastSeenDt    string               `json:"LAST_SEEN_DT,omitempty"`
+}
+
+/*
+Type ResolutionStep is one step of a How result:
+the inbound virtual entity matched the other virtual entity, forming the result virtual entity.
+*/
+type ResolutionStep struct {
+	Step                   int64         `json:"STEP"`
+	VirtualEntity1         VirtualEntity `json:"VIRTUAL_ENTITY_1"`
+	VirtualEntity2         VirtualEntity `json:"VIRTUAL_ENTITY_2"`
+	InboundVirtualEntityID string        `json:"INBOUND_VIRTUAL_ENTITY_ID"`
+	ResultVirtualEntityID  string        `json:"RESULT_VIRTUAL_ENTITY_ID"`
+	MatchInfo              MatchInfo     `json:"MATCH_INFO"`
+}
+
+// Type ReresolveTriggers counts the reasons entities were re-resolved.
+type ReresolveTriggers struct {
+	AbortRetry                   int64  `json:"abortRetry"`
+	UnresolveMovement            int64  `json:"unresolveMovement"`
+	MultipleResolvableCandidates int64  `json:"multipleResolvableCandidates"`
+	ResolveNewFeatures           int64  `json:"resolveNewFeatures"`
+	NewFeatureFTypes             Counts `json:"newFeatureFTypes,omitempty"`
+}
+
+// Type SampleRecord is a record of an interesting entity.
+type SampleRecord struct {
+	DataSource string   `json:"DATA_SOURCE"`
+	RecordID   string   `json:"RECORD_ID"`
+	Flags      []string `json:"FLAGS,omitempty"`
+}
+
+// Type SearchResponse is the response of SearchByAttributes.
+type SearchResponse struct {
+	ResolvedEntities []SearchResult `json:"RESOLVED_ENTITIES"`
+}
+
+// Type SearchResult is an entity found by SearchByAttributes.
+type SearchResult struct {
+	MatchInfo MatchInfo `json:"MATCH_INFO"`
+	Entity    Entity    `json:"ENTITY"`
+}
+
+// Type Stats is the response of GetStats.
+type Stats struct {
+	Workload Workload `json:"workload"`
+}
+
+// Type ThreadState is the number of engine threads in each state when GetStats was called.
+type ThreadState struct {
+	Active              int64 `json:"active"`
+	Idle                int64 `json:"idle"`
+	SQLExecuting        int64 `json:"sqlExecuting"`
+	Loader              int64 `json:"loader"`
+	Resolver            int64 `json:"resolver"`
+	Scoring             int64 `json:"scoring"`
+	DataLatchContention int64 `json:"dataLatchContention"`
+	ObsEntContention    int64 `json:"obsEntContention"`
+	ResEntContention    int64 `json:"resEntContention"`
+}
+
+// Type VirtualEntity is an intermediate or final entity of a How result.
+type VirtualEntity struct {
+	VirtualEntityID string         `json:"VIRTUAL_ENTITY_ID"`
+	MemberRecords   []MemberRecord `json:"MEMBER_RECORDS"`
+}
+
+// Type WhyResponse is the response of WhyEntities, WhyRecords and WhyRecordInEntity.
+type WhyResponse struct {
+	WhyResults []WhyResult `json:"WHY_RESULTS"`
+	Entities   []Entity    `json:"ENTITIES,omitempty"`
+}
+
+/*
+Type WhyResult explains the relationship of two entities or two records,
+or, for WhyRecordInEntity, of a record to its entity, in which case the fields ending in 2 are empty.
+*/
+type WhyResult struct {
+	InternalID    int64       `json:"INTERNAL_ID,omitempty"`
+	EntityID      int64       `json:"ENTITY_ID"`
+	FocusRecords  []RecordKey `json:"FOCUS_RECORDS,omitempty"`
+	InternalID2   int64       `json:"INTERNAL_ID_2,omitempty"`
+	EntityID2     int64       `json:"ENTITY_ID_2,omitempty"`
+	FocusRecords2 []RecordKey `json:"FOCUS_RECORDS_2,omitempty"`
+	MatchInfo     MatchInfo   `json:"MATCH_INFO"`
+}
+
+/*
+Type WithInfo is the response of AddRecord, DeleteRecord, ProcessRedoRecord, ReevaluateEntity
+and ReevaluateRecord when called with senzing.SzWithInfo.
+DataSource and RecordID are empty for ReevaluateEntity.
+*/
+type WithInfo struct {
+	DataSource          string              `json:"DATA_SOURCE,omitempty"`
+	RecordID            string              `json:"RECORD_ID,omitempty"`
+	AffectedEntities    []AffectedEntity    `json:"AFFECTED_ENTITIES"`
+	InterestingEntities InterestingEntities `json:"INTERESTING_ENTITIES"`
+}
+
+/*
+Type Workload is the workload counters of GetStats.
+The counters cover the period since the previous call of GetStats.
+ThreadState and SystemResources describe the engine at the time of the call.
+Lists whose structure varies between Senzing versions are kept as raw JSON.
+*/
+type Workload struct {
+	LoadedRecords                              int64                  `json:"loadedRecords"`
+	AddedRecords                               int64                  `json:"addedRecords"`
+	DeletedRecords                             int64                  `json:"deletedRecords"`
+	Reevaluations                              int64                  `json:"reevaluations"`
+	RepairedEntities                           int64                  `json:"repairedEntities"`
+	Duration                                   int64                  `json:"duration"`
+	Retries                                    int64                  `json:"retries"`
+	Candidates                                 int64                  `json:"candidates"`
+	ActualAmbiguousTest                        int64                  `json:"actualAmbiguousTest"`
+	CachedAmbiguousTest                        int64                  `json:"cachedAmbiguousTest"`
+	LibFeatCacheHit                            int64                  `json:"libFeatCacheHit"`
+	LibFeatCacheMiss                           int64                  `json:"libFeatCacheMiss"`
+	UnresolveTest                              int64                  `json:"unresolveTest"`
+	AbortedUnresolve                           int64                  `json:"abortedUnresolve"`
+	GnrScorersUsed                             int64                  `json:"gnrScorersUsed"`
+	UnresolveTriggers                          Counts                 `json:"unresolveTriggers,omitempty"`
+	ReresolveTriggers                          ReresolveTriggers      `json:"reresolveTriggers"`
+	ReresolveSkipped                           int64                  `json:"reresolveSkipped"`
+	FilteredObsFeat                            int64                  `json:"filteredObsFeat"`
+	ExpressedFeatureCalls                      []ExpressedFeatureCall `json:"expressedFeatureCalls,omitempty"`
+	ExpressedFeaturesCreated                   Counts                 `json:"expressedFeaturesCreated,omitempty"`
+	ScoredPairs                                Counts                 `json:"scoredPairs,omitempty"`
+	CacheHit                                   Counts                 `json:"cacheHit,omitempty"`
+	CacheMiss                                  Counts                 `json:"cacheMiss,omitempty"`
+	RedoTriggers                               Counts                 `json:"redoTriggers,omitempty"`
+	LatchContention                            json.RawMessage        `json:"latchContention,omitempty"`
+	HighContentionFeat                         json.RawMessage        `json:"highContentionFeat,omitempty"`
+	HighContentionResEnt                       json.RawMessage        `json:"highContentionResEnt,omitempty"`
+	GenericDetect                              Counts                 `json:"genericDetect,omitempty"`
+	CandidateBuilders                          Counts                 `json:"candidateBuilders,omitempty"`
+	SuppressedCandidateBuilders                Counts                 `json:"suppressedCandidateBuilders,omitempty"`
+	SuppressedScoredFeatureType                Counts                 `json:"suppressedScoredFeatureType,omitempty"`
+	ReducedScoredFeatureType                   Counts                 `json:"reducedScoredFeatureType,omitempty"`
+	SuppressedDisclosedRelationshipDomainCount int64                  `json:"suppressedDisclosedRelationshipDomainCount"`
+	CorruptEntityTestDiagnosis                 json.RawMessage        `json:"CorruptEntityTestDiagnosis,omitempty"`
+	ThreadState                                ThreadState            `json:"threadState"`
+	SystemResources                            json.RawMessage        `json:"systemResources,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+TimeLayout is the [time] layout of FIRST_SEEN_DT and LAST_SEEN_DT values.
+*/
+const TimeLayout = "2006-01-02 15:04:05.000"
+
+// ----------------------------------------------------------------------------
+// Match levels
+// ----------------------------------------------------------------------------
+
+/*
+Match levels reported in MATCH_LEVEL of records and related entities.
+*/
+const (
+	MatchLevelResolved        int64 = 1
+	MatchLevelPossiblySame    int64 = 2
+	MatchLevelPossiblyRelated int64 = 3
+	MatchLevelNameOnly        int64 = 4
+	MatchLevelDisclosed       int64 = 11
+)
@@ -0,0 +1,186 @@
+package szmodel
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ----------------------------------------------------------------------------
+// Decoding
+// ----------------------------------------------------------------------------
+
+/*
+The DecodeNetwork function decodes the response of FindNetworkByEntityID or FindNetworkByRecordID.
+
+Input
+  - response: The JSON document returned by FindNetworkByEntityID or FindNetworkByRecordID.
+
+Output
+  - The decoded network.
+*/
+func DecodeNetwork(response string) (*Network, error) {
+	result := &Network{}
+	if err := json.Unmarshal([]byte(response), result); err != nil {
+		return nil, fmt.Errorf("szmodel: cannot decode network response: %w", err)
+	}
+	return result, nil
+}
+
+/*
+The DecodePath function decodes the response of FindPathByEntityID or FindPathByRecordID.
+
+Input
+  - response: The JSON document returned by FindPathByEntityID or FindPathByRecordID.
+
+Output
+  - The decoded path.
+*/
+func DecodePath(response string) (*Path, error) {
+	result := &Path{}
+	if err := json.Unmarshal([]byte(response), result); err != nil {
+		return nil, fmt.Errorf("szmodel: cannot decode path response: %w", err)
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Network methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Entity returns an entity of the network.
+
+Input
+  - entityID: The unique identifier of an entity.
+
+Output
+  - The entity, or nil if the network does not include it.
+*/
+func (network *Network) Entity(entityID int64) *Entity {
+	return findEntity(network.Entities, entityID)
+}
+
+/*
+Method Links returns the relationships between the entities of the network, ordered by entity IDs.
+ENTITY_NETWORK_LINKS is used when present.
+Otherwise links are derived from the RELATED_ENTITIES of the entities in the network.
+
+Output
+  - The links, with MinEntityID less than MaxEntityID.
+*/
+func (network *Network) Links() []EntityLink {
+	return entityLinks(network.EntityNetworkLinks, network.Entities)
+}
+
+// ----------------------------------------------------------------------------
+// Path methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Entity returns an entity on the path.
+
+Input
+  - entityID: The unique identifier of an entity.
+
+Output
+  - The entity, or nil if the path does not include it.
+*/
+func (path *Path) Entity(entityID int64) *Entity {
+	return findEntity(path.Entities, entityID)
+}
+
+/*
+Method Links returns the relationships between the entities of the path, ordered by entity IDs.
+ENTITY_PATH_LINKS is used when present.
+Otherwise links are derived from the RELATED_ENTITIES of the entities on the path.
+
+Output
+  - The links, with MinEntityID less than MaxEntityID.
+*/
+func (path *Path) Links() []EntityLink {
+	return entityLinks(path.EntityPathLinks, path.Entities)
+}
+
+// ----------------------------------------------------------------------------
+// EntityLink methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Connects reports whether the link is between two entities, in either order.
+
+Input
+  - entityID1: The unique identifier of an entity.
+  - entityID2: The unique identifier of another entity.
+
+Output
+  - True if the link connects the entities.
+*/
+func (link *EntityLink) Connects(entityID1 int64, entityID2 int64) bool {
+	return (link.MinEntityID == entityID1 && link.MaxEntityID == entityID2) ||
+		(link.MinEntityID == entityID2 && link.MaxEntityID == entityID1)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Derive links from RELATED_ENTITIES, keeping only those between included entities.
+func deriveLinks(entities []Entity) []EntityLink {
+	included := map[int64]bool{}
+	for _, entity := range entities {
+		included[entity.ResolvedEntity.EntityID] = true
+	}
+	result := []EntityLink{}
+	seen := map[[2]int64]bool{}
+	for _, entity := range entities {
+		entityID := entity.ResolvedEntity.EntityID
+		for _, relatedEntity := range entity.RelatedEntities {
+			if !included[relatedEntity.EntityID] || relatedEntity.EntityID == entityID {
+				continue
+			}
+			key := [2]int64{min(entityID, relatedEntity.EntityID), max(entityID, relatedEntity.EntityID)}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			result = append(result, EntityLink{
+				MinEntityID:    key[0],
+				MaxEntityID:    key[1],
+				MatchLevel:     relatedEntity.MatchLevel,
+				MatchLevelCode: relatedEntity.MatchLevelCode,
+				MatchKey:       relatedEntity.MatchKey,
+				ErruleCode:     relatedEntity.ErruleCode,
+				IsDisclosed:    relatedEntity.IsDisclosed,
+				IsAmbiguous:    relatedEntity.IsAmbiguous,
+			})
+		}
+	}
+	return result
+}
+
+func entityLinks(links []EntityLink, entities []Entity) []EntityLink {
+	var result []EntityLink
+	if len(links) > 0 {
+		result = make([]EntityLink, len(links))
+		copy(result, links)
+	} else {
+		result = deriveLinks(entities)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].MinEntityID != result[j].MinEntityID {
+			return result[i].MinEntityID < result[j].MinEntityID
+		}
+		return result[i].MaxEntityID < result[j].MaxEntityID
+	})
+	return result
+}
+
+func findEntity(entities []Entity, entityID int64) *Entity {
+	for index := range entities {
+		if entities[index].ResolvedEntity.EntityID == entityID {
+			return &entities[index]
+		}
+	}
+	return nil
+}
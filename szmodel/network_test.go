@@ -0,0 +1,81 @@
+package szmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzmodel_DecodeNetwork(test *testing.T) {
+	for _, filename := range []string{"find-network-by-entity-id.json", "find-network-by-record-id.json"} {
+		test.Run(filename, func(test *testing.T) {
+			actual, err := DecodeNetwork(readResponse(test, filename))
+			require.NoError(test, err)
+			printActual(test, actual)
+			require.Len(test, actual.EntityPaths, 1)
+			assert.Equal(test, []int64{1, 2}, actual.EntityPaths[0].Entities)
+			require.NotNil(test, actual.Entity(1))
+			assert.NotEmpty(test, actual.Entity(1).ResolvedEntity.EntityName)
+			assert.Nil(test, actual.Entity(99))
+			links := actual.Links()
+			require.NotEmpty(test, links)
+			assert.True(test, links[0].Connects(2, 1))
+			assert.Equal(test, "POSSIBLY_RELATED", links[0].MatchLevelCode)
+		})
+	}
+	_, err := DecodeNetwork("{")
+	require.Error(test, err)
+}
+
+func TestSzmodel_DecodePath(test *testing.T) {
+	for _, filename := range []string{"find-path-by-entity-id.json", "find-path-by-record-id.json"} {
+		test.Run(filename, func(test *testing.T) {
+			actual, err := DecodePath(readResponse(test, filename))
+			require.NoError(test, err)
+			printActual(test, actual)
+			require.Len(test, actual.EntityPaths, 1)
+			assert.Equal(test, int64(1), actual.EntityPaths[0].StartEntityID)
+			assert.Equal(test, int64(2), actual.EntityPaths[0].EndEntityID)
+			assert.Equal(test, "OCEANGUY", actual.Entity(2).ResolvedEntity.EntityName)
+			links := actual.Links()
+			require.NotEmpty(test, links)
+			assert.Equal(test, EntityLink{
+				MinEntityID:    1,
+				MaxEntityID:    2,
+				MatchLevel:     3,
+				MatchLevelCode: "POSSIBLY_RELATED",
+				MatchKey:       "+PHONE+ACCT_NUM-SSN",
+				ErruleCode:     "SF1",
+			}, links[0])
+		})
+	}
+	_, err := DecodePath("[]")
+	require.Error(test, err)
+}
+
+func TestSzmodel_Path_Links_explicit(test *testing.T) {
+	actual, err := DecodePath(`{"ENTITY_PATHS":[{"START_ENTITY_ID":3,"END_ENTITY_ID":1,"ENTITIES":[3,2,1]}],
+"ENTITY_PATH_LINKS":[{"MIN_ENTITY_ID":2,"MAX_ENTITY_ID":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS","ERRULE_CODE":"MFF","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},
+{"MIN_ENTITY_ID":1,"MAX_ENTITY_ID":2,"MATCH_LEVEL_CODE":"DISCLOSED","MATCH_KEY":"+REL_POINTER(DOMAIN:-EMPLOYER)","ERRULE_CODE":"DISCLOSED","IS_DISCLOSED":1,"IS_AMBIGUOUS":0}],
+"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1},"RELATED_ENTITIES":[{"ENTITY_ID":9,"MATCH_KEY":"+NAME"}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2}},{"RESOLVED_ENTITY":{"ENTITY_ID":3}}]}`)
+	require.NoError(test, err)
+	links := actual.Links()
+	require.Len(test, links, 2)
+	assert.Equal(test, int64(1), links[0].MinEntityID)
+	assert.Equal(test, int64(1), links[0].IsDisclosed)
+	assert.True(test, links[1].Connects(3, 2))
+	assert.Equal(test, int64(2), actual.EntityPathLinks[1].MaxEntityID, "links must not reorder the response")
+}
+
+func TestSzmodel_Network_Links_derived(test *testing.T) {
+	actual, err := DecodeNetwork(`{"ENTITY_PATHS":[],"ENTITIES":[
+{"RESOLVED_ENTITY":{"ENTITY_ID":1},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_KEY":"+NAME"},{"ENTITY_ID":9,"MATCH_KEY":"+PHONE"}]},
+{"RESOLVED_ENTITY":{"ENTITY_ID":2},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_KEY":"+NAME"}]}]}`)
+	require.NoError(test, err)
+	assert.Equal(test, []EntityLink{{MinEntityID: 1, MaxEntityID: 2, MatchKey: "+NAME"}}, actual.Links())
+}
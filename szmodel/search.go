@@ -0,0 +1,174 @@
+package szmodel
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ----------------------------------------------------------------------------
+// Decoding
+// ----------------------------------------------------------------------------
+
+/*
+The DecodeSearch function decodes the response of SearchByAttributes.
+
+Input
+  - response: The JSON document returned by SearchByAttributes.
+
+Output
+  - The decoded search results.
+*/
+func DecodeSearch(response string) (*SearchResponse, error) {
+	result := &SearchResponse{}
+	if err := json.Unmarshal([]byte(response), result); err != nil {
+		return nil, fmt.Errorf("szmodel: cannot decode search response: %w", err)
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// SearchResponse methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Best returns the best search result as ordered by [SearchResponse.Ranked].
+
+Output
+  - The best result, or nil if there are no results.
+*/
+func (response *SearchResponse) Best() *SearchResult {
+	ranked := response.Ranked()
+	if len(ranked) == 0 {
+		return nil
+	}
+	return &ranked[0]
+}
+
+/*
+Method FilterByMatchLevel returns the results matching at least as strongly as a match level.
+Lower match levels are stronger, so results with a MATCH_LEVEL from 1 to matchLevel are kept.
+
+Input
+  - matchLevel: The weakest match level kept, such as MatchLevelPossiblySame.
+
+Output
+  - The kept results, in their original order.
+*/
+func (response *SearchResponse) FilterByMatchLevel(matchLevel int64) []SearchResult {
+	result := []SearchResult{}
+	for _, searchResult := range response.ResolvedEntities {
+		if searchResult.MatchInfo.MatchLevel > 0 && searchResult.MatchInfo.MatchLevel <= matchLevel {
+			result = append(result, searchResult)
+		}
+	}
+	return result
+}
+
+/*
+Method FilterByScore returns the results whose [SearchResult.Score] is at least a minimum score.
+FEATURE_SCORES are only present when senzing.SzIncludeFeatureScores is requested.
+
+Input
+  - minScore: The lowest score kept, from 0 to 100.
+
+Output
+  - The kept results, in their original order.
+*/
+func (response *SearchResponse) FilterByScore(minScore int64) []SearchResult {
+	result := []SearchResult{}
+	for _, searchResult := range response.ResolvedEntities {
+		if searchResult.Score() >= minScore {
+			result = append(result, searchResult)
+		}
+	}
+	return result
+}
+
+/*
+Method GroupByDataSource returns the results grouped by the data sources of their records.
+A result with records from several data sources is in several groups.
+RECORD_SUMMARY or RECORDS must be requested.
+
+Output
+  - The results for each data source, in ranked order.
+*/
+func (response *SearchResponse) GroupByDataSource() map[string][]SearchResult {
+	result := map[string][]SearchResult{}
+	for _, searchResult := range response.Ranked() {
+		for _, dataSource := range searchResult.Entity.DataSources() {
+			result[dataSource] = append(result[dataSource], searchResult)
+		}
+	}
+	return result
+}
+
+/*
+Method Ranked returns the results from best to worst:
+by MATCH_LEVEL (lower is better), then by [SearchResult.Score] (higher is better), then by ENTITY_ID.
+
+Output
+  - A sorted copy of the results.
+*/
+func (response *SearchResponse) Ranked() []SearchResult {
+	result := make([]SearchResult, len(response.ResolvedEntities))
+	copy(result, response.ResolvedEntities)
+	scores := make(map[int64]int64, len(result))
+	for _, searchResult := range result {
+		scores[searchResult.Entity.ResolvedEntity.EntityID] = searchResult.Score()
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		matchLevelI := result[i].MatchInfo.MatchLevel
+		matchLevelJ := result[j].MatchInfo.MatchLevel
+		if matchLevelI != matchLevelJ {
+			return matchLevelI < matchLevelJ
+		}
+		entityIDI := result[i].Entity.ResolvedEntity.EntityID
+		entityIDJ := result[j].Entity.ResolvedEntity.EntityID
+		if scores[entityIDI] != scores[entityIDJ] {
+			return scores[entityIDI] > scores[entityIDJ]
+		}
+		return entityIDI < entityIDJ
+	})
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// SearchResult methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Score returns the average, over feature types, of the best score of each feature type.
+It is 0 when FEATURE_SCORES was not requested.
+*/
+func (searchResult *SearchResult) Score() int64 {
+	var total int64
+	var count int64
+	for _, featureScores := range searchResult.MatchInfo.FeatureScores {
+		var best int64
+		for _, featureScore := range featureScores {
+			best = max(best, featureScore.Score())
+		}
+		total += best
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / count
+}
+
+// ----------------------------------------------------------------------------
+// FeatureScore methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Score returns FULL_SCORE, or GNR_FN for names, which have no FULL_SCORE.
+Negative scores, meaning "not scored", are returned as 0.
+*/
+func (featureScore *FeatureScore) Score() int64 {
+	if featureScore.FullScore != 0 {
+		return max(featureScore.FullScore, 0)
+	}
+	return max(featureScore.GnrFn, 0)
+}
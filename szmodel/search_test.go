@@ -0,0 +1,115 @@
+package szmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var searchResponse = `{"RESOLVED_ENTITIES":[
+{"MATCH_INFO":{"MATCH_LEVEL":2,"MATCH_LEVEL_CODE":"POSSIBLY_SAME","MATCH_KEY":"+NAME-DOB","ERRULE_CODE":"CNAME","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT":"BOB SMITH","CANDIDATE_FEAT":"ROBERT SMITH","GNR_FN":90}],"DOB":[{"INBOUND_FEAT":"1978-12-11","CANDIDATE_FEAT":"1978-11-12","FULL_SCORE":60}]}},
+ "ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":3,"RECORD_SUMMARY":[{"DATA_SOURCE":"CUSTOMERS","RECORD_COUNT":1}]}}},
+{"MATCH_INFO":{"MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","MATCH_KEY":"+NAME+DOB","ERRULE_CODE":"SF1","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT":"BOB SMITH","CANDIDATE_FEAT":"BOB SMITH","GNR_FN":100},{"INBOUND_FEAT":"BOB SMITH","CANDIDATE_FEAT":"ROBERT SMITH","GNR_FN":90}],"DOB":[{"INBOUND_FEAT":"1978-12-11","CANDIDATE_FEAT":"1978-12-11","FULL_SCORE":100}]}},
+ "ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":2,"RECORD_SUMMARY":[{"DATA_SOURCE":"CUSTOMERS","RECORD_COUNT":2},{"DATA_SOURCE":"WATCHLIST","RECORD_COUNT":1}]}}},
+{"MATCH_INFO":{"MATCH_LEVEL":2,"MATCH_LEVEL_CODE":"POSSIBLY_SAME","MATCH_KEY":"+NAME+DOB","ERRULE_CODE":"CNAME_CFF","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT":"BOB SMITH","CANDIDATE_FEAT":"BOB SMITH","GNR_FN":100}],"DOB":[{"INBOUND_FEAT":"1978-12-11","CANDIDATE_FEAT":"1978-12-11","FULL_SCORE":100}],"ADDRESS":[{"INBOUND_FEAT":"123 MAIN ST","CANDIDATE_FEAT":"PO BOX 7","FULL_SCORE":-1}]}},
+ "ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":4,"RECORD_SUMMARY":[{"DATA_SOURCE":"WATCHLIST","RECORD_COUNT":1}]}}},
+{"MATCH_INFO":{"MATCH_LEVEL":4,"MATCH_LEVEL_CODE":"NAME_ONLY","MATCH_KEY":"+NAME","ERRULE_CODE":"NAME_ONLY"},
+ "ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":1,"RECORD_SUMMARY":[{"DATA_SOURCE":"REFERENCE","RECORD_COUNT":1}]}}}
+]}`
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzmodel_DecodeSearch(test *testing.T) {
+	actual, err := DecodeSearch(readResponse(test, "search-by-attributes.json"))
+	require.NoError(test, err)
+	printActual(test, actual)
+	require.Len(test, actual.ResolvedEntities, 1)
+	searchResult := actual.ResolvedEntities[0]
+	assert.Equal(test, MatchLevelResolved, searchResult.MatchInfo.MatchLevel)
+	assert.Equal(test, "+NAME+SSN", searchResult.MatchInfo.MatchKey)
+	assert.Equal(test, "SF1_PNAME_CSTAB", searchResult.MatchInfo.ErruleCode)
+	assert.Equal(test, FeatureScore{
+		InboundFeat:     "JOHNSON",
+		CandidateFeat:   "JOHNSON",
+		GnrFn:           100,
+		GnrSn:           100,
+		GnrGn:           70,
+		GnrOn:           -1,
+		GenerationMatch: -1,
+	}, searchResult.MatchInfo.FeatureScores["NAME"][0])
+	assert.Equal(test, int64(100), searchResult.Score())
+	assert.Equal(test, int64(1), searchResult.Entity.ResolvedEntity.EntityID)
+	assert.Equal(test, int64(6), searchResult.Entity.RecordCount())
+	assert.Equal(test, &searchResult, actual.Best())
+}
+
+func TestSzmodel_DecodeSearch_badJSON(test *testing.T) {
+	actual, err := DecodeSearch(`{"RESOLVED_ENTITIES":{}}`)
+	require.Error(test, err)
+	assert.Nil(test, actual)
+}
+
+func TestSearchResponse_Best(test *testing.T) {
+	response, err := DecodeSearch(searchResponse)
+	require.NoError(test, err)
+	assert.Equal(test, int64(2), response.Best().Entity.ResolvedEntity.EntityID)
+	assert.Nil(test, (&SearchResponse{}).Best())
+}
+
+func TestSearchResponse_FilterByMatchLevel(test *testing.T) {
+	response, err := DecodeSearch(searchResponse)
+	require.NoError(test, err)
+	assert.Equal(test, []int64{2}, entityIDs(response.FilterByMatchLevel(MatchLevelResolved)))
+	assert.Equal(test, []int64{3, 2, 4}, entityIDs(response.FilterByMatchLevel(MatchLevelPossiblySame)))
+	assert.Equal(test, []int64{3, 2, 4, 1}, entityIDs(response.FilterByMatchLevel(MatchLevelNameOnly)))
+}
+
+func TestSearchResponse_FilterByScore(test *testing.T) {
+	response, err := DecodeSearch(searchResponse)
+	require.NoError(test, err)
+	assert.Equal(test, []int64{2}, entityIDs(response.FilterByScore(100)))
+	assert.Equal(test, []int64{3, 2, 4}, entityIDs(response.FilterByScore(60)))
+	assert.Len(test, response.FilterByScore(0), 4)
+}
+
+func TestSearchResponse_GroupByDataSource(test *testing.T) {
+	response, err := DecodeSearch(searchResponse)
+	require.NoError(test, err)
+	actual := response.GroupByDataSource()
+	assert.Len(test, actual, 3)
+	assert.Equal(test, []int64{2, 3}, entityIDs(actual["CUSTOMERS"]))
+	assert.Equal(test, []int64{2, 4}, entityIDs(actual["WATCHLIST"]))
+	assert.Equal(test, []int64{1}, entityIDs(actual["REFERENCE"]))
+}
+
+func TestSearchResponse_Ranked(test *testing.T) {
+	response, err := DecodeSearch(searchResponse)
+	require.NoError(test, err)
+	assert.Equal(test, []int64{2, 3, 4, 1}, entityIDs(response.Ranked()))
+	assert.Equal(test, []int64{3, 2, 4, 1}, entityIDs(response.ResolvedEntities))
+}
+
+func TestSearchResult_Score(test *testing.T) {
+	response, err := DecodeSearch(searchResponse)
+	require.NoError(test, err)
+	actual := []int64{}
+	for i := range response.ResolvedEntities {
+		actual = append(actual, response.ResolvedEntities[i].Score())
+	}
+	assert.Equal(test, []int64{75, 100, 66, 0}, actual)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func entityIDs(searchResults []SearchResult) []int64 {
+	result := []int64{}
+	for _, searchResult := range searchResults {
+		result = append(result, searchResult.Entity.ResolvedEntity.EntityID)
+	}
+	return result
+}
@@ -0,0 +1,205 @@
+package szmodel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ----------------------------------------------------------------------------
+// Decoding
+// ----------------------------------------------------------------------------
+
+/*
+The DecodeStats function decodes the response of GetStats.
+
+Input
+  - response: The JSON document returned by GetStats.
+
+Output
+  - The decoded statistics.
+*/
+func DecodeStats(response string) (*Stats, error) {
+	result := &Stats{}
+	if err := json.Unmarshal([]byte(response), result); err != nil {
+		return nil, fmt.Errorf("szmodel: cannot decode stats response: %w", err)
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Counts methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Add adds the counters of other to the counts.
+
+Input
+  - other: The counters to add.
+
+Output
+  - The sum, which is counts itself unless counts is nil.
+*/
+func (counts Counts) Add(other Counts) Counts {
+	if len(other) == 0 {
+		return counts
+	}
+	result := counts
+	if result == nil {
+		result = Counts{}
+	}
+	for key, value := range other {
+		result[key] += value
+	}
+	return result
+}
+
+/*
+Method Total returns the sum of the counters.
+
+Output
+  - The sum of all counters.
+*/
+func (counts Counts) Total() int64 {
+	var result int64
+	for _, value := range counts {
+		result += value
+	}
+	return result
+}
+
+/*
+Method UnmarshalJSON decodes counters given either as a list of single-key objects,
+as reported by GetStats, or as a single object.
+
+Input
+  - data: The JSON list or object.
+*/
+func (counts *Counts) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) {
+		*counts = nil
+		return nil
+	}
+	result := Counts{}
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		entries := []map[string]int64{}
+		if err := json.Unmarshal(trimmed, &entries); err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			for key, value := range entry {
+				result[key] += value
+			}
+		}
+	} else if err := json.Unmarshal(trimmed, (*map[string]int64)(&result)); err != nil {
+		return err
+	}
+	*counts = result
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Workload methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Add accumulates the workload of a later call of GetStats into the workload.
+Counters are added; ThreadState, SystemResources and the raw JSON lists are replaced
+by those of delta when delta reports them.
+
+Input
+  - delta: The workload reported by a later call of GetStats.
+*/
+func (workload *Workload) Add(delta *Workload) {
+	workload.LoadedRecords += delta.LoadedRecords
+	workload.AddedRecords += delta.AddedRecords
+	workload.DeletedRecords += delta.DeletedRecords
+	workload.Reevaluations += delta.Reevaluations
+	workload.RepairedEntities += delta.RepairedEntities
+	workload.Duration += delta.Duration
+	workload.Retries += delta.Retries
+	workload.Candidates += delta.Candidates
+	workload.ActualAmbiguousTest += delta.ActualAmbiguousTest
+	workload.CachedAmbiguousTest += delta.CachedAmbiguousTest
+	workload.LibFeatCacheHit += delta.LibFeatCacheHit
+	workload.LibFeatCacheMiss += delta.LibFeatCacheMiss
+	workload.UnresolveTest += delta.UnresolveTest
+	workload.AbortedUnresolve += delta.AbortedUnresolve
+	workload.GnrScorersUsed += delta.GnrScorersUsed
+	workload.UnresolveTriggers = workload.UnresolveTriggers.Add(delta.UnresolveTriggers)
+	workload.ReresolveTriggers.AbortRetry += delta.ReresolveTriggers.AbortRetry
+	workload.ReresolveTriggers.UnresolveMovement += delta.ReresolveTriggers.UnresolveMovement
+	workload.ReresolveTriggers.MultipleResolvableCandidates += delta.ReresolveTriggers.MultipleResolvableCandidates
+	workload.ReresolveTriggers.ResolveNewFeatures += delta.ReresolveTriggers.ResolveNewFeatures
+	workload.ReresolveTriggers.NewFeatureFTypes = workload.ReresolveTriggers.NewFeatureFTypes.Add(delta.ReresolveTriggers.NewFeatureFTypes)
+	workload.ReresolveSkipped += delta.ReresolveSkipped
+	workload.FilteredObsFeat += delta.FilteredObsFeat
+	workload.ExpressedFeatureCalls = addExpressedFeatureCalls(workload.ExpressedFeatureCalls, delta.ExpressedFeatureCalls)
+	workload.ExpressedFeaturesCreated = workload.ExpressedFeaturesCreated.Add(delta.ExpressedFeaturesCreated)
+	workload.ScoredPairs = workload.ScoredPairs.Add(delta.ScoredPairs)
+	workload.CacheHit = workload.CacheHit.Add(delta.CacheHit)
+	workload.CacheMiss = workload.CacheMiss.Add(delta.CacheMiss)
+	workload.RedoTriggers = workload.RedoTriggers.Add(delta.RedoTriggers)
+	workload.GenericDetect = workload.GenericDetect.Add(delta.GenericDetect)
+	workload.CandidateBuilders = workload.CandidateBuilders.Add(delta.CandidateBuilders)
+	workload.SuppressedCandidateBuilders = workload.SuppressedCandidateBuilders.Add(delta.SuppressedCandidateBuilders)
+	workload.SuppressedScoredFeatureType = workload.SuppressedScoredFeatureType.Add(delta.SuppressedScoredFeatureType)
+	workload.ReducedScoredFeatureType = workload.ReducedScoredFeatureType.Add(delta.ReducedScoredFeatureType)
+	workload.SuppressedDisclosedRelationshipDomainCount += delta.SuppressedDisclosedRelationshipDomainCount
+	workload.LatchContention = latestRaw(workload.LatchContention, delta.LatchContention)
+	workload.HighContentionFeat = latestRaw(workload.HighContentionFeat, delta.HighContentionFeat)
+	workload.HighContentionResEnt = latestRaw(workload.HighContentionResEnt, delta.HighContentionResEnt)
+	workload.CorruptEntityTestDiagnosis = latestRaw(workload.CorruptEntityTestDiagnosis, delta.CorruptEntityTestDiagnosis)
+	workload.SystemResources = latestRaw(workload.SystemResources, delta.SystemResources)
+	workload.ThreadState = delta.ThreadState
+}
+
+/*
+Method LibFeatCacheHitRatio returns the fraction of feature library lookups served from the cache.
+
+Output
+  - The ratio of libFeatCacheHit to all lookups, or 0 if there were none.
+*/
+func (workload *Workload) LibFeatCacheHitRatio() float64 {
+	lookups := workload.LibFeatCacheHit + workload.LibFeatCacheMiss
+	if lookups == 0 {
+		return 0
+	}
+	return float64(workload.LibFeatCacheHit) / float64(lookups)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Add the calls of expressed feature functions, matching them by EFCALL_ID.
+func addExpressedFeatureCalls(calls []ExpressedFeatureCall, delta []ExpressedFeatureCall) []ExpressedFeatureCall {
+	if len(delta) == 0 {
+		return calls
+	}
+	indexes := map[int64]int{}
+	for index, call := range calls {
+		indexes[call.EfcallID] = index
+	}
+	for _, call := range delta {
+		if index, ok := indexes[call.EfcallID]; ok {
+			calls[index].NumCalls += call.NumCalls
+			continue
+		}
+		indexes[call.EfcallID] = len(calls)
+		calls = append(calls, call)
+	}
+	sort.Slice(calls, func(i, j int) bool {
+		return calls[i].EfcallID < calls[j].EfcallID
+	})
+	return calls
+}
+
+func latestRaw(current json.RawMessage, delta json.RawMessage) json.RawMessage {
+	if len(delta) > 0 {
+		return delta
+	}
+	return current
+}
@@ -0,0 +1,78 @@
+package szmodel
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzmodel_DecodeStats(test *testing.T) {
+	actual, err := DecodeStats(readResponse(test, "get-stats.json"))
+	require.NoError(test, err)
+	printActual(test, actual)
+	workload := actual.Workload
+	assert.Equal(test, int64(5), workload.LoadedRecords)
+	assert.Equal(test, int64(2), workload.AddedRecords)
+	assert.Equal(test, int64(56), workload.Duration)
+	assert.Equal(test, Counts{"normalResolve": 0, "update": 0, "relLink": 0, "extensiveResolve": 0, "ambiguousNoResolve": 1, "ambiguousMultiResolve": 0}, workload.UnresolveTriggers)
+	assert.Equal(test, Counts{"DOB": 1}, workload.ReresolveTriggers.NewFeatureFTypes)
+	assert.Equal(test, int64(25), workload.ScoredPairs["DOB"])
+	assert.Equal(test, int64(58), workload.CacheHit.Total())
+	assert.Len(test, workload.ExpressedFeatureCalls, 9)
+	assert.Equal(test, ExpressedFeatureCall{EfcallID: 7, EfuncCode: "NAME_HASHER", NumCalls: 4}, workload.ExpressedFeatureCalls[4])
+	assert.Empty(test, workload.RedoTriggers)
+	assert.Equal(test, int64(4), workload.ThreadState.Idle)
+	assert.True(test, json.Valid(workload.SystemResources))
+	assert.InDelta(test, 0.75, workload.LibFeatCacheHitRatio(), 0.001)
+	_, err = DecodeStats(`{"workload":{"cacheHit":[{"NAME":"many"}]}}`)
+	require.Error(test, err)
+}
+
+func TestSzmodel_Counts_UnmarshalJSON(test *testing.T) {
+	testCases := map[string]Counts{
+		`[{"NAME":1},{"DOB":2},{"NAME":3}]`: {"NAME": 4, "DOB": 2},
+		`{"NAME":1}`:                        {"NAME": 1},
+		`[]`:                                {},
+		`null`:                              nil,
+	}
+	for input, expected := range testCases {
+		test.Run(input, func(test *testing.T) {
+			var actual Counts
+			require.NoError(test, json.Unmarshal([]byte(input), &actual))
+			assert.Equal(test, expected, actual)
+		})
+	}
+}
+
+func TestSzmodel_Workload_Add(test *testing.T) {
+	first, err := DecodeStats(readResponse(test, "get-stats.json"))
+	require.NoError(test, err)
+	second, err := DecodeStats(`{"workload":{"loadedRecords":3,"retries":2,"libFeatCacheHit":1,"scoredPairs":[{"DOB":5},{"EMAIL":1}],
+"reresolveTriggers":{"resolveNewFeatures":2,"newFeatureFTypes":[{"DOB":1}]},
+"expressedFeatureCalls":[{"EFCALL_ID":7,"EFUNC_CODE":"NAME_HASHER","numCalls":6},{"EFCALL_ID":4,"EFUNC_CODE":"EXPRESS_ID","numCalls":1}],
+"threadState":{"active":3,"idle":1}}}`)
+	require.NoError(test, err)
+	totals := Workload{}
+	totals.Add(&first.Workload)
+	totals.Add(&second.Workload)
+	assert.Equal(test, int64(8), totals.LoadedRecords)
+	assert.Equal(test, int64(2), totals.AddedRecords)
+	assert.Equal(test, int64(2), totals.Retries)
+	assert.Equal(test, int64(220), totals.LibFeatCacheHit)
+	assert.Equal(test, int64(30), totals.ScoredPairs["DOB"])
+	assert.Equal(test, int64(1), totals.ScoredPairs["EMAIL"])
+	assert.Equal(test, int64(3), totals.ReresolveTriggers.ResolveNewFeatures)
+	assert.Equal(test, Counts{"DOB": 2}, totals.ReresolveTriggers.NewFeatureFTypes)
+	require.Len(test, totals.ExpressedFeatureCalls, 10)
+	assert.Equal(test, ExpressedFeatureCall{EfcallID: 4, EfuncCode: "EXPRESS_ID", NumCalls: 1}, totals.ExpressedFeatureCalls[3])
+	assert.Equal(test, int64(10), totals.ExpressedFeatureCalls[5].NumCalls)
+	assert.Equal(test, ThreadState{Active: 3, Idle: 1}, totals.ThreadState)
+	assert.Equal(test, first.Workload.SystemResources, totals.SystemResources)
+	assert.Equal(test, int64(4), first.Workload.ExpressedFeatureCalls[4].NumCalls, "adding must not change the delta")
+}
@@ -0,0 +1,313 @@
+package szmodel
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Decoding
+// ----------------------------------------------------------------------------
+
+/*
+The DecodeEntity function decodes the response of GetEntityByEntityID, GetEntityByRecordID
+or GetVirtualEntityByRecordID.
+
+Input
+  - response: The JSON document returned by the method.
+
+Output
+  - The decoded entity.
+*/
+func DecodeEntity(response string) (*Entity, error) {
+	result := &Entity{}
+	if err := json.Unmarshal([]byte(response), result); err != nil {
+		return nil, fmt.Errorf("szmodel: cannot decode entity: %w", err)
+	}
+	return result, nil
+}
+
+/*
+The DecodeHow function decodes the response of HowEntityByEntityID.
+
+Input
+  - response: The JSON document returned by HowEntityByEntityID.
+
+Output
+  - The decoded resolution steps and final state.
+*/
+func DecodeHow(response string) (*HowResponse, error) {
+	result := &HowResponse{}
+	if err := json.Unmarshal([]byte(response), result); err != nil {
+		return nil, fmt.Errorf("szmodel: cannot decode how response: %w", err)
+	}
+	return result, nil
+}
+
+/*
+The DecodeRecord function decodes the response of GetRecord.
+
+Input
+  - response: The JSON document returned by GetRecord.
+
+Output
+  - The decoded record.
+*/
+func DecodeRecord(response string) (*Record, error) {
+	result := &Record{}
+	if err := json.Unmarshal([]byte(response), result); err != nil {
+		return nil, fmt.Errorf("szmodel: cannot decode record: %w", err)
+	}
+	return result, nil
+}
+
+/*
+The DecodeWhy function decodes the response of WhyEntities, WhyRecords or WhyRecordInEntity.
+
+Input
+  - response: The JSON document returned by the method.
+
+Output
+  - The decoded explanation.
+*/
+func DecodeWhy(response string) (*WhyResponse, error) {
+	result := &WhyResponse{}
+	if err := json.Unmarshal([]byte(response), result); err != nil {
+		return nil, fmt.Errorf("szmodel: cannot decode why response: %w", err)
+	}
+	return result, nil
+}
+
+/*
+The DecodeWithInfo function decodes the response of a method called with senzing.SzWithInfo.
+
+Input
+  - response: The JSON document returned by the method.
+
+Output
+  - The decoded information.
+*/
+func DecodeWithInfo(response string) (*WithInfo, error) {
+	result := &WithInfo{}
+	if err := json.Unmarshal([]byte(response), result); err != nil {
+		return nil, fmt.Errorf("szmodel: cannot decode with-info response: %w", err)
+	}
+	return result, nil
+}
+
+/*
+The ParseTime function parses a FIRST_SEEN_DT or LAST_SEEN_DT value.
+Senzing reports these times in UTC.
+
+Input
+  - value: A value in [TimeLayout].
+
+Output
+  - The time, or the zero time if value is empty.
+*/
+func ParseTime(value string) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+	return time.Parse(TimeLayout, value)
+}
+
+/*
+The ParseMatchKey function splits a MATCH_KEY or WHY_KEY such as "+NAME+DOB-SSN" into
+the features that matched and the features that conflicted.
+Parenthesized details, such as the roles of a disclosed relationship, are kept with their feature.
+
+Input
+  - matchKey: The key to split.
+
+Output
+  - The matched features, in order.
+  - The conflicting features, in order.
+*/
+func ParseMatchKey(matchKey string) ([]string, []string) {
+	matched := []string{}
+	conflicted := []string{}
+	depth := 0
+	start := 0
+	sign := byte('+')
+	flush := func(end int) {
+		if end > start {
+			if sign == '-' {
+				conflicted = append(conflicted, matchKey[start:end])
+			} else {
+				matched = append(matched, matchKey[start:end])
+			}
+		}
+	}
+	for i := 0; i < len(matchKey); i++ {
+		switch character := matchKey[i]; {
+		case character == '(':
+			depth++
+		case character == ')':
+			depth--
+		case depth == 0 && (character == '+' || character == '-'):
+			flush(i)
+			sign = character
+			start = i + 1
+		}
+	}
+	flush(len(matchKey))
+	return matched, conflicted
+}
+
+// ----------------------------------------------------------------------------
+// Entity methods
+// ----------------------------------------------------------------------------
+
+/*
+Method DataSources returns the sorted, distinct data sources of the records in the entity.
+If RECORDS was not requested, RECORD_SUMMARY is used.
+*/
+func (entity *Entity) DataSources() []string {
+	seen := map[string]bool{}
+	for _, record := range entity.ResolvedEntity.Records {
+		seen[record.DataSource] = true
+	}
+	if len(seen) == 0 {
+		for _, summary := range entity.ResolvedEntity.RecordSummary {
+			seen[summary.DataSource] = true
+		}
+	}
+	result := make([]string, 0, len(seen))
+	for dataSource := range seen {
+		result = append(result, dataSource)
+	}
+	sort.Strings(result)
+	return result
+}
+
+/*
+Method RecordCount returns the number of records in the entity.
+If RECORDS was not requested, the counts in RECORD_SUMMARY are summed.
+*/
+func (entity *Entity) RecordCount() int64 {
+	if len(entity.ResolvedEntity.Records) > 0 {
+		return int64(len(entity.ResolvedEntity.Records))
+	}
+	var result int64
+	for _, summary := range entity.ResolvedEntity.RecordSummary {
+		result += summary.RecordCount
+	}
+	return result
+}
+
+/*
+Method RecordCountByDataSource returns the number of records in the entity per data source.
+If RECORDS was not requested, RECORD_SUMMARY is used.
+*/
+func (entity *Entity) RecordCountByDataSource() map[string]int64 {
+	result := map[string]int64{}
+	for _, record := range entity.ResolvedEntity.Records {
+		result[record.DataSource]++
+	}
+	if len(result) == 0 {
+		for _, summary := range entity.ResolvedEntity.RecordSummary {
+			result[summary.DataSource] += summary.RecordCount
+		}
+	}
+	return result
+}
+
+/*
+Method RecordKeys returns the keys of the records in the entity, sorted by data source and record identifier.
+It requires RECORDS, so senzing.SzEntityIncludeRecordData must be requested.
+*/
+func (entity *Entity) RecordKeys() []RecordKey {
+	result := make([]RecordKey, 0, len(entity.ResolvedEntity.Records))
+	for _, record := range entity.ResolvedEntity.Records {
+		result = append(result, RecordKey{DataSource: record.DataSource, RecordID: record.RecordID})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].DataSource != result[j].DataSource {
+			return result[i].DataSource < result[j].DataSource
+		}
+		return result[i].RecordID < result[j].RecordID
+	})
+	return result
+}
+
+/*
+Method Record returns the record of the entity with the given data source and record identifier.
+
+Input
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+
+Output
+  - The record, or nil if RECORDS was not requested or does not contain the record.
+*/
+func (entity *Entity) Record(dataSourceCode string, recordID string) *Record {
+	for i := range entity.ResolvedEntity.Records {
+		record := &entity.ResolvedEntity.Records[i]
+		if record.DataSource == dataSourceCode && record.RecordID == recordID {
+			return record
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// WithInfo methods
+// ----------------------------------------------------------------------------
+
+/*
+Method AffectedEntityIDs returns the sorted, distinct identifiers of the affected entities.
+*/
+func (withInfo *WithInfo) AffectedEntityIDs() []int64 {
+	seen := map[int64]bool{}
+	result := make([]int64, 0, len(withInfo.AffectedEntities))
+	for _, affectedEntity := range withInfo.AffectedEntities {
+		if !seen[affectedEntity.EntityID] {
+			seen[affectedEntity.EntityID] = true
+			result = append(result, affectedEntity.EntityID)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// MatchInfo methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Key returns WHY_KEY for Why results and MATCH_KEY otherwise.
+*/
+func (matchInfo *MatchInfo) Key() string {
+	if len(matchInfo.WhyKey) > 0 {
+		return matchInfo.WhyKey
+	}
+	return matchInfo.MatchKey
+}
+
+/*
+Method Rule returns WHY_ERRULE_CODE for Why results and ERRULE_CODE otherwise.
+*/
+func (matchInfo *MatchInfo) Rule() string {
+	if len(matchInfo.WhyErruleCode) > 0 {
+		return matchInfo.WhyErruleCode
+	}
+	return matchInfo.ErruleCode
+}
+
+// ----------------------------------------------------------------------------
+// VirtualEntity methods
+// ----------------------------------------------------------------------------
+
+/*
+Method RecordKeys returns the keys of the records of all member records of the virtual entity, in order.
+*/
+func (virtualEntity *VirtualEntity) RecordKeys() []RecordKey {
+	result := []RecordKey{}
+	for _, memberRecord := range virtualEntity.MemberRecords {
+		result = append(result, memberRecord.Records...)
+	}
+	return result
+}
@@ -0,0 +1,256 @@
+package szmodel
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	exportFilename    = "../testdata/export/export-json-entity-report.jsonl"
+	printResults      = false
+	responseDirectory = "../testdata/responses"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzmodel_DecodeEntity(test *testing.T) {
+	for _, filename := range []string{"get-entity-by-entity-id.json", "get-entity-by-record-id.json"} {
+		test.Run(filename, func(test *testing.T) {
+			response := readResponse(test, filename)
+			actual, err := DecodeEntity(response)
+			require.NoError(test, err)
+			printActual(test, actual)
+			assert.Equal(test, int64(100001), actual.ResolvedEntity.EntityID)
+			assert.Equal(test, "Robert Smith", actual.ResolvedEntity.EntityName)
+			assert.Len(test, actual.ResolvedEntity.Features, 6)
+			assert.Equal(test, "MOBILE", actual.ResolvedEntity.Features["PHONE"][1].UsageType)
+			assert.Equal(test, []RecordSummary{{
+				DataSource:  "CUSTOMERS",
+				RecordCount: 2,
+				FirstSeenDt: actual.ResolvedEntity.RecordSummary[0].FirstSeenDt,
+				LastSeenDt:  actual.ResolvedEntity.RecordSummary[0].LastSeenDt,
+			}}, actual.ResolvedEntity.RecordSummary)
+			require.Len(test, actual.ResolvedEntity.Records, 2)
+			assert.Equal(test, "1002", actual.ResolvedEntity.Records[1].RecordID)
+			assert.Equal(test, "+NAME+DOB+PHONE", actual.ResolvedEntity.Records[1].MatchKey)
+			assert.Equal(test, MatchLevelResolved, actual.ResolvedEntity.Records[1].MatchLevel)
+			require.Len(test, actual.RelatedEntities, 2)
+			assert.Equal(test, int64(100003), actual.RelatedEntities[0].EntityID)
+			assert.Equal(test, MatchLevelPossiblyRelated, actual.RelatedEntities[0].MatchLevel)
+			assert.Equal(test, "+NAME+ADDRESS", actual.RelatedEntities[0].MatchKey)
+			reencoded, err := json.Marshal(actual)
+			require.NoError(test, err)
+			assert.JSONEq(test, response, string(reencoded))
+		})
+	}
+}
+
+func TestSzmodel_DecodeEntity_virtualEntity(test *testing.T) {
+	actual, err := DecodeEntity(readResponse(test, "get-virtual-entity-by-record-id.json"))
+	require.NoError(test, err)
+	printActual(test, actual)
+	assert.Len(test, actual.ResolvedEntity.Features, 13)
+	assert.Equal(test, FeatureDescValue{
+		FeatDesc:            "JOHNSON",
+		LibFeatID:           1,
+		UsedForCand:         "N",
+		UsedForScoring:      "Y",
+		EntityCount:         1,
+		CandidateCapReached: "N",
+		ScoringCapReached:   "N",
+		Suppressed:          "N",
+	}, actual.ResolvedEntity.Features["NAME"][0].FeatDescValues[0])
+	assert.Empty(test, actual.RelatedEntities)
+	record := actual.Record("TEST", "222")
+	require.NotNil(test, record)
+	assert.Contains(test, record.Features, RecordFeature{LibFeatID: 8, UsageType: "CC"})
+	assert.Nil(test, actual.Record("TEST", "333"))
+	assert.Equal(test, []string{"TEST"}, actual.DataSources())
+	assert.Equal(test, int64(2), actual.RecordCount())
+	assert.Equal(test, []RecordKey{{DataSource: "TEST", RecordID: "111"}, {DataSource: "TEST", RecordID: "222"}}, actual.RecordKeys())
+}
+
+func TestSzmodel_DecodeEntity_minimal(test *testing.T) {
+	actual, err := DecodeEntity(`{"RESOLVED_ENTITY":{"ENTITY_ID":7,"NOT_YET_KNOWN":{"A":1}}}`)
+	require.NoError(test, err)
+	assert.Equal(test, &Entity{ResolvedEntity: ResolvedEntity{EntityID: 7}}, actual)
+	assert.Empty(test, actual.DataSources())
+	assert.Equal(test, int64(0), actual.RecordCount())
+	assert.Nil(test, actual.Record("TEST", "111"))
+}
+
+func TestSzmodel_DecodeEntity_exportLines(test *testing.T) {
+	contents, err := os.ReadFile(exportFilename)
+	require.NoError(test, err)
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	require.Len(test, lines, 6)
+	actual, err := DecodeEntity(lines[4])
+	require.NoError(test, err)
+	assert.Equal(test, int64(5), actual.ResolvedEntity.EntityID)
+	assert.Equal(test, []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"}, actual.DataSources())
+	assert.Equal(test, map[string]int64{"CUSTOMERS": 2, "REFERENCE": 1, "WATCHLIST": 1}, actual.RecordCountByDataSource())
+	actual.ResolvedEntity.Records = nil
+	assert.Equal(test, int64(4), actual.RecordCount())
+	assert.Equal(test, []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"}, actual.DataSources())
+}
+
+func TestSzmodel_DecodeEntity_badJSON(test *testing.T) {
+	actual, err := DecodeEntity(`{"RESOLVED_ENTITY":`)
+	require.Error(test, err)
+	assert.Nil(test, actual)
+	_, err = DecodeEntity(`{"RESOLVED_ENTITY":{"ENTITY_ID":"one"}}`)
+	require.Error(test, err)
+}
+
+func TestSzmodel_DecodeRecord(test *testing.T) {
+	actual, err := DecodeRecord(readResponse(test, "get-record.json"))
+	require.NoError(test, err)
+	printActual(test, actual)
+	assert.Equal(test, "CUSTOMERS", actual.DataSource)
+	assert.Equal(test, "1001", actual.RecordID)
+	jsonData := map[string]string{}
+	require.NoError(test, json.Unmarshal(actual.JSONData, &jsonData))
+	assert.Equal(test, "Robert", jsonData["PRIMARY_NAME_FIRST"])
+}
+
+func TestSzmodel_DecodeRecord_noFlags(test *testing.T) {
+	actual, err := DecodeRecord(`{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}`)
+	require.NoError(test, err)
+	assert.Equal(test, &Record{DataSource: "CUSTOMERS", RecordID: "1001"}, actual)
+}
+
+func TestSzmodel_DecodeRecord_badJSON(test *testing.T) {
+	actual, err := DecodeRecord(`[]`)
+	require.Error(test, err)
+	assert.Nil(test, actual)
+}
+
+func TestSzmodel_DecodeWithInfo(test *testing.T) {
+	actual, err := DecodeWithInfo(`{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1003","AFFECTED_ENTITIES":[{"ENTITY_ID":100002},{"ENTITY_ID":100001},{"ENTITY_ID":100002}],"INTERESTING_ENTITIES":{"ENTITIES":[{"ENTITY_ID":100003,"DEGREES":1,"FLAGS":["WATCHLIST"],"SAMPLE_RECORDS":[{"DATA_SOURCE":"WATCHLIST","RECORD_ID":"1012","FLAGS":["WATCHLIST"]}]}],"NOTICES":[{"CODE":"RELATED_TO_MAX_ENTITIES","DESCRIPTION":"Too many related entities"}]}}`)
+	require.NoError(test, err)
+	printActual(test, actual)
+	assert.Equal(test, "CUSTOMERS", actual.DataSource)
+	assert.Equal(test, "1003", actual.RecordID)
+	assert.Equal(test, []int64{100001, 100002}, actual.AffectedEntityIDs())
+	assert.Equal(test, InterestingEntities{
+		Entities: []InterestingEntity{{
+			EntityID:      100003,
+			Degrees:       1,
+			Flags:         []string{"WATCHLIST"},
+			SampleRecords: []SampleRecord{{DataSource: "WATCHLIST", RecordID: "1012", Flags: []string{"WATCHLIST"}}},
+		}},
+		Notices: []Notice{{Code: "RELATED_TO_MAX_ENTITIES", Description: "Too many related entities"}},
+	}, actual.InterestingEntities)
+}
+
+func TestSzmodel_DecodeWithInfo_reevaluateEntity(test *testing.T) {
+	actual, err := DecodeWithInfo(`{"AFFECTED_ENTITIES":[],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`)
+	require.NoError(test, err)
+	assert.Empty(test, actual.DataSource)
+	assert.Empty(test, actual.AffectedEntityIDs())
+	_, err = DecodeWithInfo("")
+	require.Error(test, err)
+}
+
+func TestSzmodel_DecodeHow(test *testing.T) {
+	actual, err := DecodeHow(readResponse(test, "how-entity-by-entity-id.json"))
+	require.NoError(test, err)
+	printActual(test, actual)
+	require.Len(test, actual.HowResults.ResolutionSteps, 2)
+	step := actual.HowResults.ResolutionSteps[1]
+	assert.Equal(test, int64(2), step.Step)
+	assert.Equal(test, "V100001-S2", step.ResultVirtualEntityID)
+	assert.Equal(test, "V100001-S1", step.VirtualEntity1.VirtualEntityID)
+	assert.Equal(test, []RecordKey{{DataSource: "CUSTOMERS", RecordID: "1001"}, {DataSource: "CUSTOMERS", RecordID: "1002"}}, step.VirtualEntity1.RecordKeys())
+	assert.Equal(test, "SF1_PNAME_CSTAB", step.MatchInfo.Rule())
+	require.Len(test, actual.HowResults.FinalState.VirtualEntities, 1)
+	assert.Len(test, actual.HowResults.FinalState.VirtualEntities[0].RecordKeys(), 3)
+	_, err = DecodeHow("{")
+	require.Error(test, err)
+}
+
+func TestSzmodel_DecodeWhy(test *testing.T) {
+	actual, err := DecodeWhy(readResponse(test, "why-entities.json"))
+	require.NoError(test, err)
+	printActual(test, actual)
+	require.Len(test, actual.WhyResults, 1)
+	whyResult := actual.WhyResults[0]
+	assert.Equal(test, int64(1), whyResult.EntityID)
+	assert.Equal(test, int64(2), whyResult.EntityID2)
+	assert.Equal(test, "+PHONE+ACCT_NUM-SSN", whyResult.MatchInfo.Key())
+	assert.Equal(test, "SF1", whyResult.MatchInfo.Rule())
+	assert.NotEmpty(test, whyResult.MatchInfo.FeatureScores["NAME"])
+	assert.Len(test, actual.Entities, 2)
+	actual, err = DecodeWhy(readResponse(test, "why-record-in-entity.json"))
+	require.NoError(test, err)
+	assert.Equal(test, []RecordKey{{DataSource: "CUSTOMERS", RecordID: "1001"}}, actual.WhyResults[0].FocusRecords)
+	_, err = DecodeWhy("[]")
+	require.Error(test, err)
+}
+
+func TestSzmodel_MatchInfo_Key(test *testing.T) {
+	matchInfo := MatchInfo{MatchKey: "+NAME", ErruleCode: "CNAME"}
+	assert.Equal(test, "+NAME", matchInfo.Key())
+	assert.Equal(test, "CNAME", matchInfo.Rule())
+	matchInfo.WhyKey = "+NAME+DOB"
+	matchInfo.WhyErruleCode = "SF1"
+	assert.Equal(test, "+NAME+DOB", matchInfo.Key())
+	assert.Equal(test, "SF1", matchInfo.Rule())
+}
+
+func TestSzmodel_ParseMatchKey(test *testing.T) {
+	testCases := []struct {
+		matchKey   string
+		matched    []string
+		conflicted []string
+	}{
+		{matchKey: "", matched: []string{}, conflicted: []string{}},
+		{matchKey: "+NAME+DOB-SSN", matched: []string{"NAME", "DOB"}, conflicted: []string{"SSN"}},
+		{matchKey: "NAME+DOB", matched: []string{"NAME", "DOB"}, conflicted: []string{}},
+		{matchKey: "-DOB-SSN", matched: []string{}, conflicted: []string{"DOB", "SSN"}},
+		{matchKey: "+ADDRESS+REL_POINTER(DOMAIN:-SPOUSE-HUSBAND)-DOB", matched: []string{"ADDRESS", "REL_POINTER(DOMAIN:-SPOUSE-HUSBAND)"}, conflicted: []string{"DOB"}},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.matchKey, func(test *testing.T) {
+			matched, conflicted := ParseMatchKey(testCase.matchKey)
+			assert.Equal(test, testCase.matched, matched)
+			assert.Equal(test, testCase.conflicted, conflicted)
+		})
+	}
+}
+
+func TestSzmodel_ParseTime(test *testing.T) {
+	actual, err := ParseTime("2022-12-06 15:09:48.577")
+	require.NoError(test, err)
+	assert.Equal(test, time.Date(2022, 12, 6, 15, 9, 48, 577000000, time.UTC), actual)
+	actual, err = ParseTime("")
+	require.NoError(test, err)
+	assert.True(test, actual.IsZero())
+	_, err = ParseTime("12/06/2022")
+	require.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %+v", actual)
+	}
+}
+
+func readResponse(test *testing.T, filename string) string {
+	result, err := os.ReadFile(filepath.Join(responseDirectory, filename))
+	require.NoError(test, err)
+	return string(result)
+}
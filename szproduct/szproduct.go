@@ -20,20 +20,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"runtime"
 	"strconv"
+	"sync"
 	"time"
 	"unsafe"
 
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-messaging/messenger"
-	"github.com/senzing-garage/go-observing/notifier"
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/sz-sdk-go-core/callstats"
+	"github.com/senzing-garage/sz-sdk-go-core/dispatcher"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"github.com/senzing-garage/sz-sdk-go-core/notification"
+	"github.com/senzing-garage/sz-sdk-go-core/redaction"
+	"github.com/senzing-garage/sz-sdk-go-core/szmetrics"
+	"github.com/senzing-garage/sz-sdk-go-core/szslog"
+	"github.com/senzing-garage/sz-sdk-go-core/sztracing"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
-	"github.com/senzing-garage/sz-sdk-go/szerror"
 	"github.com/senzing-garage/sz-sdk-go/szproduct"
+	"go.opentelemetry.io/otel/trace"
 )
 
 /*
@@ -41,11 +50,20 @@ Type Szproduct struct implements the [senzing.SzProduct] interface
 for communicating with the Senzing C binaries.
 */
 type Szproduct struct {
-	isTrace        bool
-	logger         logging.Logging
-	messenger      messenger.Messenger
-	observerOrigin string
-	observers      subject.Subject
+	callStatistics      callstats.Statistics
+	dispatcher          *dispatcher.Dispatcher
+	dispatcherLock      sync.Mutex
+	filterLock          sync.RWMutex
+	filters             map[string]notification.Filter
+	isTrace             bool
+	logger              logging.Logging
+	messenger           messenger.Messenger
+	metrics             *szmetrics.Metrics
+	notificationDetails notification.Details
+	observerOrigin      string
+	observers           subject.Subject
+	redaction           *redaction.Policy
+	tracer              trace.Tracer
 }
 
 const (
@@ -68,17 +86,27 @@ Input
 */
 func (client *Szproduct) Destroy(ctx context.Context) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(3)
 		defer func() { client.traceExit(4, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Destroy", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Destroy", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szproduct.Destroy")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.destroy(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8001, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8001, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8001, err, details, entryTime, notification.NoResult)
+	}
+	flushErr := client.getDispatcher().Flush(ctx)
+	if err == nil {
+		err = flushErr
 	}
 	return err
 }
@@ -95,17 +123,23 @@ Output
 func (client *Szproduct) GetLicense(ctx context.Context) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(9)
 		defer func() { client.traceExit(10, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetLicense", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetLicense", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szproduct.GetLicense")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.license(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8003, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8003, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8003, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -122,17 +156,23 @@ Output
 func (client *Szproduct) GetVersion(ctx context.Context) (string, error) {
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(11)
 		defer func() { client.traceExit(12, result, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "GetVersion", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("GetVersion", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szproduct.GetVersion")
+		defer sztracing.End(ctx, &err)
+	}
 	result, err = client.version(ctx)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8004, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8004, err); len(recipients) > 0 {
+		details := map[string]string{}
+		client.notify(ctx, recipients, 8004, err, details, entryTime, len(result))
 	}
 	return result, err
 }
@@ -141,6 +181,24 @@ func (client *Szproduct) GetVersion(ctx context.Context) (string, error) {
 // Public non-interface methods
 // ----------------------------------------------------------------------------
 
+/*
+Method GetCallStatistics returns the number of calls, failures and latencies of each method
+called since the Szproduct was created or ResetCallStatistics was called.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The statistics of the component, which callstats.Write prints as a table.
+*/
+func (client *Szproduct) GetCallStatistics(ctx context.Context) callstats.Report {
+	_ = ctx
+	return callstats.Report{
+		ComponentID: ComponentID,
+		Methods:     client.callStatistics.Snapshot(),
+	}
+}
+
 /*
 Method GetObserverOrigin returns the "origin" value of past Observer messages.
 
@@ -167,21 +225,27 @@ Input
 */
 func (client *Szproduct) Initialize(ctx context.Context, instanceName string, settings string, verboseLogging int64) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(13, instanceName, settings, verboseLogging)
 		defer func() { client.traceExit(14, instanceName, settings, verboseLogging, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "Initialize", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("Initialize", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szproduct.Initialize")
+		defer sztracing.End(ctx, &err)
+	}
 	err = client.init(ctx, instanceName, settings, verboseLogging)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"instanceName":   instanceName,
-				"settings":       settings,
-				"verboseLogging": strconv.FormatInt(verboseLogging, baseTen),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8002, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8002, err); len(recipients) > 0 {
+		details := map[string]string{
+			"instanceName":   instanceName,
+			"settings":       settings,
+			"verboseLogging": strconv.FormatInt(verboseLogging, baseTen),
+		}
+		client.notify(ctx, recipients, 8002, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
@@ -195,26 +259,79 @@ Input
 */
 func (client *Szproduct) RegisterObserver(ctx context.Context, observer observer.Observer) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(703, observer.GetObserverID(ctx))
 		defer func() { client.traceExit(704, observer.GetObserverID(ctx), err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "RegisterObserver", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("RegisterObserver", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szproduct.RegisterObserver")
+		defer sztracing.End(ctx, &err)
+	}
 	if client.observers == nil {
 		client.observers = &subject.SimpleSubject{}
 	}
 	err = client.observers.RegisterObserver(ctx, observer)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"observerID": observer.GetObserverID(ctx),
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8702, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8702, err); len(recipients) > 0 {
+		details := map[string]string{
+			"observerID": observer.GetObserverID(ctx),
+		}
+		client.notify(ctx, recipients, 8702, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
 
+/*
+Method RegisterObserverWithFilter adds the observer to the list of observers notified,
+sending it only the notifications accepted by the filter.
+The filter is applied before the details of a notification are gathered,
+so rejected notifications cost the observer nothing.
+Registering an observer again replaces its filter.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+  - filter: The notifications sent to the observer, such as notification.MessageIDs(8001) or notification.Errors. nil for all.
+*/
+func (client *Szproduct) RegisterObserverWithFilter(ctx context.Context, observer observer.Observer, filter notification.Filter) error {
+	client.setFilter(ctx, observer, filter)
+	return client.RegisterObserver(ctx, observer)
+}
+
+/*
+Method ResetCallStatistics forgets the calls counted by GetCallStatistics.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szproduct) ResetCallStatistics(ctx context.Context) {
+	_ = ctx
+	client.callStatistics.Reset()
+}
+
+/*
+Method SetDispatcher sets the dispatcher delivering future notifications to observers,
+then waits until the notifications queued by the previous dispatcher are delivered.
+
+Input
+  - ctx: A context to control lifecycle.
+  - notificationDispatcher: The dispatcher. nil restores a default one, with a blocking queue of dispatcher.DefaultCapacity.
+*/
+func (client *Szproduct) SetDispatcher(ctx context.Context, notificationDispatcher *dispatcher.Dispatcher) error {
+	client.dispatcherLock.Lock()
+	previous := client.dispatcher
+	client.dispatcher = notificationDispatcher
+	client.dispatcherLock.Unlock()
+	if previous == nil {
+		return nil
+	}
+	return previous.Flush(ctx)
+}
+
 /*
 Method SetLogLevel sets the level of logging.
 
@@ -224,27 +341,77 @@ Input
 */
 func (client *Szproduct) SetLogLevel(ctx context.Context, logLevelName string) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(705, logLevelName)
 		defer func() { client.traceExit(706, logLevelName, err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "SetLogLevel", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("SetLogLevel", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szproduct.SetLogLevel")
+		defer sztracing.End(ctx, &err)
+	}
 	if !logging.IsValidLogLevelName(logLevelName) {
-		return fmt.Errorf("invalid error level: %s", logLevelName)
+		err = fmt.Errorf("invalid error level: %s", logLevelName)
+		return err
 	}
 	err = client.getLogger().SetLogLevel(logLevelName)
 	client.isTrace = (logLevelName == logging.LevelTraceName)
-	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"logLevelName": logLevelName,
-			}
-			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8703, err, details)
-		}()
+	if recipients := client.getRecipients(ctx, 8703, err); len(recipients) > 0 {
+		details := map[string]string{
+			"logLevelName": logLevelName,
+		}
+		client.notify(ctx, recipients, 8703, err, details, entryTime, notification.NoResult)
 	}
 	return err
 }
 
+/*
+Method SetLogger writes future log messages to an slog logger, keeping the current log level.
+
+Input
+  - ctx: A context to control lifecycle.
+  - logger: Where log messages are written, as described in package szslog. nil restores the default logging.
+*/
+func (client *Szproduct) SetLogger(ctx context.Context, logger *slog.Logger) {
+	_ = ctx
+	logLevelName := client.getLogger().GetLogLevel()
+	if logger == nil {
+		client.logger = helper.GetLogger(ComponentID, szproduct.IDMessages, baseCallerSkip)
+	} else {
+		client.logger = szslog.New(ComponentID, szproduct.IDMessages, logger)
+	}
+	client.panicOnError(client.logger.SetLogLevel(logLevelName))
+}
+
+/*
+Method SetMetrics records future method calls in metrics.
+
+Input
+  - ctx: A context to control lifecycle.
+  - metrics: Where calls are recorded. nil stops recording.
+*/
+func (client *Szproduct) SetMetrics(ctx context.Context, metrics *szmetrics.Metrics) {
+	_ = ctx
+	client.metrics = metrics
+}
+
+/*
+Method SetNotificationDetails chooses the optional details added to future Observer messages,
+as described in the notification package.
+
+Input
+  - ctx: A context to control lifecycle.
+  - details: The optional details, such as notification.Duration|notification.RequestID. notification.NoDetails, the default, adds none.
+*/
+func (client *Szproduct) SetNotificationDetails(ctx context.Context, details notification.Details) {
+	_ = ctx
+	client.notificationDetails = details
+}
+
 /*
 Method SetObserverOrigin sets the "origin" value in future Observer messages.
 
@@ -257,6 +424,31 @@ func (client *Szproduct) SetObserverOrigin(ctx context.Context, origin string) {
 	client.observerOrigin = origin
 }
 
+/*
+Method SetRedaction sets the policy masking personal data and secrets
+in future errors, trace logs and Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - policy: What is redacted, as described in the redaction package. nil redacts nothing.
+*/
+func (client *Szproduct) SetRedaction(ctx context.Context, policy *redaction.Policy) {
+	_ = ctx
+	client.redaction = policy
+}
+
+/*
+Method SetTracer starts a span around each future method call.
+
+Input
+  - ctx: A context to control lifecycle.
+  - tracer: The tracer starting the spans, such as sztracing.Tracer(otel.GetTracerProvider()). nil stops tracing.
+*/
+func (client *Szproduct) SetTracer(ctx context.Context, tracer trace.Tracer) {
+	_ = ctx
+	client.tracer = tracer
+}
+
 /*
 Method UnregisterObserver removes the observer to the list of observers notified.
 
@@ -266,21 +458,30 @@ Input
 */
 func (client *Szproduct) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(707, observer.GetObserverID(ctx))
 		defer func() { client.traceExit(708, observer.GetObserverID(ctx), err, time.Since(entryTime)) }()
 	}
+	if client.metrics != nil {
+		defer client.metrics.Observe(ComponentID, "UnregisterObserver", entryTime, &err)
+	}
+	defer client.callStatistics.Observe("UnregisterObserver", entryTime, &err)
+	if client.tracer != nil {
+		ctx = sztracing.Start(ctx, client.tracer, "szproduct.UnregisterObserver")
+		defer sztracing.End(ctx, &err)
+	}
 	if client.observers != nil {
-		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
-		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
-		details := map[string]string{
-			"observerID": observer.GetObserverID(ctx),
+		// The notification is queued for the observers registered before this one is removed,
+		// so the observer being removed is notified too.
+		if recipients := client.getRecipients(ctx, 8704, err); len(recipients) > 0 {
+			details := map[string]string{
+				"observerID": observer.GetObserverID(ctx),
+			}
+			client.notify(ctx, recipients, 8704, err, details, entryTime, notification.NoResult)
 		}
-		notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8704, err, details)
 		err = client.observers.UnregisterObserver(ctx, observer)
+		client.setFilter(ctx, observer, nil)
 		if !client.observers.HasObservers(ctx) {
 			client.observers = nil
 		}
@@ -344,6 +545,73 @@ func (client *Szproduct) init(ctx context.Context, instanceName string, settings
 // Internal methods
 // ----------------------------------------------------------------------------
 
+// --- Observing --------------------------------------------------------------
+
+// Get the notification dispatcher, creating a default one on first use.
+func (client *Szproduct) getDispatcher() *dispatcher.Dispatcher {
+	client.dispatcherLock.Lock()
+	defer client.dispatcherLock.Unlock()
+	if client.dispatcher == nil {
+		client.dispatcher = dispatcher.New(dispatcher.DefaultCapacity, dispatcher.Block)
+	}
+	return client.dispatcher
+}
+
+// Get the observers whose filters accept a notification. Empty if there are none.
+func (client *Szproduct) getRecipients(ctx context.Context, messageID int, err error) []observer.Observer {
+	if client.observers == nil {
+		return nil
+	}
+	observers := client.observers.GetObservers(ctx)
+	client.filterLock.RLock()
+	defer client.filterLock.RUnlock()
+	if len(client.filters) == 0 {
+		return observers
+	}
+	event := notification.Event{
+		ComponentID: ComponentID,
+		MessageID:   messageID,
+		Err:         err,
+	}
+	result := make([]observer.Observer, 0, len(observers))
+	for _, registered := range observers {
+		filter, isFiltered := client.filters[registered.GetObserverID(ctx)]
+		if !isFiltered || filter(event) {
+			result = append(result, registered)
+		}
+	}
+	return result
+}
+
+// Send a message to the recipients, with the optional details chosen by SetNotificationDetails.
+func (client *Szproduct) notify(ctx context.Context, recipients []observer.Observer, messageID int, err error, details map[string]string, entryTime time.Time, resultSize int) {
+	client.redaction.Details(details)
+	if client.notificationDetails != notification.NoDetails {
+		call := notification.Call{
+			EntryTime:  entryTime,
+			Err:        err,
+			ResultSize: resultSize,
+		}
+		notification.Add(ctx, details, client.notificationDetails, call)
+	}
+	client.getDispatcher().DispatchTo(ctx, recipients, client.observerOrigin, ComponentID, messageID, err, details)
+}
+
+// Set the filter of an observer. nil removes it.
+func (client *Szproduct) setFilter(ctx context.Context, registered observer.Observer, filter notification.Filter) {
+	observerID := registered.GetObserverID(ctx)
+	client.filterLock.Lock()
+	defer client.filterLock.Unlock()
+	if filter == nil {
+		delete(client.filters, observerID)
+		return
+	}
+	if client.filters == nil {
+		client.filters = map[string]notification.Filter{}
+	}
+	client.filters[observerID] = filter
+}
+
 // --- Logging ----------------------------------------------------------------
 
 // Get the Logger singleton.
@@ -364,12 +632,12 @@ func (client *Szproduct) getMessenger() messenger.Messenger {
 
 // Trace method entry.
 func (client *Szproduct) traceEntry(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+	client.getLogger().Log(errorNumber, client.redaction.Values(details)...)
 }
 
 // Trace method exit.
 func (client *Szproduct) traceExit(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+	client.getLogger().Log(errorNumber, client.redaction.Values(details)...)
 }
 
 // --- Errors -----------------------------------------------------------------
@@ -382,11 +650,14 @@ func (client *Szproduct) newError(ctx context.Context, errorNumber int, details
 	if err != nil {
 		lastException = err.Error()
 	}
+	lastException = client.redaction.Redact(lastException)
+	details = client.redaction.Values(details)
+	parameters := details
 	details = append(details, messenger.MessageCode{Value: fmt.Sprintf(ExceptionCodeTemplate, lastExceptionCode)})
 	details = append(details, messenger.MessageReason{Value: lastException})
 	details = append(details, errors.New(lastException))
 	errorMessage := client.getMessenger().NewJSON(errorNumber, details...)
-	return szerror.New(lastExceptionCode, errorMessage)
+	return nativeerror.New(ComponentID, errorNumber, szproduct.IDMessages, lastExceptionCode, lastException, errorMessage, parameters...)
 }
 
 /*
@@ -412,8 +683,8 @@ Input
 func (client *Szproduct) clearLastException(ctx context.Context) error {
 	_ = ctx
 	var err error
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(1)
 		defer func() { client.traceExit(2, err, time.Since(entryTime)) }()
 	}
@@ -434,8 +705,8 @@ func (client *Szproduct) getLastException(ctx context.Context) (string, error) {
 	_ = ctx
 	var err error
 	var result string
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(5)
 		defer func() { client.traceExit(6, result, err, time.Since(entryTime)) }()
 	}
@@ -458,8 +729,8 @@ func (client *Szproduct) getLastExceptionCode(ctx context.Context) (int, error)
 	_ = ctx
 	var err error
 	var result int
+	entryTime := time.Now()
 	if client.isTrace {
-		entryTime := time.Now()
 		client.traceEntry(7)
 		defer func() { client.traceExit(8, result, err, time.Since(entryTime)) }()
 	}
@@ -1,6 +1,7 @@
 package szproduct
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"github.com/senzing-garage/go-helpers/settings"
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/szmetrics"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szerror"
 	"github.com/stretchr/testify/assert"
@@ -91,8 +93,18 @@ func TestSzproduct_newError(test *testing.T) {
 
 func TestSzproduct_SetLogLevel_badLogLevelName(test *testing.T) {
 	ctx := context.TODO()
-	szConfig := getTestObject(ctx, test)
-	_ = szConfig.SetLogLevel(ctx, badLogLevelName)
+	szProduct := getTestObject(ctx, test)
+	metrics := szmetrics.New()
+	szProduct.SetMetrics(ctx, metrics)
+	defer szProduct.SetMetrics(ctx, nil)
+	err := szProduct.SetLogLevel(ctx, badLogLevelName)
+	require.Error(test, err)
+	var buffer bytes.Buffer
+	require.NoError(test, metrics.Write(ctx, &buffer))
+	actual := buffer.String()
+	printActual(test, actual)
+	assert.Contains(test, actual, `sz_calls_total{component="szproduct",method="SetLogLevel"} 1`)
+	assert.Contains(test, actual, `sz_call_errors_total{component="szproduct",method="SetLogLevel",code="OTHER"} 1`)
 }
 
 func TestSzproduct_SetObserverOrigin(test *testing.T) {
@@ -0,0 +1,27 @@
+/*
+Package szslog sends the log messages of the Sz objects to a [log/slog] logger.
+
+By default, the [szconfig], [szconfigmanager], [szdiagnostic], [szengine] and [szproduct] packages
+log JSON through go-logging. Pass an *slog.Logger to the SetLogger method of an Sz object,
+or set the Logger field of an [szabstractfactory.Szabstractfactory], to log through slog instead:
+
+	handler := slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level:       szslog.LevelTrace,
+		ReplaceAttr: szslog.ReplaceLevel,
+	})
+	szEngine.SetLogger(ctx, slog.New(handler))
+
+Message text still comes from the message catalogs of the Sz packages.
+Each record carries the message ID, such as "SZSDK60040001", the component and method,
+and, when present, the duration, the error and the remaining details as attributes.
+SetLogLevel continues to choose which messages are logged;
+the TRACE, FATAL and PANIC levels are [LevelTrace], [LevelFatal] and [LevelPanic].
+
+[szabstractfactory.Szabstractfactory]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szabstractfactory#Szabstractfactory
+[szconfig]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfig
+[szconfigmanager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfigmanager
+[szdiagnostic]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szdiagnostic
+[szengine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine
+[szproduct]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szproduct
+*/
+package szslog
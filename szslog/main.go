@@ -0,0 +1,80 @@
+package szslog
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/senzing-garage/go-logging/logging"
+	"github.com/senzing-garage/go-messaging/messenger"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Logger struct implements the [logging.Logging] interface of go-logging by writing to an *slog.Logger.
+Its methods may be called concurrently.
+
+[logging.Logging]: https://pkg.go.dev/github.com/senzing-garage/go-logging/logging#Logging
+*/
+type Logger struct {
+	componentID int
+	idMessages  map[int]string
+	leveler     *slog.LevelVar
+	logger      *slog.Logger
+	messenger   messenger.Messenger
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Attributes of the log records.
+const (
+	ComponentKey = "component"
+	DetailsKey   = "details"
+	DurationKey  = "duration"
+	ErrorKey     = "error"
+	IDKey        = "id"
+	MethodKey    = "method"
+)
+
+// Levels of go-logging that slog does not define, with the same values.
+const (
+	LevelTrace = slog.Level(logging.LevelTraceInt)
+	LevelFatal = slog.Level(logging.LevelFatalInt)
+	LevelPanic = slog.Level(logging.LevelPanicInt)
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// ErrInvalidLogLevel is returned by SetLogLevel for an unknown log level name.
+var ErrInvalidLogLevel = errors.New("szslog: invalid log level")
+
+// Levels maps the log level names accepted by SetLogLevel to slog levels.
+var Levels = map[string]slog.Level{
+	logging.LevelTraceName: LevelTrace,
+	logging.LevelDebugName: slog.LevelDebug,
+	logging.LevelInfoName:  slog.LevelInfo,
+	logging.LevelWarnName:  slog.LevelWarn,
+	logging.LevelErrorName: slog.LevelError,
+	logging.LevelFatalName: LevelFatal,
+	logging.LevelPanicName: LevelPanic,
+}
+
+// Message numbers at which each level starts, as in the message catalogs of the Sz packages.
+var messageLevels = []struct {
+	start int
+	level slog.Level
+}{
+	{start: 6000, level: LevelPanic},
+	{start: 5000, level: LevelFatal},
+	{start: 4000, level: slog.LevelError},
+	{start: 3000, level: slog.LevelWarn},
+	{start: 2000, level: slog.LevelInfo},
+	{start: 1000, level: slog.LevelDebug},
+	{start: 0, level: LevelTrace},
+}
@@ -0,0 +1,274 @@
+package szslog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/go-logging/logging"
+	"github.com/senzing-garage/sz-sdk-go-core/helper"
+)
+
+// ----------------------------------------------------------------------------
+// Constructor
+// ----------------------------------------------------------------------------
+
+/*
+The New function returns a logger for a component that writes to an *slog.Logger.
+Its log level starts at INFO.
+
+Input
+  - componentID: The 4-digit identifier of the component used as "cccc" in the "SZSDKcccceeee" message identifier.
+  - idMessages: The message catalog of the component.
+  - logger: Where messages are written. If nil, slog.Default() is used.
+
+Output
+  - The logger.
+*/
+func New(componentID int, idMessages map[int]string, logger *slog.Logger) *Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	result := &Logger{
+		componentID: componentID,
+		idMessages:  idMessages,
+		leveler:     new(slog.LevelVar),
+		logger:      logger,
+		messenger:   helper.GetMessenger(componentID, idMessages, 0),
+	}
+	result.leveler.Set(slog.LevelInfo)
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// logging.Logging interface methods
+// ----------------------------------------------------------------------------
+
+/*
+Method GetLogLevel returns the name of the current log level.
+
+Output
+  - A name such as "INFO".
+*/
+func (logger *Logger) GetLogLevel() string {
+	level := logger.leveler.Level()
+	for name, candidate := range Levels {
+		if candidate == level {
+			return name
+		}
+	}
+	return level.String()
+}
+
+/*
+Method Is returns true if messages of a log level are written.
+Messages must pass both the log level set by SetLogLevel and the slog handler.
+
+Input
+  - logLevelName: A name such as "DEBUG".
+*/
+func (logger *Logger) Is(logLevelName string) bool {
+	level, isFound := Levels[logLevelName]
+	return isFound && logger.enabled(context.Background(), level)
+}
+
+// Method IsDebug returns true if DEBUG messages are written.
+func (logger *Logger) IsDebug() bool {
+	return logger.Is(logging.LevelDebugName)
+}
+
+// Method IsError returns true if ERROR messages are written.
+func (logger *Logger) IsError() bool {
+	return logger.Is(logging.LevelErrorName)
+}
+
+// Method IsFatal returns true if FATAL messages are written.
+func (logger *Logger) IsFatal() bool {
+	return logger.Is(logging.LevelFatalName)
+}
+
+// Method IsInfo returns true if INFO messages are written.
+func (logger *Logger) IsInfo() bool {
+	return logger.Is(logging.LevelInfoName)
+}
+
+// Method IsPanic returns true if PANIC messages are written.
+func (logger *Logger) IsPanic() bool {
+	return logger.Is(logging.LevelPanicName)
+}
+
+// Method IsTrace returns true if TRACE messages are written.
+func (logger *Logger) IsTrace() bool {
+	return logger.Is(logging.LevelTraceName)
+}
+
+// Method IsWarn returns true if WARN messages are written.
+func (logger *Logger) IsWarn() bool {
+	return logger.Is(logging.LevelWarnName)
+}
+
+/*
+Method JSON returns a message of the catalog as a JSON string, as go-logging does.
+
+Input
+  - messageNumber: The message number in the catalog.
+  - details: Values of the message.
+*/
+func (logger *Logger) JSON(messageNumber int, details ...interface{}) string {
+	return logger.messenger.NewJSON(messageNumber, details...)
+}
+
+/*
+Method Log writes a message of the catalog to the slog logger.
+The level of the message follows from its number: TRACE below 1000, DEBUG below 2000, and so on.
+
+Input
+  - messageNumber: The message number in the catalog.
+  - details: Values of the message. A time.Duration becomes the duration attribute,
+    a non-nil error the error attribute and a map[string]string named details.
+    Other values become details named by their position, starting at 1.
+*/
+func (logger *Logger) Log(messageNumber int, details ...interface{}) {
+	ctx := context.Background()
+	level := messageLevel(messageNumber)
+	if !logger.enabled(ctx, level) {
+		return
+	}
+	var callers [1]uintptr
+	runtime.Callers(3, callers[:]) // Skip Callers, Log and the trace method of the Sz object.
+	template := logger.idMessages[messageNumber]
+	text := strings.Split(fmt.Sprintf(template, details...), "%!(")[0]
+	record := slog.NewRecord(time.Now(), level, text, callers[0])
+	component, method := parseTemplate(template)
+	if len(component) == 0 {
+		component = strconv.Itoa(logger.componentID)
+	}
+	record.AddAttrs(
+		slog.String(IDKey, fmt.Sprintf("%s%04d%04d", helper.MessageIDPrefix, logger.componentID, messageNumber)),
+		slog.String(ComponentKey, component),
+	)
+	if len(method) > 0 {
+		record.AddAttrs(slog.String(MethodKey, method))
+	}
+	detailAttrs := []any{}
+	for index, detail := range details {
+		switch typedDetail := detail.(type) {
+		case nil:
+			// For example, the error of a call that succeeded.
+		case time.Duration:
+			record.AddAttrs(slog.Duration(DurationKey, typedDetail))
+		case error:
+			record.AddAttrs(slog.Any(ErrorKey, typedDetail))
+		case map[string]string:
+			keys := make([]string, 0, len(typedDetail))
+			for key := range typedDetail {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				detailAttrs = append(detailAttrs, slog.String(key, typedDetail[key]))
+			}
+		default:
+			detailAttrs = append(detailAttrs, slog.Any(strconv.Itoa(index+1), typedDetail))
+		}
+	}
+	if len(detailAttrs) > 0 {
+		record.AddAttrs(slog.Group(DetailsKey, detailAttrs...))
+	}
+	_ = logger.logger.Handler().Handle(ctx, record)
+}
+
+/*
+Method NewError returns a message of the catalog as an error, as go-logging does.
+
+Input
+  - messageNumber: The message number in the catalog.
+  - details: Values of the message.
+*/
+func (logger *Logger) NewError(messageNumber int, details ...interface{}) error {
+	return logger.messenger.NewError(messageNumber, details...)
+}
+
+/*
+Method SetLogLevel sets the lowest level of messages written.
+
+Input
+  - logLevelName: One of "TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL" or "PANIC".
+*/
+func (logger *Logger) SetLogLevel(logLevelName string) error {
+	level, isFound := Levels[logLevelName]
+	if !isFound {
+		return fmt.Errorf("%w: %s", ErrInvalidLogLevel, logLevelName)
+	}
+	logger.leveler.Set(level)
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Interface functions
+// ----------------------------------------------------------------------------
+
+/*
+The ReplaceLevel function names the TRACE, FATAL and PANIC levels in log records.
+Use it as the ReplaceAttr of slog.HandlerOptions; slog would otherwise write them as "DEBUG-4", "ERROR+4" and "ERROR+8".
+
+Input
+  - groups: The groups of the attribute.
+  - attr: The attribute.
+
+Output
+  - The attribute, with the level named if it is one of Levels.
+*/
+func ReplaceLevel(groups []string, attr slog.Attr) slog.Attr {
+	if len(groups) > 0 || attr.Key != slog.LevelKey {
+		return attr
+	}
+	level, isLevel := attr.Value.Any().(slog.Level)
+	if !isLevel {
+		return attr
+	}
+	for name, candidate := range Levels {
+		if candidate == level {
+			return slog.String(slog.LevelKey, name)
+		}
+	}
+	return attr
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (logger *Logger) enabled(ctx context.Context, level slog.Level) bool {
+	return level >= logger.leveler.Level() && logger.logger.Handler().Enabled(ctx, level)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func messageLevel(messageNumber int) slog.Level {
+	for _, messageLevel := range messageLevels {
+		if messageNumber >= messageLevel.start {
+			return messageLevel.level
+		}
+	}
+	return LevelTrace
+}
+
+// Parse the component and method from a template such as "Enter szengine.AddRecord(%s, %s, %s, %d).".
+func parseTemplate(template string) (string, string) {
+	template = strings.TrimPrefix(template, "Enter ")
+	template = strings.TrimSpace(strings.TrimPrefix(template, "Exit "))
+	name, _, _ := strings.Cut(template, "(")
+	component, method, isFound := strings.Cut(name, ".")
+	if !isFound || strings.ContainsAny(name, " ") {
+		return "", ""
+	}
+	return component, method
+}
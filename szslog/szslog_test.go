@@ -0,0 +1,185 @@
+package szslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/go-logging/logging"
+	"github.com/senzing-garage/sz-sdk-go/szengine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	componentID  = 6004
+	printResults = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzslog_New(test *testing.T) {
+	var logger logging.Logging = New(componentID, szengine.IDMessages, nil)
+	assert.Equal(test, logging.LevelInfoName, logger.GetLogLevel())
+	assert.False(test, logger.IsTrace())
+}
+
+func TestSzslog_Log_entry(test *testing.T) {
+	buffer, logger := newLogger(LevelTrace)
+	require.NoError(test, logger.SetLogLevel(logging.LevelTraceName))
+	traceEntry(logger, 1, "CUSTOMERS", "1001", `{"NAME": "Bob"}`, int64(0))
+	records := decode(test, buffer)
+	require.Len(test, records, 1)
+	record := records[0]
+	printActual(test, record)
+	assert.Equal(test, "TRACE", record["level"])
+	assert.Equal(test, `Enter szengine.AddRecord(CUSTOMERS, 1001, {"NAME": "Bob"}, 0).`, record["msg"])
+	assert.Equal(test, "SZSDK60040001", record[IDKey])
+	assert.Equal(test, "szengine", record[ComponentKey])
+	assert.Equal(test, "AddRecord", record[MethodKey])
+	assert.Equal(test, map[string]interface{}{"1": "CUSTOMERS", "2": "1001", "3": `{"NAME": "Bob"}`, "4": float64(0)}, record[DetailsKey])
+	assert.NotContains(test, record, DurationKey)
+	assert.NotContains(test, record, ErrorKey)
+	source, isMap := record[slog.SourceKey].(map[string]interface{})
+	require.True(test, isMap)
+	assert.True(test, strings.HasSuffix(source["function"].(string), "TestSzslog_Log_entry"), source["function"])
+}
+
+func TestSzslog_Log_exit(test *testing.T) {
+	buffer, logger := newLogger(LevelTrace)
+	require.NoError(test, logger.SetLogLevel(logging.LevelTraceName))
+	var err error = errors.New("not found")
+	traceEntry(logger, 2, "CUSTOMERS", "1001", "{}", int64(0), "", err, map[string]string{"flagNames": "SzNoFlags"}, 1500*time.Millisecond)
+	traceEntry(logger, 12, nil, time.Millisecond)
+	records := decode(test, buffer)
+	require.Len(test, records, 2)
+	printActual(test, records)
+	assert.Equal(test, "not found", records[0][ErrorKey])
+	assert.InDelta(test, float64(1500*time.Millisecond), records[0][DurationKey], 0)
+	details, isMap := records[0][DetailsKey].(map[string]interface{})
+	require.True(test, isMap)
+	assert.Equal(test, "SzNoFlags", details["flagNames"])
+	assert.Equal(test, "Destroy", records[1][MethodKey])
+	assert.NotContains(test, records[1], ErrorKey)
+	assert.NotContains(test, records[1], DetailsKey)
+}
+
+func TestSzslog_Log_levels(test *testing.T) {
+	buffer, logger := newLogger(LevelTrace)
+	traceEntry(logger, 11)
+	assert.Empty(test, decode(test, buffer))
+	traceEntry(logger, 4035, "CUSTOMERS", "1001", int64(0), 33)
+	records := decode(test, buffer)
+	require.Len(test, records, 1)
+	assert.Equal(test, "ERROR", records[0]["level"])
+	assert.Equal(test, "Sz_getRecord_V2", records[0][MethodKey])
+	require.NoError(test, logger.SetLogLevel(logging.LevelFatalName))
+	traceEntry(logger, 4035, "CUSTOMERS", "1001", int64(0), 33)
+	assert.Empty(test, decode(test, buffer))
+}
+
+func TestSzslog_Log_handlerLevel(test *testing.T) {
+	buffer, logger := newLogger(slog.LevelWarn)
+	require.NoError(test, logger.SetLogLevel(logging.LevelTraceName))
+	assert.False(test, logger.IsTrace())
+	assert.False(test, logger.IsInfo())
+	assert.True(test, logger.IsWarn())
+	traceEntry(logger, 11)
+	assert.Empty(test, decode(test, buffer))
+}
+
+func TestSzslog_Log_unknownMessage(test *testing.T) {
+	buffer, logger := newLogger(LevelTrace)
+	traceEntry(logger, 2999, "value")
+	records := decode(test, buffer)
+	require.Len(test, records, 1)
+	assert.Equal(test, "INFO", records[0]["level"])
+	assert.Equal(test, "6004", records[0][ComponentKey])
+	assert.NotContains(test, records[0], MethodKey)
+}
+
+func TestSzslog_JSON(test *testing.T) {
+	_, logger := newLogger(LevelTrace)
+	actual := logger.JSON(4035, "CUSTOMERS", "1001", int64(0), 33)
+	printActual(test, actual)
+	assert.Contains(test, actual, "SZSDK60044035")
+	require.Error(test, logger.NewError(4035, "CUSTOMERS", "1001", int64(0), 33))
+}
+
+func TestSzslog_SetLogLevel(test *testing.T) {
+	_, logger := newLogger(LevelTrace)
+	for name, level := range Levels {
+		require.NoError(test, logger.SetLogLevel(name))
+		assert.Equal(test, name, logger.GetLogLevel())
+		assert.True(test, logger.Is(name))
+		assert.Equal(test, level, logger.leveler.Level())
+	}
+	err := logger.SetLogLevel("BAD")
+	require.ErrorIs(test, err, ErrInvalidLogLevel)
+	assert.False(test, logger.Is("BAD"))
+}
+
+func TestSzslog_ReplaceLevel(test *testing.T) {
+	testCases := []struct {
+		level    slog.Level
+		expected string
+	}{
+		{level: LevelTrace, expected: "TRACE"},
+		{level: slog.LevelDebug, expected: "DEBUG"},
+		{level: LevelFatal, expected: "FATAL"},
+		{level: LevelPanic, expected: "PANIC"},
+	}
+	for _, testCase := range testCases {
+		actual := ReplaceLevel(nil, slog.Any(slog.LevelKey, testCase.level))
+		assert.Equal(test, testCase.expected, actual.Value.String())
+	}
+	unchanged := slog.Any(slog.LevelKey, slog.Level(1))
+	assert.Equal(test, unchanged, ReplaceLevel(nil, unchanged))
+	grouped := slog.Any(slog.LevelKey, LevelTrace)
+	assert.Equal(test, grouped, ReplaceLevel([]string{"group"}, grouped))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func decode(test *testing.T, buffer *bytes.Buffer) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+		if len(line) == 0 {
+			continue
+		}
+		record := map[string]interface{}{}
+		require.NoError(test, json.Unmarshal([]byte(line), &record))
+		result = append(result, record)
+	}
+	buffer.Reset()
+	return result
+}
+
+func newLogger(level slog.Level) (*bytes.Buffer, *Logger) {
+	buffer := &bytes.Buffer{}
+	handler := slog.NewJSONHandler(buffer, &slog.HandlerOptions{
+		AddSource:   true,
+		Level:       level,
+		ReplaceAttr: ReplaceLevel,
+	})
+	return buffer, New(componentID, szengine.IDMessages, slog.New(handler))
+}
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %v", actual)
+	}
+}
+
+// Log as the trace methods of the Sz objects do.
+func traceEntry(logger *Logger, messageNumber int, details ...interface{}) {
+	logger.Log(messageNumber, details...)
+}
@@ -0,0 +1,22 @@
+/*
+Package sztracing starts OpenTelemetry spans around calls of the Sz objects.
+
+Tracing is opt-in and configured per component. Pass a tracer to the SetTracer method of
+[szconfig.Szconfig], [szconfigmanager.Szconfigmanager], [szdiagnostic.Szdiagnostic],
+[szengine.Szengine] or [szproduct.Szproduct]:
+
+	szEngine.SetTracer(ctx, sztracing.Tracer(otel.GetTracerProvider()))
+
+Each method call then starts a span, named like "szengine.AddRecord", as a child of the span in its context.
+Spans carry the data sources, record IDs, entity IDs and flags of the call.
+A failed call sets the span status to error and, for failures of the Senzing C library,
+adds the Senzing exception code, such as "SENZ0033".
+When no tracer is set, the only cost to a method call is a nil check.
+
+[szconfig.Szconfig]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfig#Szconfig
+[szconfigmanager.Szconfigmanager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfigmanager#Szconfigmanager
+[szdiagnostic.Szdiagnostic]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szdiagnostic#Szdiagnostic
+[szengine.Szengine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine#Szengine
+[szproduct.Szproduct]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szproduct#Szproduct
+*/
+package sztracing
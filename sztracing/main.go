@@ -0,0 +1,19 @@
+package sztracing
+
+import "go.opentelemetry.io/otel/attribute"
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Attributes of the spans.
+const (
+	DataSourcesKey = attribute.Key("sz.data_sources")
+	EntityIDsKey   = attribute.Key("sz.entity_ids")
+	ErrorCodeKey   = attribute.Key("sz.error.code")
+	FlagsKey       = attribute.Key("sz.flags")
+	RecordIDsKey   = attribute.Key("sz.record_ids")
+)
+
+// InstrumentationName names the tracer returned by Tracer.
+const InstrumentationName = "github.com/senzing-garage/sz-sdk-go-core"
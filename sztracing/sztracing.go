@@ -0,0 +1,162 @@
+package sztracing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"github.com/senzing-garage/sz-sdk-go-core/szargs"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ----------------------------------------------------------------------------
+// Interface functions
+// ----------------------------------------------------------------------------
+
+/*
+The End function ends the span started by Start.
+It is meant to be deferred:
+
+	ctx = sztracing.Start(ctx, tracer, "szengine.AddRecord")
+	defer sztracing.End(ctx, &err)
+
+Input
+  - ctx: The context returned by Start.
+  - err: The error returned by the call, read when End runs. May be nil.
+*/
+func End(ctx context.Context, err *error) {
+	span := trace.SpanFromContext(ctx)
+	if err != nil && *err != nil {
+		var nativeError *nativeerror.Error
+		if errors.As(*err, &nativeError) {
+			span.SetAttributes(ErrorCodeKey.String(nativeError.Code()))
+		}
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+	span.End()
+}
+
+/*
+The Start function starts a span as a child of the span in the context.
+
+Input
+  - ctx: The context of the call.
+  - tracer: The tracer starting the span.
+  - name: The span name, such as "szengine.AddRecord".
+  - attributes: Attributes of the call, such as those made by DataSources and Flags.
+
+Output
+  - A context holding the span.
+*/
+func Start(ctx context.Context, tracer trace.Tracer, name string, attributes ...attribute.KeyValue) context.Context {
+	ctx, _ = tracer.Start(ctx, name, trace.WithAttributes(attributes...), trace.WithSpanKind(trace.SpanKindInternal))
+	return ctx
+}
+
+/*
+The Tracer function returns the tracer of this module from a provider.
+
+Input
+  - provider: The tracer provider, such as otel.GetTracerProvider().
+
+Output
+  - The tracer to pass to SetTracer.
+*/
+func Tracer(provider trace.TracerProvider) trace.Tracer {
+	return provider.Tracer(InstrumentationName)
+}
+
+// ----------------------------------------------------------------------------
+// Attributes
+// ----------------------------------------------------------------------------
+
+/*
+The DataSources function returns the data source codes of a call as an attribute.
+
+Input
+  - dataSourceCodes: The data source codes.
+
+Output
+  - The sz.data_sources attribute.
+*/
+func DataSources(dataSourceCodes ...string) attribute.KeyValue {
+	return DataSourcesKey.StringSlice(dataSourceCodes)
+}
+
+/*
+The EntityIDs function returns the entity IDs of a call as an attribute.
+
+Input
+  - entityIDs: The entity IDs.
+
+Output
+  - The sz.entity_ids attribute.
+*/
+func EntityIDs(entityIDs ...int64) attribute.KeyValue {
+	return EntityIDsKey.Int64Slice(entityIDs)
+}
+
+/*
+The EntityIDsDocument function returns the entity IDs of a JSON document, such as
+{"ENTITIES": [{"ENTITY_ID": 1}]}, as an attribute.
+
+Input
+  - entityIDs: The JSON document. If it is malformed, the attribute is empty.
+
+Output
+  - The sz.entity_ids attribute.
+*/
+func EntityIDsDocument(entityIDs string) attribute.KeyValue {
+	parsed, _ := szargs.ParseEntityIDs(entityIDs)
+	return EntityIDs(parsed...)
+}
+
+/*
+The Flags function returns the flags of a call as an attribute.
+
+Input
+  - flags: The flags.
+
+Output
+  - The sz.flags attribute.
+*/
+func Flags(flags int64) attribute.KeyValue {
+	return FlagsKey.Int64(flags)
+}
+
+/*
+The RecordIDs function returns the record IDs of a call as an attribute.
+
+Input
+  - recordIDs: The record IDs.
+
+Output
+  - The sz.record_ids attribute.
+*/
+func RecordIDs(recordIDs ...string) attribute.KeyValue {
+	return RecordIDsKey.StringSlice(recordIDs)
+}
+
+/*
+The RecordKeysDocument function returns the data sources and record IDs of a JSON document,
+such as {"RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}]}, as attributes.
+
+Input
+  - recordKeys: The JSON document. If it is malformed, the attributes are empty.
+
+Output
+  - The sz.data_sources and sz.record_ids attributes.
+*/
+func RecordKeysDocument(recordKeys string) (attribute.KeyValue, attribute.KeyValue) {
+	parsed, _ := szargs.ParseRecordKeys(recordKeys)
+	dataSourceCodes := make([]string, 0, len(parsed))
+	recordIDs := make([]string, 0, len(parsed))
+	for _, recordKey := range parsed {
+		dataSourceCodes = append(dataSourceCodes, recordKey.DataSource)
+		recordIDs = append(recordIDs, recordKey.RecordID)
+	}
+	return DataSources(dataSourceCodes...), RecordIDs(recordIDs...)
+}
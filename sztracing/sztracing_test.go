@@ -0,0 +1,132 @@
+package sztracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/nativeerror"
+	"github.com/senzing-garage/sz-sdk-go/szengine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	printResults = false
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSztracing_Start(test *testing.T) {
+	ctx := context.TODO()
+	exporter, tracer := newTracer()
+	parentCtx, parent := tracer.Start(ctx, "request")
+	var err error
+	spanCtx := Start(parentCtx, tracer, "szengine.GetRecord", DataSources("CUSTOMERS"), RecordIDs("1001"), Flags(2))
+	End(spanCtx, &err)
+	parent.End()
+	spans := exporter.GetSpans()
+	require.Len(test, spans, 2)
+	span := spans[0]
+	printActual(test, span.Attributes)
+	assert.Equal(test, "szengine.GetRecord", span.Name)
+	assert.Equal(test, parent.SpanContext().SpanID(), span.Parent.SpanID())
+	assert.Equal(test, parent.SpanContext().TraceID(), span.SpanContext.TraceID())
+	assert.Equal(test, codes.Unset, span.Status.Code)
+	assert.Equal(test, []string{"CUSTOMERS"}, attributeValue(span.Attributes, DataSourcesKey).AsStringSlice())
+	assert.Equal(test, []string{"1001"}, attributeValue(span.Attributes, RecordIDsKey).AsStringSlice())
+	assert.Equal(test, int64(2), attributeValue(span.Attributes, FlagsKey).AsInt64())
+	assert.Equal(test, attribute.INVALID, attributeValue(span.Attributes, ErrorCodeKey).Type())
+}
+
+func TestSztracing_End_nativeError(test *testing.T) {
+	ctx := context.TODO()
+	exporter, tracer := newTracer()
+	err := nativeerror.New(6004, 4035, szengine.IDMessages, 33, "0033E|Unknown record", "unknown record", "CUSTOMERS", "1001", int64(0))
+	End(Start(ctx, tracer, "szengine.GetRecord"), &err)
+	spans := exporter.GetSpans()
+	require.Len(test, spans, 1)
+	assert.Equal(test, codes.Error, spans[0].Status.Code)
+	assert.Equal(test, "unknown record", spans[0].Status.Description)
+	assert.Equal(test, "SENZ0033", attributeValue(spans[0].Attributes, ErrorCodeKey).AsString())
+	require.Len(test, spans[0].Events, 1)
+	assert.Equal(test, "exception", spans[0].Events[0].Name)
+}
+
+func TestSztracing_End_otherError(test *testing.T) {
+	ctx := context.TODO()
+	exporter, tracer := newTracer()
+	err := errors.New("malformed")
+	End(Start(ctx, tracer, "szengine.FindNetworkByEntityID"), &err)
+	spans := exporter.GetSpans()
+	require.Len(test, spans, 1)
+	assert.Equal(test, codes.Error, spans[0].Status.Code)
+	assert.Equal(test, attribute.INVALID, attributeValue(spans[0].Attributes, ErrorCodeKey).Type())
+}
+
+func TestSztracing_End_nilError(test *testing.T) {
+	ctx := context.TODO()
+	exporter, tracer := newTracer()
+	End(Start(ctx, tracer, "szproduct.GetVersion"), nil)
+	spans := exporter.GetSpans()
+	require.Len(test, spans, 1)
+	assert.Equal(test, codes.Unset, spans[0].Status.Code)
+}
+
+func TestSztracing_EntityIDsDocument(test *testing.T) {
+	actual := EntityIDsDocument(`{"ENTITIES": [{"ENTITY_ID": 1}, {"ENTITY_ID": 7}]}`)
+	assert.Equal(test, EntityIDsKey, actual.Key)
+	assert.Equal(test, []int64{1, 7}, actual.Value.AsInt64Slice())
+	assert.Empty(test, EntityIDsDocument(`{"ENTITIES": "bad"}`).Value.AsInt64Slice())
+}
+
+func TestSztracing_RecordKeysDocument(test *testing.T) {
+	dataSources, recordIDs := RecordKeysDocument(`{"RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}, {"DATA_SOURCE": "WATCHLIST", "RECORD_ID": "2"}]}`)
+	assert.Equal(test, []string{"CUSTOMERS", "WATCHLIST"}, dataSources.Value.AsStringSlice())
+	assert.Equal(test, []string{"1001", "2"}, recordIDs.Value.AsStringSlice())
+	dataSources, recordIDs = RecordKeysDocument("not JSON")
+	assert.Empty(test, dataSources.Value.AsStringSlice())
+	assert.Empty(test, recordIDs.Value.AsStringSlice())
+}
+
+func TestSztracing_Tracer(test *testing.T) {
+	ctx := context.TODO()
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	End(Start(ctx, Tracer(provider), "szengine.PrimeEngine"), nil)
+	spans := exporter.GetSpans()
+	require.Len(test, spans, 1)
+	assert.Equal(test, InstrumentationName, spans[0].InstrumentationScope.Name)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func attributeValue(attributes []attribute.KeyValue, key attribute.Key) attribute.Value {
+	for _, keyValue := range attributes {
+		if keyValue.Key == key {
+			return keyValue.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func newTracer() (*tracetest.InMemoryExporter, trace.Tracer) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	return exporter, provider.Tracer("test")
+}
+
+func printActual(test *testing.T, actual interface{}) {
+	if printResults {
+		test.Logf("Actual: %v", actual)
+	}
+}